@@ -11,9 +11,13 @@ import (
 
 	"github.com/fatihsen-dev/kanban-backend/config"
 	db "github.com/fatihsen-dev/kanban-backend/internal/adapters/driven/db/postgres"
+	"github.com/fatihsen-dev/kanban-backend/internal/adapters/driven/mail"
+	"github.com/fatihsen-dev/kanban-backend/internal/adapters/driven/webhook"
 	httphandler "github.com/fatihsen-dev/kanban-backend/internal/adapters/driver/http"
 	middlewares "github.com/fatihsen-dev/kanban-backend/internal/adapters/driver/http/middleware"
+	"github.com/fatihsen-dev/kanban-backend/internal/adapters/driver/job"
 	"github.com/fatihsen-dev/kanban-backend/internal/adapters/driver/ws"
+	ports "github.com/fatihsen-dev/kanban-backend/internal/core/ports/driven"
 	"github.com/fatihsen-dev/kanban-backend/internal/core/service"
 	_ "github.com/fatihsen-dev/kanban-backend/pkg/log"
 	"github.com/gin-contrib/cors"
@@ -42,26 +46,106 @@ func main() {
 	teamRepo := db.NewPostgresTeamRepo(postgresDB)
 	projectMemberRepo := db.NewPostgresProjectMemberRepo(postgresDB)
 	invitationRepo := db.NewPostgresInvitationRepo(postgresDB)
+	projectRoleRepo := db.NewPostgresProjectRoleRepo(postgresDB)
+	organizationRepo := db.NewPostgresOrganizationRepo(postgresDB)
+	organizationMemberRepo := db.NewPostgresOrganizationMemberRepo(postgresDB)
+	organizationTeamRepo := db.NewPostgresOrganizationTeamRepo(postgresDB)
+	laneRepo := db.NewPostgresLaneRepo(postgresDB)
+	columnTransitionRepo := db.NewPostgresColumnTransitionRepo(postgresDB)
+	automationRuleRepo := db.NewPostgresAutomationRuleRepo(postgresDB)
+	automationExecutionRepo := db.NewPostgresAutomationExecutionRepo(postgresDB)
+	checklistItemRepo := db.NewPostgresChecklistItemRepo(postgresDB)
+	taskLinkRepo := db.NewPostgresTaskLinkRepo(postgresDB)
+	customFieldRepo := db.NewPostgresCustomFieldRepo(postgresDB)
+	searchEngine := db.NewPostgresSearchEngine(postgresDB)
+	savedViewRepo := db.NewPostgresSavedViewRepo(postgresDB)
+	activityRepo := db.NewPostgresActivityRepo(postgresDB)
+	taskRevisionRepo := db.NewPostgresTaskRevisionRepo(postgresDB)
+	taskWatcherRepo := db.NewPostgresTaskWatcherRepo(postgresDB)
+	webhookRepo := db.NewPostgresWebhookRepo(postgresDB)
+	webhookDeliveryRepo := db.NewPostgresWebhookDeliveryRepo(postgresDB)
+	incomingWebhookRepo := db.NewPostgresIncomingWebhookRepo(postgresDB)
+	outboxRepo := db.NewPostgresOutboxRepo(postgresDB)
+	transactor := db.NewPostgresTransactor(postgresDB)
+	notificationRepo := db.NewPostgresNotificationRepo(postgresDB)
+	notificationPreferenceRepo := db.NewPostgresNotificationPreferenceRepo(postgresDB)
+
+	// mail
+	var mailer ports.Mailer
+	switch appConfig.MailDriver {
+	case "smtp":
+		mailer = mail.NewSMTPMailer(appConfig.SMTPHost, appConfig.SMTPPort, appConfig.SMTPUsername, appConfig.SMTPPassword, appConfig.MailFrom)
+	case "file":
+		mailer = mail.NewFileMailer(appConfig.MailDir, appConfig.MailFrom)
+	default:
+		mailer = mail.NewLogMailer()
+	}
+	mailComposer := mail.NewTemplateComposer(appConfig.ClientUrl)
+	webhookSender := webhook.NewHTTPSender(10 * time.Second)
 
 	// services
 	userService := service.NewUserService(userRepo)
-	projectService := service.NewProjectService(projectRepo, columnRepo, taskRepo, teamRepo, projectMemberRepo, userRepo)
-	columnService := service.NewColumnService(columnRepo, taskRepo)
-	taskService := service.NewTaskService(taskRepo)
-	projectMemberService := service.NewProjectMemberService(projectMemberRepo, userRepo)
-	teamService := service.NewTeamService(teamRepo, projectMemberRepo)
-	invitationService := service.NewInvitationService(invitationRepo, userRepo, projectRepo, projectMemberRepo)
-
-	hub := ws.NewHub(projectMemberService)
+	projectAccessService := service.NewProjectAccessService(projectRepo, projectMemberRepo, teamRepo, projectRoleRepo, organizationMemberRepo, organizationTeamRepo)
+	webhookService := service.NewWebhookService(webhookRepo, webhookDeliveryRepo, webhookSender, ws.NewEventEncoder())
+	outboxService := service.NewOutboxService(transactor, outboxRepo)
+	hub := ws.NewHub(projectAccessService)
+	activityService := service.NewActivityService(activityRepo, transactor)
+	emailService := service.NewEmailService(notificationPreferenceRepo, userRepo, taskRepo, notificationRepo, mailer, mailComposer, appConfig.JWTSecret, appConfig.ApiUrl, appConfig.DigestHour)
+	notificationService := service.NewNotificationService(notificationRepo, taskRepo, emailService, outboxService, transactor)
+	projectService := service.NewProjectService(projectRepo, columnRepo, taskRepo, teamRepo, projectMemberRepo, userRepo, projectRoleRepo, organizationMemberRepo, organizationTeamRepo, laneRepo, columnTransitionRepo, activityService, outboxService)
+	columnService := service.NewColumnService(columnRepo, taskRepo, projectRepo, activityService, outboxService, transactor)
+	automationEngine := service.NewAutomationEngine(automationRuleRepo, automationExecutionRepo, taskRepo, columnRepo, projectMemberRepo, taskRevisionRepo, taskWatcherRepo, activityService, notificationService, outboxService, transactor)
+	automationService := service.NewAutomationService(automationRuleRepo, automationExecutionRepo, taskRepo, columnRepo, projectMemberRepo, automationEngine, outboxService)
+	taskService := service.NewTaskService(taskRepo, columnRepo, projectRepo, laneRepo, projectMemberRepo, columnTransitionRepo, customFieldRepo, taskRevisionRepo, taskWatcherRepo, automationEngine, activityService, notificationService, outboxService, transactor)
+	checklistService := service.NewChecklistService(checklistItemRepo, taskRepo, projectMemberRepo, outboxService)
+	projectMemberService := service.NewProjectMemberService(projectMemberRepo, userRepo, projectRoleRepo, activityService, outboxService)
+	teamService := service.NewTeamService(teamRepo, projectMemberRepo, projectRoleRepo, activityService, outboxService)
+	invitationService := service.NewInvitationService(invitationRepo, userRepo, projectRepo, projectMemberRepo, activityService, notificationService, outboxService)
+	projectRoleService := service.NewProjectRoleService(projectRoleRepo, outboxService)
+	laneService := service.NewLaneService(laneRepo, outboxService)
+	customFieldService := service.NewCustomFieldService(customFieldRepo, outboxService)
+	columnTransitionService := service.NewColumnTransitionService(columnTransitionRepo, columnRepo, outboxService)
+	taskLinkService := service.NewTaskLinkService(taskLinkRepo, taskRepo, projectAccessService, outboxService)
+	organizationService := service.NewOrganizationService(organizationRepo, organizationMemberRepo, organizationTeamRepo, projectRepo, userRepo)
+	searchService := service.NewSearchService(searchEngine, projectService)
+	savedViewService := service.NewSavedViewService(savedViewRepo, taskService, projectService, outboxService)
+	incomingWebhookService := service.NewIncomingWebhookService(incomingWebhookRepo, columnRepo, taskService, activityService, transactor)
+	outboxDispatcher := service.NewOutboxDispatcher(transactor, outboxRepo, []ports.EventSubscriber{webhookService}, hub, notificationService)
+	trashService := service.NewTrashService(taskRepo, columnRepo, time.Duration(appConfig.TrashRetentionDays)*24*time.Hour)
+
 	go hub.Run()
 
+	// jobs
+	trashPurgeJob := job.NewTrashPurgeJob(trashService, time.Hour)
+	go trashPurgeJob.Run(context.Background())
+
+	automationDueDateJob := job.NewAutomationDueDateJob(automationService, 5*time.Minute)
+	go automationDueDateJob.Run(context.Background())
+
+	notificationReminderJob := job.NewNotificationReminderJob(notificationService, 15*time.Minute)
+	go notificationReminderJob.Run(context.Background())
+
+	emailDigestJob := job.NewEmailDigestJob(emailService, time.Hour)
+	go emailDigestJob.Run(context.Background())
+
+	webhookDeliveryJob := job.NewWebhookDeliveryJob(webhookService, 5*time.Second)
+	go webhookDeliveryJob.Run(context.Background())
+
+	outboxDispatchJob := job.NewOutboxDispatchJob(outboxDispatcher, 500*time.Millisecond, time.Hour)
+	go outboxDispatchJob.Run(context.Background())
+
 	router.GET("/ws", func(c *gin.Context) {
 		ws.ServeWs(hub, c)
 	})
 
 	// middlewares
+	transactionMiddleware := middlewares.NewTransactionMiddleware(outboxService)
+	router.Use(transactionMiddleware.Handle())
+
 	authnMiddleware := middlewares.NewAuthnMiddleware()
-	projectAuthzMiddleware := middlewares.NewProjectAuthzMiddleware(projectMemberService, teamService)
+	projectAuthzMiddleware := middlewares.NewProjectAuthzMiddleware(projectAccessService)
+	organizationAuthzMiddleware := middlewares.NewOrganizationAuthzMiddleware(organizationService)
+	taskKeyMiddleware := middlewares.NewTaskKeyMiddleware(taskService)
 
 	// /users/* routes
 	userHandler := httphandler.NewUserHandler(userService, authnMiddleware)
@@ -72,11 +156,19 @@ func main() {
 	authHandler.RegisterAuthRouter(router)
 
 	// /invitations/* routes
-	invitationHandler := httphandler.NewInvitationHandler(invitationService, authnMiddleware, projectAuthzMiddleware, hub)
+	invitationHandler := httphandler.NewInvitationHandler(invitationService, authnMiddleware, projectAuthzMiddleware)
 	invitationHandler.RegisterInvitationRouter(router)
 
+	// /organizations/* routes
+	organizationHandler := httphandler.NewOrganizationHandler(organizationService, authnMiddleware, organizationAuthzMiddleware)
+	organizationHandler.RegisterOrganizationRouter(router)
+
+	// /search routes
+	searchHandler := httphandler.NewSearchHandler(searchService, authnMiddleware)
+	searchHandler.RegisterSearchRouter(router)
+
 	// /projects/* routes
-	projectHandler := httphandler.NewProjectHandler(projectService, authnMiddleware, projectAuthzMiddleware, hub)
+	projectHandler := httphandler.NewProjectHandler(projectService, authnMiddleware, projectAuthzMiddleware)
 	projectHandler.RegisterProjectRouter(router)
 
 	// /projects/:project_id/members/* routes
@@ -84,17 +176,69 @@ func main() {
 	projectMemberHandler.RegisterProjectMemberRouter(router)
 
 	// /projects/:project_id/teams/* routes
-	teamHandler := httphandler.NewTeamHandler(teamService, authnMiddleware, projectAuthzMiddleware, hub)
+	teamHandler := httphandler.NewTeamHandler(teamService, authnMiddleware, projectAuthzMiddleware)
 	teamHandler.RegisterTeamRouter(router)
 
+	// /projects/:project_id/roles/* routes
+	projectRoleHandler := httphandler.NewProjectRoleHandler(projectRoleService, authnMiddleware, projectAuthzMiddleware)
+	projectRoleHandler.RegisterProjectRoleRouter(router)
+
 	// /projects/:project_id/columns/* routes
-	columnHandler := httphandler.NewColumnHandler(columnService, authnMiddleware, projectAuthzMiddleware, hub)
+	columnHandler := httphandler.NewColumnHandler(columnService, authnMiddleware, projectAuthzMiddleware)
 	columnHandler.RegisterColumnRouter(router)
 
-	// /projects/:project_id/tasks/* routes
-	taskHandler := httphandler.NewTaskHandler(taskService, authnMiddleware, projectAuthzMiddleware, hub)
+	// /projects/:project_id/transitions/* routes
+	columnTransitionHandler := httphandler.NewColumnTransitionHandler(columnTransitionService, authnMiddleware, projectAuthzMiddleware)
+	columnTransitionHandler.RegisterColumnTransitionRouter(router)
+
+	// /projects/:project_id/lanes/* routes
+	laneHandler := httphandler.NewLaneHandler(laneService, authnMiddleware, projectAuthzMiddleware)
+	laneHandler.RegisterLaneRouter(router)
+
+	// /projects/:project_id/custom-fields/* routes
+	customFieldHandler := httphandler.NewCustomFieldHandler(customFieldService, authnMiddleware, projectAuthzMiddleware)
+	customFieldHandler.RegisterCustomFieldRouter(router)
+
+	// /projects/:project_id/automations/* routes
+	automationHandler := httphandler.NewAutomationHandler(automationService, authnMiddleware, projectAuthzMiddleware)
+	automationHandler.RegisterAutomationRouter(router)
+
+	// /projects/:project_id/views/* routes
+	savedViewHandler := httphandler.NewSavedViewHandler(savedViewService, authnMiddleware, projectAuthzMiddleware)
+	savedViewHandler.RegisterSavedViewRouter(router)
+
+	// /projects/:project_id/tasks/* and /tasks/by-key/:key routes
+	taskHandler := httphandler.NewTaskHandler(taskService, authnMiddleware, projectAuthzMiddleware, taskKeyMiddleware)
 	taskHandler.RegisterTaskRouter(router)
 
+	// /projects/:project_id/tasks/:task_id/checklist/* routes
+	checklistHandler := httphandler.NewChecklistHandler(checklistService, taskService, authnMiddleware, projectAuthzMiddleware, taskKeyMiddleware)
+	checklistHandler.RegisterChecklistRouter(router)
+
+	// /projects/:project_id/tasks/:task_id/links/* routes
+	taskLinkHandler := httphandler.NewTaskLinkHandler(taskLinkService, taskService, authnMiddleware, projectAuthzMiddleware, taskKeyMiddleware)
+	taskLinkHandler.RegisterTaskLinkRouter(router)
+
+	// /notifications/* routes
+	notificationHandler := httphandler.NewNotificationHandler(notificationService, emailService, authnMiddleware)
+	notificationHandler.RegisterNotificationRouter(router)
+
+	// /projects/:project_id/tasks/:task_id/revisions/* routes
+	taskRevisionHandler := httphandler.NewTaskRevisionHandler(taskService, authnMiddleware, projectAuthzMiddleware, taskKeyMiddleware)
+	taskRevisionHandler.RegisterTaskRevisionRouter(router)
+
+	// /projects/:project_id/activity and /projects/:project_id/tasks/:task_id/activity routes
+	activityHandler := httphandler.NewActivityHandler(activityService, authnMiddleware, projectAuthzMiddleware, taskKeyMiddleware)
+	activityHandler.RegisterActivityRouter(router)
+
+	// /projects/:project_id/webhooks/* routes
+	webhookHandler := httphandler.NewWebhookHandler(webhookService, authnMiddleware, projectAuthzMiddleware)
+	webhookHandler.RegisterWebhookRouter(router)
+
+	// /projects/:project_id/incoming-webhook/* routes
+	incomingWebhookHandler := httphandler.NewIncomingWebhookHandler(incomingWebhookService, authnMiddleware, projectAuthzMiddleware)
+	incomingWebhookHandler.RegisterIncomingWebhookRouter(router)
+
 	router.Run(fmt.Sprintf(":%s", appConfig.Port))
 
 	gracefulShutdown(router)
@@ -19,11 +19,30 @@ type AppConfig struct {
 	DBPassword string `mapstructure:"DB_PASSWORD" validate:"required"`
 	DBName     string `mapstructure:"DB_NAME" validate:"required"`
 	DBUrl      string `mapstructure:"DB_URL"`
+
+	TrashRetentionDays int `mapstructure:"TRASH_RETENTION_DAYS" validate:"min=1"`
+
+	ApiUrl       string `mapstructure:"API_URL" validate:"required"`
+	MailDriver   string `mapstructure:"MAIL_DRIVER" validate:"oneof=smtp file log"`
+	MailFrom     string `mapstructure:"MAIL_FROM" validate:"required"`
+	MailDir      string `mapstructure:"MAIL_DIR"`
+	SMTPHost     string `mapstructure:"SMTP_HOST" validate:"required_if=MailDriver smtp"`
+	SMTPPort     string `mapstructure:"SMTP_PORT"`
+	SMTPUsername string `mapstructure:"SMTP_USERNAME"`
+	SMTPPassword string `mapstructure:"SMTP_PASSWORD"`
+	DigestHour   int    `mapstructure:"DIGEST_HOUR" validate:"min=0,max=23"`
 }
 
 func Read() *AppConfig {
 	_ = godotenv.Load()
 	viper.AutomaticEnv()
+	viper.SetDefault("TRASH_RETENTION_DAYS", 30)
+	viper.SetDefault("API_URL", "http://localhost:5000")
+	viper.SetDefault("MAIL_DRIVER", "log")
+	viper.SetDefault("MAIL_FROM", "Kanban <no-reply@localhost>")
+	viper.SetDefault("MAIL_DIR", "mail")
+	viper.SetDefault("SMTP_PORT", "587")
+	viper.SetDefault("DIGEST_HOUR", 8)
 
 	var cfg AppConfig
 	BindAllEnv(&cfg)
@@ -52,7 +52,7 @@ func Migrate(db *sql.DB) {
 		column_id UUID NOT NULL,
 		project_id UUID NOT NULL,
 		created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
-		FOREIGN KEY (column_id) REFERENCES columns(id) ON DELETE CASCADE,
+		FOREIGN KEY (column_id) REFERENCES columns(id),
 		FOREIGN KEY (project_id) REFERENCES projects(id) ON DELETE CASCADE
 	)`
 	_, err = db.Exec(query)
@@ -105,4 +105,760 @@ func Migrate(db *sql.DB) {
 	if err != nil {
 		log.Fatal(err)
 	}
+
+	query = `CREATE TABLE IF NOT EXISTS project_roles (
+		id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+		name VARCHAR(255) NOT NULL,
+		project_id UUID NOT NULL,
+		permissions TEXT[] NOT NULL DEFAULT '{}',
+		created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+		FOREIGN KEY (project_id) REFERENCES projects(id) ON DELETE CASCADE,
+		UNIQUE (project_id, name)
+	)`
+	_, err = db.Exec(query)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	query = `ALTER TABLE project_members ADD COLUMN IF NOT EXISTS role_id UUID REFERENCES project_roles(id) ON DELETE SET NULL`
+	_, err = db.Exec(query)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	query = `ALTER TABLE teams ADD COLUMN IF NOT EXISTS role_id UUID REFERENCES project_roles(id) ON DELETE SET NULL`
+	_, err = db.Exec(query)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	query = `CREATE TABLE IF NOT EXISTS organizations (
+		id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+		name VARCHAR(255) NOT NULL,
+		owner_id UUID NOT NULL,
+		created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+		FOREIGN KEY (owner_id) REFERENCES users(id) ON DELETE CASCADE
+	)`
+	_, err = db.Exec(query)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	query = `CREATE TABLE IF NOT EXISTS organization_members (
+		id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+		organization_id UUID NOT NULL,
+		user_id UUID NOT NULL,
+		role VARCHAR(255) NOT NULL,
+		created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+		FOREIGN KEY (organization_id) REFERENCES organizations(id) ON DELETE CASCADE,
+		FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE,
+		UNIQUE (organization_id, user_id)
+	)`
+	_, err = db.Exec(query)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	query = `CREATE TABLE IF NOT EXISTS organization_teams (
+		id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+		name VARCHAR(255) NOT NULL,
+		organization_id UUID NOT NULL,
+		created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+		FOREIGN KEY (organization_id) REFERENCES organizations(id) ON DELETE CASCADE
+	)`
+	_, err = db.Exec(query)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	query = `CREATE TABLE IF NOT EXISTS organization_team_members (
+		team_id UUID NOT NULL,
+		user_id UUID NOT NULL,
+		created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+		PRIMARY KEY (team_id, user_id),
+		FOREIGN KEY (team_id) REFERENCES organization_teams(id) ON DELETE CASCADE,
+		FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+	)`
+	_, err = db.Exec(query)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	query = `ALTER TABLE projects ADD COLUMN IF NOT EXISTS organization_id UUID REFERENCES organizations(id)`
+	_, err = db.Exec(query)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	query = `CREATE TABLE IF NOT EXISTS organization_team_projects (
+		team_id UUID NOT NULL,
+		project_id UUID NOT NULL,
+		role VARCHAR(255) NOT NULL,
+		created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+		PRIMARY KEY (team_id, project_id),
+		FOREIGN KEY (team_id) REFERENCES organization_teams(id) ON DELETE CASCADE,
+		FOREIGN KEY (project_id) REFERENCES projects(id) ON DELETE CASCADE
+	)`
+	_, err = db.Exec(query)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	query = `ALTER TABLE tasks ADD COLUMN IF NOT EXISTS archived_at TIMESTAMP, ADD COLUMN IF NOT EXISTS deleted_at TIMESTAMP`
+	_, err = db.Exec(query)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	query = `ALTER TABLE columns ADD COLUMN IF NOT EXISTS archived_at TIMESTAMP, ADD COLUMN IF NOT EXISTS deleted_at TIMESTAMP`
+	_, err = db.Exec(query)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	query = `CREATE INDEX IF NOT EXISTS idx_tasks_deleted_at ON tasks (deleted_at) WHERE deleted_at IS NOT NULL`
+	_, err = db.Exec(query)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	query = `CREATE INDEX IF NOT EXISTS idx_columns_deleted_at ON columns (deleted_at) WHERE deleted_at IS NOT NULL`
+	_, err = db.Exec(query)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	query = `DO $$
+		BEGIN
+			IF EXISTS (SELECT 1 FROM pg_constraint WHERE conname = 'tasks_column_id_fkey' AND confdeltype = 'c') THEN
+				ALTER TABLE tasks DROP CONSTRAINT tasks_column_id_fkey,
+					ADD CONSTRAINT tasks_column_id_fkey FOREIGN KEY (column_id) REFERENCES columns(id);
+			END IF;
+		END $$`
+	_, err = db.Exec(query)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	query = `ALTER TABLE columns ADD COLUMN IF NOT EXISTS wip_limit INTEGER CHECK (wip_limit > 0)`
+	_, err = db.Exec(query)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	query = `ALTER TABLE projects ADD COLUMN IF NOT EXISTS wip_enforcement VARCHAR(255) NOT NULL DEFAULT 'hard'`
+	_, err = db.Exec(query)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	query = `CREATE INDEX IF NOT EXISTS idx_tasks_column_id ON tasks (column_id)`
+	_, err = db.Exec(query)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	query = `CREATE TABLE IF NOT EXISTS lanes (
+		id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+		name VARCHAR(255) NOT NULL,
+		project_id UUID NOT NULL,
+		position INTEGER NOT NULL DEFAULT 0,
+		created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+		FOREIGN KEY (project_id) REFERENCES projects(id) ON DELETE CASCADE
+	)`
+	_, err = db.Exec(query)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	query = `ALTER TABLE tasks
+		ADD COLUMN IF NOT EXISTS lane_id UUID REFERENCES lanes(id) ON DELETE SET NULL,
+		ADD COLUMN IF NOT EXISTS assignee_id UUID REFERENCES users(id) ON DELETE SET NULL,
+		ADD COLUMN IF NOT EXISTS labels TEXT[] NOT NULL DEFAULT '{}',
+		ADD COLUMN IF NOT EXISTS priority VARCHAR(255) NOT NULL DEFAULT 'none'`
+	_, err = db.Exec(query)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	query = `ALTER TABLE projects ADD COLUMN IF NOT EXISTS lane_grouping VARCHAR(255) NOT NULL DEFAULT 'custom'`
+	_, err = db.Exec(query)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	query = `CREATE TABLE IF NOT EXISTS column_transitions (
+		id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+		project_id UUID NOT NULL,
+		from_column_id UUID NOT NULL,
+		to_column_id UUID NOT NULL,
+		conditions TEXT[] NOT NULL DEFAULT '{}',
+		created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+		FOREIGN KEY (project_id) REFERENCES projects(id) ON DELETE CASCADE,
+		FOREIGN KEY (from_column_id) REFERENCES columns(id) ON DELETE CASCADE,
+		FOREIGN KEY (to_column_id) REFERENCES columns(id) ON DELETE CASCADE,
+		UNIQUE (from_column_id, to_column_id)
+	)`
+	_, err = db.Exec(query)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	query = `ALTER TABLE tasks
+		ADD COLUMN IF NOT EXISTS due_date TIMESTAMPTZ,
+		ADD COLUMN IF NOT EXISTS completed_at TIMESTAMPTZ`
+	_, err = db.Exec(query)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	query = `CREATE INDEX IF NOT EXISTS idx_tasks_due_date ON tasks (due_date) WHERE due_date IS NOT NULL AND completed_at IS NULL`
+	_, err = db.Exec(query)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	query = `CREATE TABLE IF NOT EXISTS automation_rules (
+		id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+		project_id UUID NOT NULL,
+		name VARCHAR(255) NOT NULL,
+		enabled BOOLEAN NOT NULL DEFAULT TRUE,
+		trigger VARCHAR(255) NOT NULL,
+		conditions JSONB NOT NULL DEFAULT '[]',
+		actions JSONB NOT NULL DEFAULT '[]',
+		created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+		FOREIGN KEY (project_id) REFERENCES projects(id) ON DELETE CASCADE
+	)`
+	_, err = db.Exec(query)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	query = `CREATE TABLE IF NOT EXISTS automation_executions (
+		id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+		rule_id UUID NOT NULL,
+		task_id UUID,
+		status VARCHAR(255) NOT NULL,
+		message TEXT,
+		created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+		FOREIGN KEY (rule_id) REFERENCES automation_rules(id) ON DELETE CASCADE,
+		FOREIGN KEY (task_id) REFERENCES tasks(id) ON DELETE SET NULL
+	)`
+	_, err = db.Exec(query)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	query = `CREATE INDEX IF NOT EXISTS idx_automation_executions_rule_id ON automation_executions (rule_id, created_at DESC)`
+	_, err = db.Exec(query)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	query = `CREATE TABLE IF NOT EXISTS checklist_items (
+		id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+		task_id UUID NOT NULL,
+		text VARCHAR(255) NOT NULL,
+		done BOOLEAN NOT NULL DEFAULT FALSE,
+		assignee_id UUID REFERENCES users(id) ON DELETE SET NULL,
+		due_date TIMESTAMPTZ,
+		position INTEGER NOT NULL DEFAULT 0,
+		created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+		FOREIGN KEY (task_id) REFERENCES tasks(id) ON DELETE CASCADE
+	)`
+	_, err = db.Exec(query)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	query = `CREATE INDEX IF NOT EXISTS idx_checklist_items_task_id ON checklist_items (task_id, position)`
+	_, err = db.Exec(query)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	query = `ALTER TABLE columns ADD COLUMN IF NOT EXISTS category VARCHAR(255) NOT NULL DEFAULT 'active'`
+	_, err = db.Exec(query)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	query = `ALTER TABLE projects ADD COLUMN IF NOT EXISTS blocker_enforcement VARCHAR(255) NOT NULL DEFAULT 'none'`
+	_, err = db.Exec(query)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	query = `CREATE TABLE IF NOT EXISTS task_links (
+		id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+		source_task_id UUID NOT NULL,
+		target_task_id UUID NOT NULL,
+		type VARCHAR(255) NOT NULL,
+		created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+		FOREIGN KEY (source_task_id) REFERENCES tasks(id) ON DELETE CASCADE,
+		FOREIGN KEY (target_task_id) REFERENCES tasks(id) ON DELETE CASCADE,
+		UNIQUE (source_task_id, target_task_id, type),
+		CHECK (source_task_id <> target_task_id)
+	)`
+	_, err = db.Exec(query)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	query = `CREATE INDEX IF NOT EXISTS idx_task_links_target_task_id ON task_links (target_task_id, type)`
+	_, err = db.Exec(query)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	query = `ALTER TABLE projects
+		ADD COLUMN IF NOT EXISTS key VARCHAR(10),
+		ADD COLUMN IF NOT EXISTS task_sequence INTEGER NOT NULL DEFAULT 0`
+	_, err = db.Exec(query)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	query = `UPDATE projects SET key = 'P' || UPPER(SUBSTRING(REPLACE(id::text, '-', ''), 1, 6)) WHERE key IS NULL`
+	_, err = db.Exec(query)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	query = `ALTER TABLE projects ALTER COLUMN key SET NOT NULL`
+	_, err = db.Exec(query)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	query = `CREATE UNIQUE INDEX IF NOT EXISTS idx_projects_key ON projects (key)`
+	_, err = db.Exec(query)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	query = `ALTER TABLE tasks ADD COLUMN IF NOT EXISTS number INTEGER`
+	_, err = db.Exec(query)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	query = `UPDATE tasks SET number = numbered.number
+		FROM (SELECT id, ROW_NUMBER() OVER (PARTITION BY project_id ORDER BY created_at, id) AS number FROM tasks) numbered
+		WHERE tasks.id = numbered.id AND tasks.number IS NULL`
+	_, err = db.Exec(query)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	query = `UPDATE projects SET task_sequence = numbered.max_number
+		FROM (SELECT project_id, MAX(number) AS max_number FROM tasks GROUP BY project_id) numbered
+		WHERE projects.id = numbered.project_id AND projects.task_sequence < numbered.max_number`
+	_, err = db.Exec(query)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	query = `ALTER TABLE tasks ALTER COLUMN number SET NOT NULL`
+	_, err = db.Exec(query)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	query = `CREATE UNIQUE INDEX IF NOT EXISTS idx_tasks_project_id_number ON tasks (project_id, number)`
+	_, err = db.Exec(query)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	query = `ALTER TABLE tasks ADD COLUMN IF NOT EXISTS estimate NUMERIC(6, 2) CHECK (estimate > 0)`
+	_, err = db.Exec(query)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	query = `CREATE INDEX IF NOT EXISTS idx_tasks_priority ON tasks (priority) WHERE archived_at IS NULL AND deleted_at IS NULL`
+	_, err = db.Exec(query)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	query = `CREATE TABLE IF NOT EXISTS custom_fields (
+		id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+		project_id UUID NOT NULL,
+		name VARCHAR(255) NOT NULL,
+		type VARCHAR(20) NOT NULL,
+		required BOOLEAN NOT NULL DEFAULT FALSE,
+		options TEXT[] NOT NULL DEFAULT '{}',
+		min_value DOUBLE PRECISION,
+		max_value DOUBLE PRECISION,
+		max_length INTEGER,
+		position INTEGER NOT NULL DEFAULT 0,
+		created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+		FOREIGN KEY (project_id) REFERENCES projects(id) ON DELETE CASCADE
+	)`
+	_, err = db.Exec(query)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	query = `ALTER TABLE tasks ADD COLUMN IF NOT EXISTS custom_fields JSONB NOT NULL DEFAULT '{}'`
+	_, err = db.Exec(query)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	query = `CREATE INDEX IF NOT EXISTS idx_tasks_custom_fields ON tasks USING GIN (custom_fields jsonb_path_ops)`
+	_, err = db.Exec(query)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	query = `CREATE INDEX IF NOT EXISTS idx_tasks_project_id_created_at ON tasks (project_id, created_at, id) WHERE archived_at IS NULL AND deleted_at IS NULL`
+	_, err = db.Exec(query)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	query = `CREATE INDEX IF NOT EXISTS idx_tasks_project_id_assignee_id ON tasks (project_id, assignee_id)`
+	_, err = db.Exec(query)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	query = `CREATE INDEX IF NOT EXISTS idx_tasks_project_id_due_date ON tasks (project_id, due_date)`
+	_, err = db.Exec(query)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	query = `CREATE INDEX IF NOT EXISTS idx_tasks_labels ON tasks USING GIN (labels)`
+	_, err = db.Exec(query)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	query = `ALTER TABLE tasks ADD COLUMN IF NOT EXISTS search_vector TSVECTOR GENERATED ALWAYS AS (
+		setweight(to_tsvector('simple', title), 'A') || setweight(to_tsvector('simple', COALESCE(content, '')), 'B')
+	) STORED`
+	_, err = db.Exec(query)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	query = `CREATE INDEX IF NOT EXISTS idx_tasks_search_vector ON tasks USING GIN (search_vector)`
+	_, err = db.Exec(query)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	query = `DROP INDEX IF EXISTS idx_tasks_text`
+	_, err = db.Exec(query)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	query = `ALTER TABLE projects ADD COLUMN IF NOT EXISTS search_vector TSVECTOR GENERATED ALWAYS AS (
+		setweight(to_tsvector('simple', key), 'A') || setweight(to_tsvector('simple', name), 'A')
+	) STORED`
+	_, err = db.Exec(query)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	query = `CREATE INDEX IF NOT EXISTS idx_projects_search_vector ON projects USING GIN (search_vector)`
+	_, err = db.Exec(query)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	query = `CREATE TABLE IF NOT EXISTS saved_views (
+		id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+		project_id UUID NOT NULL,
+		owner_id UUID NOT NULL,
+		name VARCHAR(255) NOT NULL,
+		visibility VARCHAR(20) NOT NULL DEFAULT 'personal',
+		filter JSONB NOT NULL DEFAULT '{}',
+		grouping VARCHAR(20) NOT NULL DEFAULT '',
+		visible_fields TEXT[] NOT NULL DEFAULT '{}',
+		created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+		updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+		FOREIGN KEY (project_id) REFERENCES projects(id) ON DELETE CASCADE,
+		FOREIGN KEY (owner_id) REFERENCES users(id) ON DELETE CASCADE
+	)`
+	_, err = db.Exec(query)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	query = `CREATE INDEX IF NOT EXISTS idx_saved_views_project_id ON saved_views (project_id, visibility, owner_id)`
+	_, err = db.Exec(query)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	query = `CREATE TABLE IF NOT EXISTS activities (
+		id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+		project_id UUID NOT NULL,
+		actor_id UUID,
+		entity_type VARCHAR(30) NOT NULL,
+		entity_id UUID NOT NULL,
+		action VARCHAR(30) NOT NULL,
+		changes JSONB NOT NULL DEFAULT '{}',
+		created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+	)`
+	_, err = db.Exec(query)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	query = `CREATE INDEX IF NOT EXISTS idx_activities_project_id ON activities (project_id, created_at DESC, id DESC)`
+	_, err = db.Exec(query)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	query = `CREATE INDEX IF NOT EXISTS idx_activities_entity ON activities (entity_type, entity_id, created_at DESC, id DESC)`
+	_, err = db.Exec(query)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	query = `CREATE TABLE IF NOT EXISTS task_revisions (
+		id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+		task_id UUID NOT NULL,
+		project_id UUID NOT NULL,
+		number INTEGER NOT NULL,
+		actor_id UUID,
+		state JSONB NOT NULL,
+		created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+		UNIQUE (task_id, number),
+		FOREIGN KEY (task_id) REFERENCES tasks(id) ON DELETE CASCADE,
+		FOREIGN KEY (project_id) REFERENCES projects(id) ON DELETE CASCADE
+	)`
+	_, err = db.Exec(query)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	query = `CREATE TABLE IF NOT EXISTS notifications (
+		id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+		recipient_id UUID NOT NULL,
+		actor_id UUID,
+		project_id UUID NOT NULL,
+		type VARCHAR(40) NOT NULL,
+		entity_type VARCHAR(30) NOT NULL,
+		entity_id UUID NOT NULL,
+		message TEXT NOT NULL,
+		read_at TIMESTAMP,
+		created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+		FOREIGN KEY (recipient_id) REFERENCES users(id) ON DELETE CASCADE,
+		FOREIGN KEY (project_id) REFERENCES projects(id) ON DELETE CASCADE
+	)`
+	_, err = db.Exec(query)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	query = `CREATE INDEX IF NOT EXISTS idx_notifications_recipient ON notifications (recipient_id, created_at DESC, id DESC)`
+	_, err = db.Exec(query)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	query = `CREATE INDEX IF NOT EXISTS idx_notifications_unread ON notifications (recipient_id) WHERE read_at IS NULL`
+	_, err = db.Exec(query)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	query = `CREATE INDEX IF NOT EXISTS idx_notifications_entity ON notifications (entity_id, type)`
+	_, err = db.Exec(query)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	query = `CREATE TABLE IF NOT EXISTS notification_preferences (
+		user_id UUID NOT NULL,
+		type VARCHAR(50) NOT NULL,
+		email_delivery VARCHAR(20) NOT NULL,
+		updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+		PRIMARY KEY (user_id, type),
+		FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+	)`
+	_, err = db.Exec(query)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	query = `CREATE TABLE IF NOT EXISTS email_digests (
+		user_id UUID PRIMARY KEY,
+		sent_at TIMESTAMP NOT NULL,
+		FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+	)`
+	_, err = db.Exec(query)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	query = `ALTER TABLE tasks ADD COLUMN IF NOT EXISTS mentions JSONB NOT NULL DEFAULT '[]'`
+	_, err = db.Exec(query)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	query = `CREATE TABLE IF NOT EXISTS task_watchers (
+		task_id UUID NOT NULL,
+		user_id UUID NOT NULL,
+		created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+		PRIMARY KEY (task_id, user_id),
+		FOREIGN KEY (task_id) REFERENCES tasks(id) ON DELETE CASCADE,
+		FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+	)`
+	_, err = db.Exec(query)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	query = `CREATE INDEX IF NOT EXISTS idx_task_watchers_user ON task_watchers (user_id)`
+	_, err = db.Exec(query)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	query = `CREATE TABLE IF NOT EXISTS webhooks (
+		id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+		project_id UUID NOT NULL,
+		url TEXT NOT NULL,
+		secret VARCHAR(255) NOT NULL,
+		events TEXT[] NOT NULL DEFAULT '{}',
+		active BOOLEAN NOT NULL DEFAULT TRUE,
+		created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+		FOREIGN KEY (project_id) REFERENCES projects(id) ON DELETE CASCADE
+	)`
+	_, err = db.Exec(query)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	query = `CREATE INDEX IF NOT EXISTS idx_webhooks_project ON webhooks (project_id)`
+	_, err = db.Exec(query)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	query = `CREATE TABLE IF NOT EXISTS webhook_deliveries (
+		id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+		webhook_id UUID NOT NULL,
+		project_id UUID NOT NULL,
+		event VARCHAR(100) NOT NULL,
+		payload BYTEA NOT NULL,
+		status VARCHAR(20) NOT NULL DEFAULT 'pending',
+		attempts INTEGER NOT NULL DEFAULT 0,
+		response_status INTEGER,
+		response_body TEXT,
+		error TEXT,
+		next_attempt_at TIMESTAMP,
+		delivered_at TIMESTAMP,
+		created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+		FOREIGN KEY (webhook_id) REFERENCES webhooks(id) ON DELETE CASCADE,
+		FOREIGN KEY (project_id) REFERENCES projects(id) ON DELETE CASCADE
+	)`
+	_, err = db.Exec(query)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	query = `CREATE INDEX IF NOT EXISTS idx_webhook_deliveries_webhook ON webhook_deliveries (webhook_id, created_at DESC, id DESC)`
+	_, err = db.Exec(query)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	query = `CREATE INDEX IF NOT EXISTS idx_webhook_deliveries_due ON webhook_deliveries (next_attempt_at) WHERE status = 'pending'`
+	_, err = db.Exec(query)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	query = `CREATE TABLE IF NOT EXISTS incoming_webhooks (
+		project_id UUID PRIMARY KEY,
+		secret VARCHAR(255) NOT NULL,
+		target_column_id UUID,
+		created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+		FOREIGN KEY (project_id) REFERENCES projects(id) ON DELETE CASCADE,
+		FOREIGN KEY (target_column_id) REFERENCES columns(id) ON DELETE SET NULL
+	)`
+	_, err = db.Exec(query)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	query = `CREATE TABLE IF NOT EXISTS outbox_events (
+		id BIGSERIAL PRIMARY KEY,
+		project_id UUID,
+		recipient_id UUID,
+		name VARCHAR(100) NOT NULL,
+		payload BYTEA NOT NULL,
+		status VARCHAR(20) NOT NULL DEFAULT 'pending',
+		attempts INTEGER NOT NULL DEFAULT 0,
+		error TEXT,
+		available_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+		published_at TIMESTAMP,
+		created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+	)`
+	_, err = db.Exec(query)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	query = `ALTER TABLE outbox_events ALTER COLUMN project_id DROP NOT NULL`
+	_, err = db.Exec(query)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	query = `ALTER TABLE projects ADD COLUMN IF NOT EXISTS event_sequence BIGINT NOT NULL DEFAULT 0`
+	_, err = db.Exec(query)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	query = `ALTER TABLE outbox_events ADD COLUMN IF NOT EXISTS sequence BIGINT`
+	_, err = db.Exec(query)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	query = `UPDATE outbox_events SET sequence = id WHERE sequence IS NULL`
+	_, err = db.Exec(query)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	query = `UPDATE projects SET event_sequence = sequenced.max_sequence
+		FROM (SELECT project_id, MAX(sequence) AS max_sequence FROM outbox_events GROUP BY project_id) sequenced
+		WHERE projects.id = sequenced.project_id AND projects.event_sequence < sequenced.max_sequence`
+	_, err = db.Exec(query)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	query = `ALTER TABLE outbox_events ALTER COLUMN sequence SET NOT NULL`
+	_, err = db.Exec(query)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	query = `DROP INDEX IF EXISTS idx_outbox_events_pending`
+	_, err = db.Exec(query)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	query = `CREATE UNIQUE INDEX IF NOT EXISTS idx_outbox_events_project_sequence ON outbox_events (project_id, sequence)`
+	_, err = db.Exec(query)
+	if err != nil {
+		log.Fatal(err)
+	}
 }
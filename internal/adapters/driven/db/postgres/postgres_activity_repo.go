@@ -0,0 +1,124 @@
+package db
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/fatihsen-dev/kanban-backend/internal/core/domain"
+	ports "github.com/fatihsen-dev/kanban-backend/internal/core/ports/driven"
+)
+
+const activitySelectFields = `id, project_id, actor_id, entity_type, entity_id, action, changes, created_at`
+
+type PostgresActivityRepository struct {
+	PostgresRepository
+}
+
+func NewPostgresActivityRepo(baseRepo *PostgresRepository) ports.ActivityRepository {
+	return &PostgresActivityRepository{PostgresRepository: *baseRepo}
+}
+
+type activityChangeRecord struct {
+	Before interface{} `json:"before"`
+	After  interface{} `json:"after"`
+}
+
+func scanActivity(row rowScanner) (*domain.Activity, error) {
+	var activity domain.Activity
+	var changes []byte
+	err := row.Scan(&activity.ID, &activity.ProjectID, &activity.ActorID, &activity.EntityType, &activity.EntityID, &activity.Action, &changes, &activity.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	records := map[string]activityChangeRecord{}
+	if err := json.Unmarshal(changes, &records); err != nil {
+		return nil, err
+	}
+
+	activity.Changes = make(map[string]domain.FieldChange, len(records))
+	for field, record := range records {
+		activity.Changes[field] = domain.FieldChange{Before: record.Before, After: record.After}
+	}
+
+	return &activity, nil
+}
+
+func (r *PostgresActivityRepository) Save(ctx context.Context, activity *domain.Activity) error {
+	records := make(map[string]activityChangeRecord, len(activity.Changes))
+	for field, change := range activity.Changes {
+		records[field] = activityChangeRecord{Before: change.Before, After: change.After}
+	}
+
+	changes, err := json.Marshal(records)
+	if err != nil {
+		return err
+	}
+
+	query := `INSERT INTO activities (project_id, actor_id, entity_type, entity_id, action, changes)
+		VALUES ($1, $2, $3, $4, $5, $6) RETURNING id, created_at`
+	return r.DB.QueryRowContext(ctx, query, activity.ProjectID, activity.ActorID, activity.EntityType, activity.EntityID, activity.Action, changes).Scan(&activity.ID, &activity.CreatedAt)
+}
+
+func (r *PostgresActivityRepository) Query(ctx context.Context, filter *domain.ActivityFilter) (*domain.ActivityPage, error) {
+	whereClauses := []string{"project_id = $1"}
+	args := []interface{}{filter.ProjectID}
+	paramIndex := 2
+
+	if filter.EntityType != "" {
+		whereClauses = append(whereClauses, fmt.Sprintf("entity_type = $%d", paramIndex))
+		args = append(args, filter.EntityType)
+		paramIndex++
+	}
+
+	if filter.EntityID != "" {
+		whereClauses = append(whereClauses, fmt.Sprintf("entity_id = $%d", paramIndex))
+		args = append(args, filter.EntityID)
+		paramIndex++
+	}
+
+	if filter.Cursor != "" {
+		cursor, err := decodeTimeCursor(filter.Cursor)
+		if err != nil {
+			return nil, domain.ErrInvalidPageCursor
+		}
+		whereClauses = append(whereClauses, fmt.Sprintf("(created_at, id) < ($%d::timestamp, $%d::uuid)", paramIndex, paramIndex+1))
+		args = append(args, cursor.CreatedAt, cursor.ID)
+		paramIndex += 2
+	}
+
+	pageSize := filter.PageSize()
+	args = append(args, pageSize+1)
+
+	query := `SELECT ` + activitySelectFields + ` FROM activities WHERE ` + strings.Join(whereClauses, " AND ") +
+		fmt.Sprintf(" ORDER BY created_at DESC, id DESC LIMIT $%d", paramIndex)
+
+	rows, err := r.DB.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	activities := []*domain.Activity{}
+	for rows.Next() {
+		activity, err := scanActivity(rows)
+		if err != nil {
+			return nil, err
+		}
+		activities = append(activities, activity)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	page := &domain.ActivityPage{Activities: activities}
+	if len(activities) > pageSize {
+		page.Activities = activities[:pageSize]
+		last := page.Activities[pageSize-1]
+		page.NextCursor = encodeTimeCursor(last.CreatedAt, last.ID)
+	}
+
+	return page, nil
+}
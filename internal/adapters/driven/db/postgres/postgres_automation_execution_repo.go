@@ -0,0 +1,41 @@
+package db
+
+import (
+	"context"
+
+	"github.com/fatihsen-dev/kanban-backend/internal/core/domain"
+	ports "github.com/fatihsen-dev/kanban-backend/internal/core/ports/driven"
+)
+
+type PostgresAutomationExecutionRepository struct {
+	PostgresRepository
+}
+
+func NewPostgresAutomationExecutionRepo(baseRepo *PostgresRepository) ports.AutomationExecutionRepository {
+	return &PostgresAutomationExecutionRepository{PostgresRepository: *baseRepo}
+}
+
+func (r *PostgresAutomationExecutionRepository) Save(ctx context.Context, execution *domain.AutomationExecution) error {
+	query := `INSERT INTO automation_executions (rule_id, task_id, status, message) VALUES ($1, $2, $3, $4) RETURNING id, created_at`
+	return r.DB.QueryRowContext(ctx, query, execution.RuleID, execution.TaskID, execution.Status, execution.Message).Scan(&execution.ID, &execution.CreatedAt)
+}
+
+func (r *PostgresAutomationExecutionRepository) GetExecutionsByRuleID(ctx context.Context, ruleID string, limit int) ([]*domain.AutomationExecution, error) {
+	query := `SELECT id, rule_id, task_id, status, message, created_at FROM automation_executions WHERE rule_id = $1 ORDER BY created_at DESC LIMIT $2`
+	rows, err := r.DB.QueryContext(ctx, query, ruleID, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var executions []*domain.AutomationExecution
+	for rows.Next() {
+		var execution domain.AutomationExecution
+		err := rows.Scan(&execution.ID, &execution.RuleID, &execution.TaskID, &execution.Status, &execution.Message, &execution.CreatedAt)
+		if err != nil {
+			return nil, err
+		}
+		executions = append(executions, &execution)
+	}
+	return executions, rows.Err()
+}
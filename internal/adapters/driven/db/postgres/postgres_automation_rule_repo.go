@@ -0,0 +1,154 @@
+package db
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/fatihsen-dev/kanban-backend/internal/core/domain"
+	ports "github.com/fatihsen-dev/kanban-backend/internal/core/ports/driven"
+)
+
+const automationRuleSelectFields = `id, project_id, name, enabled, trigger, conditions, actions, created_at`
+
+type PostgresAutomationRuleRepository struct {
+	PostgresRepository
+}
+
+func NewPostgresAutomationRuleRepo(baseRepo *PostgresRepository) ports.AutomationRuleRepository {
+	return &PostgresAutomationRuleRepository{PostgresRepository: *baseRepo}
+}
+
+type automationConditionRecord struct {
+	Type  string `json:"type"`
+	Value string `json:"value,omitempty"`
+}
+
+type automationActionRecord struct {
+	Type  string `json:"type"`
+	Value string `json:"value,omitempty"`
+}
+
+func scanAutomationRule(row rowScanner) (*domain.AutomationRule, error) {
+	var rule domain.AutomationRule
+	var conditions, actions []byte
+	err := row.Scan(&rule.ID, &rule.ProjectID, &rule.Name, &rule.Enabled, &rule.Trigger, &conditions, &actions, &rule.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	var conditionRecords []automationConditionRecord
+	if err := json.Unmarshal(conditions, &conditionRecords); err != nil {
+		return nil, err
+	}
+	for _, record := range conditionRecords {
+		rule.Conditions = append(rule.Conditions, domain.AutomationCondition{Type: domain.AutomationConditionType(record.Type), Value: record.Value})
+	}
+
+	var actionRecords []automationActionRecord
+	if err := json.Unmarshal(actions, &actionRecords); err != nil {
+		return nil, err
+	}
+	for _, record := range actionRecords {
+		rule.Actions = append(rule.Actions, domain.AutomationAction{Type: domain.AutomationActionType(record.Type), Value: record.Value})
+	}
+
+	return &rule, nil
+}
+
+func marshalAutomationConditions(conditions []domain.AutomationCondition) ([]byte, error) {
+	records := make([]automationConditionRecord, len(conditions))
+	for i, condition := range conditions {
+		records[i] = automationConditionRecord{Type: string(condition.Type), Value: condition.Value}
+	}
+	return json.Marshal(records)
+}
+
+func marshalAutomationActions(actions []domain.AutomationAction) ([]byte, error) {
+	records := make([]automationActionRecord, len(actions))
+	for i, action := range actions {
+		records[i] = automationActionRecord{Type: string(action.Type), Value: action.Value}
+	}
+	return json.Marshal(records)
+}
+
+func (r *PostgresAutomationRuleRepository) queryRules(ctx context.Context, query string, args ...interface{}) ([]*domain.AutomationRule, error) {
+	rows, err := r.DB.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var rules []*domain.AutomationRule
+	for rows.Next() {
+		rule, err := scanAutomationRule(rows)
+		if err != nil {
+			return nil, err
+		}
+		rules = append(rules, rule)
+	}
+	return rules, rows.Err()
+}
+
+func (r *PostgresAutomationRuleRepository) Save(ctx context.Context, rule *domain.AutomationRule) error {
+	conditions, err := marshalAutomationConditions(rule.Conditions)
+	if err != nil {
+		return err
+	}
+
+	actions, err := marshalAutomationActions(rule.Actions)
+	if err != nil {
+		return err
+	}
+
+	query := `INSERT INTO automation_rules (project_id, name, enabled, trigger, conditions, actions) VALUES ($1, $2, $3, $4, $5, $6) RETURNING id, created_at`
+	return r.DB.QueryRowContext(ctx, query, rule.ProjectID, rule.Name, rule.Enabled, rule.Trigger, conditions, actions).Scan(&rule.ID, &rule.CreatedAt)
+}
+
+func (r *PostgresAutomationRuleRepository) GetByID(ctx context.Context, id string) (*domain.AutomationRule, error) {
+	query := `SELECT ` + automationRuleSelectFields + ` FROM automation_rules WHERE id = $1`
+	return scanAutomationRule(r.DB.QueryRowContext(ctx, query, id))
+}
+
+func (r *PostgresAutomationRuleRepository) GetRulesByProjectID(ctx context.Context, projectID string) ([]*domain.AutomationRule, error) {
+	query := `SELECT ` + automationRuleSelectFields + ` FROM automation_rules WHERE project_id = $1 ORDER BY created_at ASC`
+	return r.queryRules(ctx, query, projectID)
+}
+
+func (r *PostgresAutomationRuleRepository) GetEnabledRulesByTrigger(ctx context.Context, projectID string, trigger domain.AutomationTrigger) ([]*domain.AutomationRule, error) {
+	query := `SELECT ` + automationRuleSelectFields + ` FROM automation_rules WHERE project_id = $1 AND trigger = $2 AND enabled ORDER BY created_at ASC`
+	return r.queryRules(ctx, query, projectID, trigger)
+}
+
+func (r *PostgresAutomationRuleRepository) GetAllEnabledRulesByTrigger(ctx context.Context, trigger domain.AutomationTrigger) ([]*domain.AutomationRule, error) {
+	query := `SELECT ` + automationRuleSelectFields + ` FROM automation_rules WHERE trigger = $1 AND enabled ORDER BY created_at ASC`
+	return r.queryRules(ctx, query, trigger)
+}
+
+func (r *PostgresAutomationRuleRepository) Update(ctx context.Context, rule *domain.AutomationRule) error {
+	conditions, err := marshalAutomationConditions(rule.Conditions)
+	if err != nil {
+		return err
+	}
+
+	actions, err := marshalAutomationActions(rule.Actions)
+	if err != nil {
+		return err
+	}
+
+	query := `UPDATE automation_rules SET name = $1, enabled = $2, trigger = $3, conditions = $4, actions = $5 WHERE id = $6`
+	_, err = r.DB.ExecContext(ctx, query, rule.Name, rule.Enabled, rule.Trigger, conditions, actions, rule.ID)
+	if err != nil {
+		return fmt.Errorf("automation rule update failed: %w", err)
+	}
+	return nil
+}
+
+func (r *PostgresAutomationRuleRepository) DeleteByID(ctx context.Context, id string) error {
+	query := `DELETE FROM automation_rules WHERE id = $1`
+	_, err := r.DB.ExecContext(ctx, query, id)
+	if err != nil {
+		return err
+	}
+	return nil
+}
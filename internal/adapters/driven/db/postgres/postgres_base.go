@@ -1,6 +1,7 @@
 package db
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 	"log"
@@ -9,8 +10,19 @@ import (
 	_ "github.com/lib/pq"
 )
 
+type txContextKey struct{}
+
 type PostgresRepository struct {
-	DB *sql.DB
+	DB *Conn
+}
+
+type Conn struct {
+	*sql.DB
+}
+
+type Tx struct {
+	*sql.Tx
+	nested bool
 }
 
 func NewPostgresRepository(connStr string) *PostgresRepository {
@@ -48,9 +60,67 @@ func NewPostgresRepository(connStr string) *PostgresRepository {
 
 	Migrate(db)
 
-	return &PostgresRepository{DB: db}
+	return &PostgresRepository{DB: &Conn{DB: db}}
 }
 
 func (r *PostgresRepository) Close() error {
 	return r.DB.Close()
 }
+
+func (c *Conn) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error) {
+	if tx, ok := ctx.Value(txContextKey{}).(*sql.Tx); ok {
+		return &Tx{Tx: tx, nested: true}, nil
+	}
+
+	tx, err := c.DB.BeginTx(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	return &Tx{Tx: tx}, nil
+}
+
+func (c *Conn) ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error) {
+	if tx, ok := ctx.Value(txContextKey{}).(*sql.Tx); ok {
+		return tx.ExecContext(ctx, query, args...)
+	}
+	return c.DB.ExecContext(ctx, query, args...)
+}
+
+func (c *Conn) QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error) {
+	if tx, ok := ctx.Value(txContextKey{}).(*sql.Tx); ok {
+		return tx.QueryContext(ctx, query, args...)
+	}
+	return c.DB.QueryContext(ctx, query, args...)
+}
+
+func (c *Conn) QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row {
+	if tx, ok := ctx.Value(txContextKey{}).(*sql.Tx); ok {
+		return tx.QueryRowContext(ctx, query, args...)
+	}
+	return c.DB.QueryRowContext(ctx, query, args...)
+}
+
+func (t *Tx) Commit() error {
+	if t.nested {
+		return nil
+	}
+	return t.Tx.Commit()
+}
+
+func (t *Tx) Rollback() error {
+	if t.nested {
+		return nil
+	}
+	return t.Tx.Rollback()
+}
+
+func checkRowsAffected(result sql.Result) error {
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return sql.ErrNoRows
+	}
+	return nil
+}
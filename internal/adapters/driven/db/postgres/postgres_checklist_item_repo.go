@@ -0,0 +1,101 @@
+package db
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/fatihsen-dev/kanban-backend/internal/core/domain"
+	ports "github.com/fatihsen-dev/kanban-backend/internal/core/ports/driven"
+)
+
+const checklistItemSelectFields = `id, task_id, text, done, assignee_id, due_date, position, created_at`
+
+type PostgresChecklistItemRepository struct {
+	PostgresRepository
+}
+
+func NewPostgresChecklistItemRepo(baseRepo *PostgresRepository) ports.ChecklistItemRepository {
+	return &PostgresChecklistItemRepository{PostgresRepository: *baseRepo}
+}
+
+func scanChecklistItem(row rowScanner) (*domain.ChecklistItem, error) {
+	var item domain.ChecklistItem
+	err := row.Scan(&item.ID, &item.TaskID, &item.Text, &item.Done, &item.AssigneeID, &item.DueDate, &item.Position, &item.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+	return &item, nil
+}
+
+func (r *PostgresChecklistItemRepository) Save(ctx context.Context, item *domain.ChecklistItem) error {
+	query := `INSERT INTO checklist_items (task_id, text, assignee_id, due_date, position) VALUES ($1, $2, $3, $4, (SELECT COALESCE(MAX(position) + 1, 0) FROM checklist_items WHERE task_id = $1)) RETURNING ` + checklistItemSelectFields
+	savedItem, err := scanChecklistItem(r.DB.QueryRowContext(ctx, query, item.TaskID, item.Text, item.AssigneeID, item.DueDate))
+	if err != nil {
+		return err
+	}
+	*item = *savedItem
+	return nil
+}
+
+func (r *PostgresChecklistItemRepository) GetByID(ctx context.Context, id string) (*domain.ChecklistItem, error) {
+	query := `SELECT ` + checklistItemSelectFields + ` FROM checklist_items WHERE id = $1`
+	return scanChecklistItem(r.DB.QueryRowContext(ctx, query, id))
+}
+
+func (r *PostgresChecklistItemRepository) GetItemsByTaskID(ctx context.Context, taskID string) ([]*domain.ChecklistItem, error) {
+	query := `SELECT ` + checklistItemSelectFields + ` FROM checklist_items WHERE task_id = $1 ORDER BY position ASC, created_at ASC`
+	rows, err := r.DB.QueryContext(ctx, query, taskID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var items []*domain.ChecklistItem
+	for rows.Next() {
+		item, err := scanChecklistItem(rows)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, item)
+	}
+	return items, rows.Err()
+}
+
+func (r *PostgresChecklistItemRepository) Update(ctx context.Context, item *domain.ChecklistItem) error {
+	query := `UPDATE checklist_items SET text = $1, done = $2, assignee_id = $3, due_date = $4 WHERE id = $5`
+	_, err := r.DB.ExecContext(ctx, query, item.Text, item.Done, item.AssigneeID, item.DueDate, item.ID)
+	if err != nil {
+		return fmt.Errorf("checklist item update failed: %w", err)
+	}
+	return nil
+}
+
+func (r *PostgresChecklistItemRepository) Reorder(ctx context.Context, taskID string, itemIDs []string) error {
+	tx, err := r.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	query := `UPDATE checklist_items SET position = $1 WHERE id = $2 AND task_id = $3`
+	for position, itemID := range itemIDs {
+		result, err := tx.ExecContext(ctx, query, position, itemID, taskID)
+		if err != nil {
+			return err
+		}
+		if err := checkRowsAffected(result); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+func (r *PostgresChecklistItemRepository) DeleteByID(ctx context.Context, id string) error {
+	query := `DELETE FROM checklist_items WHERE id = $1`
+	_, err := r.DB.ExecContext(ctx, query, id)
+	if err != nil {
+		return err
+	}
+	return nil
+}
@@ -2,8 +2,10 @@ package db
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/fatihsen-dev/kanban-backend/internal/core/domain"
 	ports "github.com/fatihsen-dev/kanban-backend/internal/core/ports/driven"
@@ -18,8 +20,8 @@ func NewPostgresColumnRepo(baseRepo *PostgresRepository) ports.ColumnRepository
 }
 
 func (r *PostgresColumnRepository) Save(ctx context.Context, column *domain.Column) error {
-	query := `INSERT INTO columns (name, color, project_id) VALUES ($1, $2, $3) RETURNING id, name, color, project_id, created_at`
-	err := r.DB.QueryRowContext(ctx, query, column.Name, column.Color, column.ProjectID).Scan(&column.ID, &column.Name, &column.Color, &column.ProjectID, &column.CreatedAt)
+	query := `INSERT INTO columns (name, color, project_id, wip_limit, category) VALUES ($1, $2, $3, $4, COALESCE(NULLIF($5, ''), 'active')) RETURNING id, name, color, project_id, wip_limit, category, archived_at, deleted_at, created_at`
+	err := r.DB.QueryRowContext(ctx, query, column.Name, column.Color, column.ProjectID, column.WipLimit, column.Category).Scan(&column.ID, &column.Name, &column.Color, &column.ProjectID, &column.WipLimit, &column.Category, &column.ArchivedAt, &column.DeletedAt, &column.CreatedAt)
 	if err != nil {
 		return err
 	}
@@ -27,17 +29,34 @@ func (r *PostgresColumnRepository) Save(ctx context.Context, column *domain.Colu
 }
 
 func (r *PostgresColumnRepository) GetByID(ctx context.Context, id string) (*domain.Column, error) {
-	query := `SELECT id, name, color, project_id, created_at FROM columns WHERE id = $1`
+	query := `SELECT id, name, color, project_id, wip_limit, category, (SELECT COUNT(*) FROM tasks WHERE tasks.column_id = columns.id AND tasks.archived_at IS NULL AND tasks.deleted_at IS NULL), archived_at, deleted_at, created_at FROM columns WHERE id = $1`
 	var column domain.Column
-	err := r.DB.QueryRowContext(ctx, query, id).Scan(&column.ID, &column.Name, &column.Color, &column.ProjectID, &column.CreatedAt)
+	err := r.DB.QueryRowContext(ctx, query, id).Scan(&column.ID, &column.Name, &column.Color, &column.ProjectID, &column.WipLimit, &column.Category, &column.TaskCount, &column.ArchivedAt, &column.DeletedAt, &column.CreatedAt)
+	if err == sql.ErrNoRows {
+		return nil, domain.ErrColumnNotFound
+	}
 	if err != nil {
 		return nil, err
 	}
 	return &column, nil
 }
 
+func (r *PostgresColumnRepository) GetByIDForUpdate(ctx context.Context, id string) (*domain.Column, error) {
+	query := `SELECT id FROM columns WHERE id = $1 FOR UPDATE`
+	var columnID string
+	err := r.DB.QueryRowContext(ctx, query, id).Scan(&columnID)
+	if err == sql.ErrNoRows {
+		return nil, domain.ErrColumnNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return r.GetByID(ctx, columnID)
+}
+
 func (r *PostgresColumnRepository) GetColumnsByProjectID(ctx context.Context, projectID string) ([]*domain.Column, error) {
-	query := `SELECT id, name, color, project_id, created_at FROM columns WHERE project_id = $1 ORDER BY created_at ASC`
+	query := `SELECT id, name, color, project_id, wip_limit, category, (SELECT COUNT(*) FROM tasks WHERE tasks.column_id = columns.id AND tasks.archived_at IS NULL AND tasks.deleted_at IS NULL), archived_at, deleted_at, created_at FROM columns WHERE project_id = $1 AND archived_at IS NULL AND deleted_at IS NULL ORDER BY created_at ASC`
 	rows, err := r.DB.QueryContext(ctx, query, projectID)
 	if err != nil {
 		return nil, err
@@ -47,7 +66,7 @@ func (r *PostgresColumnRepository) GetColumnsByProjectID(ctx context.Context, pr
 	var columns []*domain.Column
 	for rows.Next() {
 		var column domain.Column
-		err := rows.Scan(&column.ID, &column.Name, &column.Color, &column.ProjectID, &column.CreatedAt)
+		err := rows.Scan(&column.ID, &column.Name, &column.Color, &column.ProjectID, &column.WipLimit, &column.Category, &column.TaskCount, &column.ArchivedAt, &column.DeletedAt, &column.CreatedAt)
 		if err != nil {
 			return nil, err
 		}
@@ -57,7 +76,7 @@ func (r *PostgresColumnRepository) GetColumnsByProjectID(ctx context.Context, pr
 }
 
 func (r *PostgresColumnRepository) GetAll(ctx context.Context) ([]*domain.Column, error) {
-	query := `SELECT id, name, color, project_id, created_at FROM columns ORDER BY created_at ASC`
+	query := `SELECT id, name, color, project_id, wip_limit, category, (SELECT COUNT(*) FROM tasks WHERE tasks.column_id = columns.id AND tasks.archived_at IS NULL AND tasks.deleted_at IS NULL), archived_at, deleted_at, created_at FROM columns WHERE archived_at IS NULL AND deleted_at IS NULL ORDER BY created_at ASC`
 	rows, err := r.DB.QueryContext(ctx, query)
 	if err != nil {
 		return nil, err
@@ -67,7 +86,7 @@ func (r *PostgresColumnRepository) GetAll(ctx context.Context) ([]*domain.Column
 	var columns []*domain.Column
 	for rows.Next() {
 		var column domain.Column
-		err := rows.Scan(&column.ID, &column.Name, &column.Color, &column.ProjectID, &column.CreatedAt)
+		err := rows.Scan(&column.ID, &column.Name, &column.Color, &column.ProjectID, &column.WipLimit, &column.Category, &column.TaskCount, &column.ArchivedAt, &column.DeletedAt, &column.CreatedAt)
 		if err != nil {
 			return nil, err
 		}
@@ -102,6 +121,22 @@ func (r *PostgresColumnRepository) Update(ctx context.Context, column *domain.Co
 		paramIndex++
 	}
 
+	if column.WipLimit != nil {
+		if *column.WipLimit == 0 {
+			setClauses = append(setClauses, "wip_limit = NULL")
+		} else {
+			setClauses = append(setClauses, fmt.Sprintf("wip_limit = $%d", paramIndex))
+			args = append(args, *column.WipLimit)
+			paramIndex++
+		}
+	}
+
+	if column.Category != "" {
+		setClauses = append(setClauses, fmt.Sprintf("category = $%d", paramIndex))
+		args = append(args, column.Category)
+		paramIndex++
+	}
+
 	if len(setClauses) == 0 {
 		return nil
 	}
@@ -110,7 +145,7 @@ func (r *PostgresColumnRepository) Update(ctx context.Context, column *domain.Co
 
 	args = append(args, column.ID)
 
-	finalQuery := queryBase + querySet + fmt.Sprintf(queryWhere, paramIndex)
+	finalQuery := queryBase + querySet + fmt.Sprintf(queryWhere, paramIndex) + " AND deleted_at IS NULL"
 
 	_, err := r.DB.ExecContext(ctx, finalQuery, args...)
 	if err != nil {
@@ -121,10 +156,81 @@ func (r *PostgresColumnRepository) Update(ctx context.Context, column *domain.Co
 }
 
 func (r *PostgresColumnRepository) Delete(ctx context.Context, id string) error {
-	query := `DELETE FROM columns WHERE id = $1`
-	_, err := r.DB.ExecContext(ctx, query, id)
+	query := `UPDATE columns SET deleted_at = CURRENT_TIMESTAMP WHERE id = $1 AND deleted_at IS NULL`
+	result, err := r.DB.ExecContext(ctx, query, id)
 	if err != nil {
 		return err
 	}
-	return nil
+	return checkRowsAffected(result)
+}
+
+func (r *PostgresColumnRepository) Archive(ctx context.Context, id string) error {
+	query := `UPDATE columns SET archived_at = CURRENT_TIMESTAMP WHERE id = $1 AND archived_at IS NULL AND deleted_at IS NULL`
+	result, err := r.DB.ExecContext(ctx, query, id)
+	if err != nil {
+		return err
+	}
+	return checkRowsAffected(result)
+}
+
+func (r *PostgresColumnRepository) Restore(ctx context.Context, id string) error {
+	query := `UPDATE columns SET archived_at = NULL, deleted_at = NULL WHERE id = $1 AND (archived_at IS NOT NULL OR deleted_at IS NOT NULL)`
+	result, err := r.DB.ExecContext(ctx, query, id)
+	if err != nil {
+		return err
+	}
+	return checkRowsAffected(result)
+}
+
+func (r *PostgresColumnRepository) GetByProjectIDAndState(ctx context.Context, projectID string, state domain.ArchiveState) ([]*domain.Column, error) {
+	query := `SELECT id, name, color, project_id, wip_limit, category, (SELECT COUNT(*) FROM tasks WHERE tasks.column_id = columns.id AND tasks.archived_at IS NULL AND tasks.deleted_at IS NULL), archived_at, deleted_at, created_at FROM columns WHERE project_id = $1 AND deleted_at IS NULL AND archived_at IS NOT NULL ORDER BY archived_at DESC`
+	if state == domain.ArchiveStateTrashed {
+		query = `SELECT id, name, color, project_id, wip_limit, category, (SELECT COUNT(*) FROM tasks WHERE tasks.column_id = columns.id AND tasks.archived_at IS NULL AND tasks.deleted_at IS NULL), archived_at, deleted_at, created_at FROM columns WHERE project_id = $1 AND deleted_at IS NOT NULL ORDER BY deleted_at DESC`
+	}
+
+	rows, err := r.DB.QueryContext(ctx, query, projectID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var columns []*domain.Column
+	for rows.Next() {
+		var column domain.Column
+		err := rows.Scan(&column.ID, &column.Name, &column.Color, &column.ProjectID, &column.WipLimit, &column.Category, &column.TaskCount, &column.ArchivedAt, &column.DeletedAt, &column.CreatedAt)
+		if err != nil {
+			return nil, err
+		}
+		columns = append(columns, &column)
+	}
+	return columns, nil
+}
+
+func (r *PostgresColumnRepository) PurgeDeleted(ctx context.Context, olderThan time.Duration) (int64, error) {
+	tx, err := r.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback()
+
+	query := `DELETE FROM tasks WHERE deleted_at IS NOT NULL AND column_id IN (
+		SELECT id FROM columns WHERE deleted_at IS NOT NULL AND deleted_at < CURRENT_TIMESTAMP - make_interval(secs => $1))`
+	_, err = tx.ExecContext(ctx, query, olderThan.Seconds())
+	if err != nil {
+		return 0, err
+	}
+
+	query = `DELETE FROM columns WHERE deleted_at IS NOT NULL AND deleted_at < CURRENT_TIMESTAMP - make_interval(secs => $1)
+		AND NOT EXISTS (SELECT 1 FROM tasks WHERE tasks.column_id = columns.id)`
+	result, err := tx.ExecContext(ctx, query, olderThan.Seconds())
+	if err != nil {
+		return 0, err
+	}
+
+	purged, err := result.RowsAffected()
+	if err != nil {
+		return 0, err
+	}
+
+	return purged, tx.Commit()
 }
@@ -0,0 +1,94 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/fatihsen-dev/kanban-backend/internal/core/domain"
+)
+
+func newTestRepository(t *testing.T) *PostgresRepository {
+	t.Helper()
+
+	connStr := os.Getenv("TEST_DB_URL")
+	if connStr == "" {
+		t.Skip("TEST_DB_URL is not set")
+	}
+
+	repo := NewPostgresRepository(connStr)
+	t.Cleanup(func() { repo.Close() })
+	return repo
+}
+
+func TestPurgeDeletedColumnsRemovesTrashedTasks(t *testing.T) {
+	repo := newTestRepository(t)
+	ctx := context.Background()
+
+	userRepo := NewPostgresUserRepo(repo)
+	projectRepo := NewPostgresProjectRepo(repo)
+	columnRepo := NewPostgresColumnRepo(repo)
+	taskRepo := NewPostgresTaskRepo(repo)
+
+	suffix := time.Now().UnixNano()
+	user := &domain.User{Name: "Purge", Email: fmt.Sprintf("purge-%d@example.com", suffix), PasswordHash: "x"}
+	if err := userRepo.Save(ctx, user); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { repo.DB.ExecContext(ctx, `DELETE FROM users WHERE id = $1`, user.ID) })
+
+	project := &domain.Project{Name: "Purge", OwnerID: user.ID, Key: fmt.Sprintf("PG%d", suffix%100000000)}
+	if err := projectRepo.Save(ctx, project); err != nil {
+		t.Fatal(err)
+	}
+
+	trashedColumn := &domain.Column{Name: "Trashed", ProjectID: project.ID}
+	keptColumn := &domain.Column{Name: "Kept", ProjectID: project.ID}
+	for _, column := range []*domain.Column{trashedColumn, keptColumn} {
+		if err := columnRepo.Save(ctx, column); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	trashedTask := &domain.Task{Title: "Trashed", ProjectID: project.ID, ColumnID: trashedColumn.ID}
+	archivedTask := &domain.Task{Title: "Archived", ProjectID: project.ID, ColumnID: keptColumn.ID}
+	for _, task := range []*domain.Task{trashedTask, archivedTask} {
+		if err := taskRepo.Save(ctx, task); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := taskRepo.Delete(ctx, trashedTask.ID); err != nil {
+		t.Fatal(err)
+	}
+	if err := taskRepo.Archive(ctx, archivedTask.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	_, err := repo.DB.ExecContext(ctx, `UPDATE columns SET deleted_at = CURRENT_TIMESTAMP - INTERVAL '2 days' WHERE id IN ($1, $2)`, trashedColumn.ID, keptColumn.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = columnRepo.PurgeDeleted(ctx, 24*time.Hour)
+	if err != nil {
+		t.Fatalf("purge failed: %v", err)
+	}
+
+	if _, err := columnRepo.GetByID(ctx, trashedColumn.ID); !errors.Is(err, domain.ErrColumnNotFound) {
+		t.Errorf("expected the trashed column to be purged, got %v", err)
+	}
+	if _, err := taskRepo.GetByID(ctx, trashedTask.ID); !errors.Is(err, domain.ErrTaskNotFound) {
+		t.Errorf("expected the trashed task to be purged with its column, got %v", err)
+	}
+
+	if _, err := columnRepo.GetByID(ctx, keptColumn.ID); err != nil {
+		t.Errorf("expected the column holding an archived task to be kept, got %v", err)
+	}
+	if _, err := taskRepo.GetByID(ctx, archivedTask.ID); err != nil {
+		t.Errorf("expected the archived task to be kept, got %v", err)
+	}
+}
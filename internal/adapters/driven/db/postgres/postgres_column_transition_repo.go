@@ -0,0 +1,104 @@
+package db
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/fatihsen-dev/kanban-backend/internal/core/domain"
+	ports "github.com/fatihsen-dev/kanban-backend/internal/core/ports/driven"
+	"github.com/lib/pq"
+)
+
+type PostgresColumnTransitionRepository struct {
+	PostgresRepository
+}
+
+func NewPostgresColumnTransitionRepo(baseRepo *PostgresRepository) ports.ColumnTransitionRepository {
+	return &PostgresColumnTransitionRepository{PostgresRepository: *baseRepo}
+}
+
+func (r *PostgresColumnTransitionRepository) Save(ctx context.Context, transition *domain.ColumnTransition) error {
+	query := `INSERT INTO column_transitions (project_id, from_column_id, to_column_id, conditions) VALUES ($1, $2, $3, $4) RETURNING id, created_at`
+	err := r.DB.QueryRowContext(ctx, query, transition.ProjectID, transition.FromColumnID, transition.ToColumnID, pq.Array(fromTransitionConditions(transition.Conditions))).Scan(&transition.ID, &transition.CreatedAt)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (r *PostgresColumnTransitionRepository) GetByID(ctx context.Context, id string) (*domain.ColumnTransition, error) {
+	query := `SELECT id, project_id, from_column_id, to_column_id, conditions, created_at FROM column_transitions WHERE id = $1`
+	var transition domain.ColumnTransition
+	var conditions []string
+	err := r.DB.QueryRowContext(ctx, query, id).Scan(&transition.ID, &transition.ProjectID, &transition.FromColumnID, &transition.ToColumnID, pq.Array(&conditions), &transition.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+	transition.Conditions = toTransitionConditions(conditions)
+	return &transition, nil
+}
+
+func (r *PostgresColumnTransitionRepository) GetTransitionsByProjectID(ctx context.Context, projectID string) ([]*domain.ColumnTransition, error) {
+	query := `SELECT id, project_id, from_column_id, to_column_id, conditions, created_at FROM column_transitions WHERE project_id = $1 ORDER BY created_at ASC`
+	return r.queryTransitions(ctx, query, projectID)
+}
+
+func (r *PostgresColumnTransitionRepository) GetTransitionsFromColumn(ctx context.Context, fromColumnID string) ([]*domain.ColumnTransition, error) {
+	query := `SELECT id, project_id, from_column_id, to_column_id, conditions, created_at FROM column_transitions WHERE from_column_id = $1 ORDER BY created_at ASC`
+	return r.queryTransitions(ctx, query, fromColumnID)
+}
+
+func (r *PostgresColumnTransitionRepository) queryTransitions(ctx context.Context, query string, args ...interface{}) ([]*domain.ColumnTransition, error) {
+	rows, err := r.DB.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var transitions []*domain.ColumnTransition
+	for rows.Next() {
+		var transition domain.ColumnTransition
+		var conditions []string
+		err := rows.Scan(&transition.ID, &transition.ProjectID, &transition.FromColumnID, &transition.ToColumnID, pq.Array(&conditions), &transition.CreatedAt)
+		if err != nil {
+			return nil, err
+		}
+		transition.Conditions = toTransitionConditions(conditions)
+		transitions = append(transitions, &transition)
+	}
+	return transitions, nil
+}
+
+func (r *PostgresColumnTransitionRepository) Update(ctx context.Context, transition *domain.ColumnTransition) error {
+	query := `UPDATE column_transitions SET conditions = $1 WHERE id = $2`
+	_, err := r.DB.ExecContext(ctx, query, pq.Array(fromTransitionConditions(transition.Conditions)), transition.ID)
+	if err != nil {
+		return fmt.Errorf("column transition update failed: %w", err)
+	}
+	return nil
+}
+
+func (r *PostgresColumnTransitionRepository) DeleteByID(ctx context.Context, id string) error {
+	query := `DELETE FROM column_transitions WHERE id = $1`
+	_, err := r.DB.ExecContext(ctx, query, id)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func toTransitionConditions(values []string) []domain.TransitionCondition {
+	conditions := make([]domain.TransitionCondition, len(values))
+	for i, value := range values {
+		conditions[i] = domain.TransitionCondition(value)
+	}
+	return conditions
+}
+
+func fromTransitionConditions(conditions []domain.TransitionCondition) []string {
+	values := make([]string, len(conditions))
+	for i, condition := range conditions {
+		values[i] = string(condition)
+	}
+	return values
+}
@@ -0,0 +1,101 @@
+package db
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/fatihsen-dev/kanban-backend/internal/core/domain"
+	ports "github.com/fatihsen-dev/kanban-backend/internal/core/ports/driven"
+	"github.com/lib/pq"
+)
+
+const customFieldSelectFields = `id, project_id, name, type, required, options, min_value, max_value, max_length, position, created_at`
+
+type PostgresCustomFieldRepository struct {
+	PostgresRepository
+}
+
+func NewPostgresCustomFieldRepo(baseRepo *PostgresRepository) ports.CustomFieldRepository {
+	return &PostgresCustomFieldRepository{PostgresRepository: *baseRepo}
+}
+
+func scanCustomField(row rowScanner) (*domain.CustomField, error) {
+	var field domain.CustomField
+	err := row.Scan(&field.ID, &field.ProjectID, &field.Name, &field.Type, &field.Required, pq.Array(&field.Options), &field.MinValue, &field.MaxValue, &field.MaxLength, &field.Position, &field.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+	return &field, nil
+}
+
+func (r *PostgresCustomFieldRepository) Save(ctx context.Context, field *domain.CustomField) error {
+	options := field.Options
+	if options == nil {
+		options = []string{}
+	}
+
+	query := `INSERT INTO custom_fields (project_id, name, type, required, options, min_value, max_value, max_length, position)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9) RETURNING id, created_at`
+	return r.DB.QueryRowContext(ctx, query, field.ProjectID, field.Name, field.Type, field.Required, pq.Array(options), field.MinValue, field.MaxValue, field.MaxLength, field.Position).Scan(&field.ID, &field.CreatedAt)
+}
+
+func (r *PostgresCustomFieldRepository) GetByID(ctx context.Context, id string) (*domain.CustomField, error) {
+	query := `SELECT ` + customFieldSelectFields + ` FROM custom_fields WHERE id = $1`
+	return scanCustomField(r.DB.QueryRowContext(ctx, query, id))
+}
+
+func (r *PostgresCustomFieldRepository) GetFieldsByProjectID(ctx context.Context, projectID string) ([]*domain.CustomField, error) {
+	query := `SELECT ` + customFieldSelectFields + ` FROM custom_fields WHERE project_id = $1 ORDER BY position ASC, created_at ASC`
+	rows, err := r.DB.QueryContext(ctx, query, projectID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var fields []*domain.CustomField
+	for rows.Next() {
+		field, err := scanCustomField(rows)
+		if err != nil {
+			return nil, err
+		}
+		fields = append(fields, field)
+	}
+	return fields, rows.Err()
+}
+
+func (r *PostgresCustomFieldRepository) Update(ctx context.Context, field *domain.CustomField) error {
+	options := field.Options
+	if options == nil {
+		options = []string{}
+	}
+
+	query := `UPDATE custom_fields SET name = $1, required = $2, options = $3, min_value = $4, max_value = $5, max_length = $6, position = $7 WHERE id = $8`
+	_, err := r.DB.ExecContext(ctx, query, field.Name, field.Required, pq.Array(options), field.MinValue, field.MaxValue, field.MaxLength, field.Position, field.ID)
+	if err != nil {
+		return fmt.Errorf("custom field update failed: %w", err)
+	}
+	return nil
+}
+
+func (r *PostgresCustomFieldRepository) DeleteByID(ctx context.Context, id string) error {
+	tx, err := r.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	query := `UPDATE tasks SET custom_fields = custom_fields - $1::text
+		WHERE project_id = (SELECT project_id FROM custom_fields WHERE id = $2) AND custom_fields ? $1::text`
+	_, err = tx.ExecContext(ctx, query, id, id)
+	if err != nil {
+		return err
+	}
+
+	query = `DELETE FROM custom_fields WHERE id = $1`
+	_, err = tx.ExecContext(ctx, query, id)
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
@@ -0,0 +1,41 @@
+package db
+
+import (
+	"context"
+
+	"github.com/fatihsen-dev/kanban-backend/internal/core/domain"
+	ports "github.com/fatihsen-dev/kanban-backend/internal/core/ports/driven"
+)
+
+type PostgresIncomingWebhookRepository struct {
+	PostgresRepository
+}
+
+func NewPostgresIncomingWebhookRepo(baseRepo *PostgresRepository) ports.IncomingWebhookRepository {
+	return &PostgresIncomingWebhookRepository{PostgresRepository: *baseRepo}
+}
+
+func (r *PostgresIncomingWebhookRepository) Save(ctx context.Context, webhook *domain.IncomingWebhook) error {
+	query := `
+		INSERT INTO incoming_webhooks (project_id, secret, target_column_id) VALUES ($1, $2, $3)
+		ON CONFLICT (project_id) DO UPDATE SET secret = EXCLUDED.secret, target_column_id = EXCLUDED.target_column_id
+		RETURNING created_at`
+	return r.DB.QueryRowContext(ctx, query, webhook.ProjectID, webhook.Secret, webhook.TargetColumnID).Scan(&webhook.CreatedAt)
+}
+
+func (r *PostgresIncomingWebhookRepository) GetByProjectID(ctx context.Context, projectID string) (*domain.IncomingWebhook, error) {
+	query := `SELECT project_id, secret, target_column_id, created_at FROM incoming_webhooks WHERE project_id = $1`
+
+	var webhook domain.IncomingWebhook
+	err := r.DB.QueryRowContext(ctx, query, projectID).Scan(&webhook.ProjectID, &webhook.Secret, &webhook.TargetColumnID, &webhook.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+	return &webhook, nil
+}
+
+func (r *PostgresIncomingWebhookRepository) DeleteByProjectID(ctx context.Context, projectID string) error {
+	query := `DELETE FROM incoming_webhooks WHERE project_id = $1`
+	_, err := r.DB.ExecContext(ctx, query, projectID)
+	return err
+}
@@ -0,0 +1,74 @@
+package db
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/fatihsen-dev/kanban-backend/internal/core/domain"
+	ports "github.com/fatihsen-dev/kanban-backend/internal/core/ports/driven"
+)
+
+type PostgresLaneRepository struct {
+	PostgresRepository
+}
+
+func NewPostgresLaneRepo(baseRepo *PostgresRepository) ports.LaneRepository {
+	return &PostgresLaneRepository{PostgresRepository: *baseRepo}
+}
+
+func (r *PostgresLaneRepository) Save(ctx context.Context, lane *domain.Lane) error {
+	query := `INSERT INTO lanes (name, project_id, position) VALUES ($1, $2, $3) RETURNING id, created_at`
+	err := r.DB.QueryRowContext(ctx, query, lane.Name, lane.ProjectID, lane.Position).Scan(&lane.ID, &lane.CreatedAt)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (r *PostgresLaneRepository) GetByID(ctx context.Context, id string) (*domain.Lane, error) {
+	query := `SELECT id, name, project_id, position, created_at FROM lanes WHERE id = $1`
+	var lane domain.Lane
+	err := r.DB.QueryRowContext(ctx, query, id).Scan(&lane.ID, &lane.Name, &lane.ProjectID, &lane.Position, &lane.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+	return &lane, nil
+}
+
+func (r *PostgresLaneRepository) GetLanesByProjectID(ctx context.Context, projectID string) ([]*domain.Lane, error) {
+	query := `SELECT id, name, project_id, position, created_at FROM lanes WHERE project_id = $1 ORDER BY position ASC, created_at ASC`
+	rows, err := r.DB.QueryContext(ctx, query, projectID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var lanes []*domain.Lane
+	for rows.Next() {
+		var lane domain.Lane
+		err := rows.Scan(&lane.ID, &lane.Name, &lane.ProjectID, &lane.Position, &lane.CreatedAt)
+		if err != nil {
+			return nil, err
+		}
+		lanes = append(lanes, &lane)
+	}
+	return lanes, nil
+}
+
+func (r *PostgresLaneRepository) Update(ctx context.Context, lane *domain.Lane) error {
+	query := `UPDATE lanes SET name = $1, position = $2 WHERE id = $3`
+	_, err := r.DB.ExecContext(ctx, query, lane.Name, lane.Position, lane.ID)
+	if err != nil {
+		return fmt.Errorf("lane update failed: %w", err)
+	}
+	return nil
+}
+
+func (r *PostgresLaneRepository) DeleteByID(ctx context.Context, id string) error {
+	query := `DELETE FROM lanes WHERE id = $1`
+	_, err := r.DB.ExecContext(ctx, query, id)
+	if err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,98 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/fatihsen-dev/kanban-backend/internal/core/domain"
+	ports "github.com/fatihsen-dev/kanban-backend/internal/core/ports/driven"
+)
+
+type PostgresNotificationPreferenceRepository struct {
+	PostgresRepository
+}
+
+func NewPostgresNotificationPreferenceRepo(baseRepo *PostgresRepository) ports.NotificationPreferenceRepository {
+	return &PostgresNotificationPreferenceRepository{PostgresRepository: *baseRepo}
+}
+
+func (r *PostgresNotificationPreferenceRepository) GetByUserID(ctx context.Context, userID string) (domain.NotificationPreferences, error) {
+	query := `SELECT type, email_delivery FROM notification_preferences WHERE user_id = $1`
+	rows, err := r.DB.QueryContext(ctx, query, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	preferences := domain.NotificationPreferences{}
+	for rows.Next() {
+		var notificationType domain.NotificationType
+		var delivery domain.EmailDelivery
+		if err := rows.Scan(&notificationType, &delivery); err != nil {
+			return nil, err
+		}
+		preferences[notificationType] = delivery
+	}
+	return preferences, rows.Err()
+}
+
+func (r *PostgresNotificationPreferenceRepository) Save(ctx context.Context, userID string, preferences domain.NotificationPreferences) error {
+	tx, err := r.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	query := `INSERT INTO notification_preferences (user_id, type, email_delivery) VALUES ($1, $2, $3)
+		ON CONFLICT (user_id, type) DO UPDATE SET email_delivery = EXCLUDED.email_delivery, updated_at = CURRENT_TIMESTAMP`
+	for notificationType, delivery := range preferences {
+		_, err := tx.ExecContext(ctx, query, userID, notificationType, delivery)
+		if err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+func (r *PostgresNotificationPreferenceRepository) GetDigestRecipientIDs(ctx context.Context, sentBefore time.Time) ([]string, error) {
+	query := `SELECT id FROM users WHERE NOT EXISTS (SELECT 1 FROM notification_preferences WHERE notification_preferences.user_id = users.id AND notification_preferences.type = $1 AND notification_preferences.email_delivery = $2)
+		AND NOT EXISTS (SELECT 1 FROM email_digests WHERE email_digests.user_id = users.id AND email_digests.sent_at >= $3)
+		AND (EXISTS (SELECT 1 FROM tasks WHERE tasks.assignee_id = users.id AND tasks.completed_at IS NULL AND tasks.archived_at IS NULL AND tasks.deleted_at IS NULL)
+			OR EXISTS (SELECT 1 FROM notifications WHERE notifications.recipient_id = users.id AND notifications.read_at IS NULL))`
+	rows, err := r.DB.QueryContext(ctx, query, domain.NotificationDailyDigest, domain.EmailDeliveryOff, sentBefore)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	userIDs := []string{}
+	for rows.Next() {
+		var userID string
+		if err := rows.Scan(&userID); err != nil {
+			return nil, err
+		}
+		userIDs = append(userIDs, userID)
+	}
+	return userIDs, rows.Err()
+}
+
+func (r *PostgresNotificationPreferenceRepository) GetLastDigestAt(ctx context.Context, userID string) (*time.Time, error) {
+	query := `SELECT sent_at FROM email_digests WHERE user_id = $1`
+	var sentAt time.Time
+	err := r.DB.QueryRowContext(ctx, query, userID).Scan(&sentAt)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &sentAt, nil
+}
+
+func (r *PostgresNotificationPreferenceRepository) MarkDigestSent(ctx context.Context, userID string, sentAt time.Time) error {
+	query := `INSERT INTO email_digests (user_id, sent_at) VALUES ($1, $2) ON CONFLICT (user_id) DO UPDATE SET sent_at = EXCLUDED.sent_at`
+	_, err := r.DB.ExecContext(ctx, query, userID, sentAt)
+	return err
+}
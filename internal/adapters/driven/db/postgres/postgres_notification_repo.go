@@ -0,0 +1,145 @@
+package db
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/fatihsen-dev/kanban-backend/internal/core/domain"
+	ports "github.com/fatihsen-dev/kanban-backend/internal/core/ports/driven"
+	"github.com/lib/pq"
+)
+
+const notificationSelectFields = `id, recipient_id, actor_id, project_id, type, entity_type, entity_id, message, read_at, created_at`
+
+type PostgresNotificationRepository struct {
+	PostgresRepository
+}
+
+func NewPostgresNotificationRepo(baseRepo *PostgresRepository) ports.NotificationRepository {
+	return &PostgresNotificationRepository{PostgresRepository: *baseRepo}
+}
+
+func scanNotification(row rowScanner) (*domain.Notification, error) {
+	var notification domain.Notification
+	err := row.Scan(&notification.ID, &notification.RecipientID, &notification.ActorID, &notification.ProjectID, &notification.Type, &notification.EntityType, &notification.EntityID, &notification.Message, &notification.ReadAt, &notification.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+	return &notification, nil
+}
+
+func (r *PostgresNotificationRepository) Save(ctx context.Context, notification *domain.Notification) error {
+	query := `INSERT INTO notifications (recipient_id, actor_id, project_id, type, entity_type, entity_id, message)
+		VALUES ($1, $2, $3, $4, $5, $6, $7) RETURNING id, created_at`
+	return r.DB.QueryRowContext(ctx, query, notification.RecipientID, notification.ActorID, notification.ProjectID, notification.Type, notification.EntityType, notification.EntityID, notification.Message).Scan(&notification.ID, &notification.CreatedAt)
+}
+
+func (r *PostgresNotificationRepository) GetByID(ctx context.Context, id string) (*domain.Notification, error) {
+	query := `SELECT ` + notificationSelectFields + ` FROM notifications WHERE id = $1`
+	return scanNotification(r.DB.QueryRowContext(ctx, query, id))
+}
+
+func (r *PostgresNotificationRepository) Query(ctx context.Context, filter *domain.NotificationFilter) (*domain.NotificationPage, error) {
+	whereClauses := []string{"recipient_id = $1"}
+	args := []interface{}{filter.RecipientID}
+	paramIndex := 2
+
+	if filter.UnreadOnly {
+		whereClauses = append(whereClauses, "read_at IS NULL")
+	}
+
+	if filter.Since != nil {
+		whereClauses = append(whereClauses, fmt.Sprintf("created_at >= $%d", paramIndex))
+		args = append(args, *filter.Since)
+		paramIndex++
+	}
+
+	if len(filter.Types) > 0 {
+		whereClauses = append(whereClauses, fmt.Sprintf("type = ANY($%d)", paramIndex))
+		types := make([]string, len(filter.Types))
+		for i, notificationType := range filter.Types {
+			types[i] = string(notificationType)
+		}
+		args = append(args, pq.Array(types))
+		paramIndex++
+	}
+
+	if filter.Cursor != "" {
+		cursor, err := decodeTimeCursor(filter.Cursor)
+		if err != nil {
+			return nil, domain.ErrInvalidPageCursor
+		}
+		whereClauses = append(whereClauses, fmt.Sprintf("(created_at, id) < ($%d::timestamp, $%d::uuid)", paramIndex, paramIndex+1))
+		args = append(args, cursor.CreatedAt, cursor.ID)
+		paramIndex += 2
+	}
+
+	pageSize := filter.PageSize()
+	args = append(args, pageSize+1)
+
+	query := `SELECT ` + notificationSelectFields + ` FROM notifications WHERE ` + strings.Join(whereClauses, " AND ") +
+		fmt.Sprintf(" ORDER BY created_at DESC, id DESC LIMIT $%d", paramIndex)
+
+	rows, err := r.DB.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	notifications := []*domain.Notification{}
+	for rows.Next() {
+		notification, err := scanNotification(rows)
+		if err != nil {
+			return nil, err
+		}
+		notifications = append(notifications, notification)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	page := &domain.NotificationPage{Notifications: notifications}
+	if len(notifications) > pageSize {
+		page.Notifications = notifications[:pageSize]
+		last := page.Notifications[pageSize-1]
+		page.NextCursor = encodeTimeCursor(last.CreatedAt, last.ID)
+	}
+
+	return page, nil
+}
+
+func (r *PostgresNotificationRepository) CountUnread(ctx context.Context, recipientID string) (int, error) {
+	query := `SELECT COUNT(*) FROM notifications WHERE recipient_id = $1 AND read_at IS NULL`
+	var count int
+	err := r.DB.QueryRowContext(ctx, query, recipientID).Scan(&count)
+	return count, err
+}
+
+func (r *PostgresNotificationRepository) MarkRead(ctx context.Context, recipientID string, ids []string) ([]string, error) {
+	query := `UPDATE notifications SET read_at = CURRENT_TIMESTAMP WHERE recipient_id = $1 AND id = ANY($2) AND read_at IS NULL RETURNING id`
+	rows, err := r.DB.QueryContext(ctx, query, recipientID, pq.Array(ids))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	markedIDs := []string{}
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		markedIDs = append(markedIDs, id)
+	}
+	return markedIDs, rows.Err()
+}
+
+func (r *PostgresNotificationRepository) MarkAllRead(ctx context.Context, recipientID string) (int64, error) {
+	query := `UPDATE notifications SET read_at = CURRENT_TIMESTAMP WHERE recipient_id = $1 AND read_at IS NULL`
+	result, err := r.DB.ExecContext(ctx, query, recipientID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
@@ -0,0 +1,96 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/fatihsen-dev/kanban-backend/internal/core/domain"
+	ports "github.com/fatihsen-dev/kanban-backend/internal/core/ports/driven"
+)
+
+type PostgresOrganizationMemberRepository struct {
+	PostgresRepository
+}
+
+func NewPostgresOrganizationMemberRepo(baseRepo *PostgresRepository) ports.OrganizationMemberRepository {
+	return &PostgresOrganizationMemberRepository{PostgresRepository: *baseRepo}
+}
+
+func (r *PostgresOrganizationMemberRepository) Save(ctx context.Context, member *domain.OrganizationMember) error {
+	query := `INSERT INTO organization_members (organization_id, user_id, role) VALUES ($1, $2, $3) RETURNING id, created_at`
+	err := r.DB.QueryRowContext(ctx, query, member.OrganizationID, member.UserID, member.Role).Scan(&member.ID, &member.CreatedAt)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (r *PostgresOrganizationMemberRepository) GetByID(ctx context.Context, id string) (*domain.OrganizationMember, error) {
+	query := `SELECT id, organization_id, user_id, role, created_at FROM organization_members WHERE id = $1`
+	var member domain.OrganizationMember
+	err := r.DB.QueryRowContext(ctx, query, id).Scan(&member.ID, &member.OrganizationID, &member.UserID, &member.Role, &member.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+	return &member, nil
+}
+
+func (r *PostgresOrganizationMemberRepository) GetByUserIDAndOrganizationID(ctx context.Context, userID, organizationID string) (*domain.OrganizationMember, error) {
+	query := `SELECT id, organization_id, user_id, role, created_at FROM organization_members WHERE user_id = $1 AND organization_id = $2`
+	var member domain.OrganizationMember
+	err := r.DB.QueryRowContext(ctx, query, userID, organizationID).Scan(&member.ID, &member.OrganizationID, &member.UserID, &member.Role, &member.CreatedAt)
+	if err == sql.ErrNoRows {
+		return nil, domain.ErrNotOrganizationMember
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &member, nil
+}
+
+func (r *PostgresOrganizationMemberRepository) GetByUserID(ctx context.Context, userID string) ([]*domain.OrganizationMember, error) {
+	query := `SELECT id, organization_id, user_id, role, created_at FROM organization_members WHERE user_id = $1`
+	return r.queryMembers(ctx, query, userID)
+}
+
+func (r *PostgresOrganizationMemberRepository) GetMembersByOrganizationID(ctx context.Context, organizationID string) ([]*domain.OrganizationMember, error) {
+	query := `SELECT id, organization_id, user_id, role, created_at FROM organization_members WHERE organization_id = $1 ORDER BY created_at ASC`
+	return r.queryMembers(ctx, query, organizationID)
+}
+
+func (r *PostgresOrganizationMemberRepository) Update(ctx context.Context, member *domain.OrganizationMember) error {
+	query := `UPDATE organization_members SET role = $1 WHERE id = $2`
+	_, err := r.DB.ExecContext(ctx, query, member.Role, member.ID)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (r *PostgresOrganizationMemberRepository) DeleteByID(ctx context.Context, id string) error {
+	query := `DELETE FROM organization_members WHERE id = $1`
+	_, err := r.DB.ExecContext(ctx, query, id)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (r *PostgresOrganizationMemberRepository) queryMembers(ctx context.Context, query string, args ...interface{}) ([]*domain.OrganizationMember, error) {
+	rows, err := r.DB.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var members []*domain.OrganizationMember
+	for rows.Next() {
+		var member domain.OrganizationMember
+		err := rows.Scan(&member.ID, &member.OrganizationID, &member.UserID, &member.Role, &member.CreatedAt)
+		if err != nil {
+			return nil, err
+		}
+		members = append(members, &member)
+	}
+	return members, nil
+}
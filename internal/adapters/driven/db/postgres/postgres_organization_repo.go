@@ -0,0 +1,99 @@
+package db
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/fatihsen-dev/kanban-backend/internal/core/domain"
+	ports "github.com/fatihsen-dev/kanban-backend/internal/core/ports/driven"
+	"github.com/lib/pq"
+)
+
+type PostgresOrganizationRepository struct {
+	PostgresRepository
+}
+
+func NewPostgresOrganizationRepo(baseRepo *PostgresRepository) ports.OrganizationRepository {
+	return &PostgresOrganizationRepository{PostgresRepository: *baseRepo}
+}
+
+func (r *PostgresOrganizationRepository) Save(ctx context.Context, organization *domain.Organization) error {
+	query := `INSERT INTO organizations (name, owner_id) VALUES ($1, $2) RETURNING id, name, owner_id, created_at`
+	err := r.DB.QueryRowContext(ctx, query, organization.Name, organization.OwnerID).Scan(&organization.ID, &organization.Name, &organization.OwnerID, &organization.CreatedAt)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (r *PostgresOrganizationRepository) GetByID(ctx context.Context, id string) (*domain.Organization, error) {
+	query := `SELECT id, name, owner_id, created_at FROM organizations WHERE id = $1`
+	var organization domain.Organization
+	err := r.DB.QueryRowContext(ctx, query, id).Scan(&organization.ID, &organization.Name, &organization.OwnerID, &organization.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+	return &organization, nil
+}
+
+func (r *PostgresOrganizationRepository) GetByIDs(ctx context.Context, ids []string) ([]*domain.Organization, error) {
+	query := `SELECT id, name, owner_id, created_at FROM organizations WHERE id = ANY($1) ORDER BY created_at ASC`
+	rows, err := r.DB.QueryContext(ctx, query, pq.Array(ids))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var organizations []*domain.Organization
+	for rows.Next() {
+		var organization domain.Organization
+		err := rows.Scan(&organization.ID, &organization.Name, &organization.OwnerID, &organization.CreatedAt)
+		if err != nil {
+			return nil, err
+		}
+		organizations = append(organizations, &organization)
+	}
+	return organizations, nil
+}
+
+func (r *PostgresOrganizationRepository) Update(ctx context.Context, organization *domain.Organization) error {
+	queryBase := "UPDATE organizations SET "
+	queryWhere := " WHERE id = $%d"
+
+	setClauses := []string{}
+	args := []interface{}{}
+	paramIndex := 1
+
+	if organization.Name != "" {
+		setClauses = append(setClauses, fmt.Sprintf("name = $%d", paramIndex))
+		args = append(args, organization.Name)
+		paramIndex++
+	}
+
+	if len(setClauses) == 0 {
+		return nil
+	}
+
+	querySet := strings.Join(setClauses, ", ")
+
+	args = append(args, organization.ID)
+
+	finalQuery := queryBase + querySet + fmt.Sprintf(queryWhere, paramIndex)
+
+	_, err := r.DB.ExecContext(ctx, finalQuery, args...)
+	if err != nil {
+		return fmt.Errorf("organization update failed: %w", err)
+	}
+
+	return nil
+}
+
+func (r *PostgresOrganizationRepository) DeleteByID(ctx context.Context, id string) error {
+	query := `DELETE FROM organizations WHERE id = $1`
+	_, err := r.DB.ExecContext(ctx, query, id)
+	if err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,174 @@
+package db
+
+import (
+	"context"
+
+	"github.com/fatihsen-dev/kanban-backend/internal/core/domain"
+	ports "github.com/fatihsen-dev/kanban-backend/internal/core/ports/driven"
+	"github.com/lib/pq"
+)
+
+type PostgresOrganizationTeamRepository struct {
+	PostgresRepository
+}
+
+func NewPostgresOrganizationTeamRepo(baseRepo *PostgresRepository) ports.OrganizationTeamRepository {
+	return &PostgresOrganizationTeamRepository{PostgresRepository: *baseRepo}
+}
+
+func (r *PostgresOrganizationTeamRepository) Save(ctx context.Context, team *domain.OrganizationTeam) error {
+	query := `INSERT INTO organization_teams (name, organization_id) VALUES ($1, $2) RETURNING id, created_at`
+	err := r.DB.QueryRowContext(ctx, query, team.Name, team.OrganizationID).Scan(&team.ID, &team.CreatedAt)
+	if err != nil {
+		return err
+	}
+	team.MemberIDs = []string{}
+	return nil
+}
+
+func (r *PostgresOrganizationTeamRepository) GetByID(ctx context.Context, id string) (*domain.OrganizationTeam, error) {
+	query := `
+		SELECT t.id, t.name, t.organization_id, t.created_at,
+			COALESCE(ARRAY_AGG(tm.user_id::text) FILTER (WHERE tm.user_id IS NOT NULL), '{}')
+		FROM organization_teams t
+		LEFT JOIN organization_team_members tm ON tm.team_id = t.id
+		WHERE t.id = $1
+		GROUP BY t.id`
+	var team domain.OrganizationTeam
+	err := r.DB.QueryRowContext(ctx, query, id).Scan(&team.ID, &team.Name, &team.OrganizationID, &team.CreatedAt, pq.Array(&team.MemberIDs))
+	if err != nil {
+		return nil, err
+	}
+	return &team, nil
+}
+
+func (r *PostgresOrganizationTeamRepository) GetTeamsByOrganizationID(ctx context.Context, organizationID string) ([]*domain.OrganizationTeam, error) {
+	query := `
+		SELECT t.id, t.name, t.organization_id, t.created_at,
+			COALESCE(ARRAY_AGG(tm.user_id::text) FILTER (WHERE tm.user_id IS NOT NULL), '{}')
+		FROM organization_teams t
+		LEFT JOIN organization_team_members tm ON tm.team_id = t.id
+		WHERE t.organization_id = $1
+		GROUP BY t.id
+		ORDER BY t.created_at ASC`
+	rows, err := r.DB.QueryContext(ctx, query, organizationID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var teams []*domain.OrganizationTeam
+	for rows.Next() {
+		var team domain.OrganizationTeam
+		err := rows.Scan(&team.ID, &team.Name, &team.OrganizationID, &team.CreatedAt, pq.Array(&team.MemberIDs))
+		if err != nil {
+			return nil, err
+		}
+		teams = append(teams, &team)
+	}
+	return teams, nil
+}
+
+func (r *PostgresOrganizationTeamRepository) DeleteByID(ctx context.Context, id string) error {
+	query := `DELETE FROM organization_teams WHERE id = $1`
+	_, err := r.DB.ExecContext(ctx, query, id)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (r *PostgresOrganizationTeamRepository) AddMembers(ctx context.Context, teamID string, userIDs []string) error {
+	query := `INSERT INTO organization_team_members (team_id, user_id) SELECT $1, UNNEST($2::uuid[]) ON CONFLICT DO NOTHING`
+	_, err := r.DB.ExecContext(ctx, query, teamID, pq.Array(userIDs))
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (r *PostgresOrganizationTeamRepository) RemoveMember(ctx context.Context, teamID, userID string) error {
+	query := `DELETE FROM organization_team_members WHERE team_id = $1 AND user_id = $2`
+	_, err := r.DB.ExecContext(ctx, query, teamID, userID)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (r *PostgresOrganizationTeamRepository) RemoveUserFromOrganizationTeams(ctx context.Context, organizationID, userID string) error {
+	query := `
+		DELETE FROM organization_team_members
+		WHERE user_id = $1
+		AND team_id IN (SELECT id FROM organization_teams WHERE organization_id = $2)`
+	_, err := r.DB.ExecContext(ctx, query, userID, organizationID)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (r *PostgresOrganizationTeamRepository) SaveProjectGrants(ctx context.Context, grants []*domain.OrganizationTeamProject) error {
+	tx, err := r.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	for _, grant := range grants {
+		query := `
+			INSERT INTO organization_team_projects (team_id, project_id, role) VALUES ($1, $2, $3)
+			ON CONFLICT (team_id, project_id) DO UPDATE SET role = EXCLUDED.role
+			RETURNING created_at`
+		err := tx.QueryRowContext(ctx, query, grant.TeamID, grant.ProjectID, grant.Role).Scan(&grant.CreatedAt)
+		if err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+func (r *PostgresOrganizationTeamRepository) DeleteProjectGrant(ctx context.Context, teamID, projectID string) error {
+	query := `DELETE FROM organization_team_projects WHERE team_id = $1 AND project_id = $2`
+	_, err := r.DB.ExecContext(ctx, query, teamID, projectID)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (r *PostgresOrganizationTeamRepository) GetProjectGrantsByTeamIDs(ctx context.Context, teamIDs []string) ([]*domain.OrganizationTeamProject, error) {
+	query := `SELECT team_id, project_id, role, created_at FROM organization_team_projects WHERE team_id = ANY($1) ORDER BY created_at ASC`
+	return r.queryProjectGrants(ctx, query, pq.Array(teamIDs))
+}
+
+func (r *PostgresOrganizationTeamRepository) GetUserProjectGrants(ctx context.Context, userID string) ([]*domain.OrganizationTeamProject, error) {
+	query := `
+		SELECT tp.team_id, tp.project_id, tp.role, tp.created_at
+		FROM organization_team_projects tp
+		INNER JOIN organization_team_members tm ON tm.team_id = tp.team_id
+		INNER JOIN organization_teams t ON t.id = tp.team_id
+		INNER JOIN organization_members om ON om.organization_id = t.organization_id AND om.user_id = tm.user_id
+		WHERE tm.user_id = $1`
+	return r.queryProjectGrants(ctx, query, userID)
+}
+
+func (r *PostgresOrganizationTeamRepository) queryProjectGrants(ctx context.Context, query string, args ...interface{}) ([]*domain.OrganizationTeamProject, error) {
+	rows, err := r.DB.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var grants []*domain.OrganizationTeamProject
+	for rows.Next() {
+		var grant domain.OrganizationTeamProject
+		err := rows.Scan(&grant.TeamID, &grant.ProjectID, &grant.Role, &grant.CreatedAt)
+		if err != nil {
+			return nil, err
+		}
+		grants = append(grants, &grant)
+	}
+	return grants, nil
+}
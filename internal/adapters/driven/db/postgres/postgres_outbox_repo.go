@@ -0,0 +1,170 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/fatihsen-dev/kanban-backend/internal/core/domain"
+	ports "github.com/fatihsen-dev/kanban-backend/internal/core/ports/driven"
+	"github.com/lib/pq"
+)
+
+const (
+	outboxEventSelectFields = `id, project_id, sequence, recipient_id, name, payload, status, attempts, error, available_at, published_at, created_at`
+	outboxClaimLockKey      = 7210461
+)
+
+type PostgresOutboxRepository struct {
+	PostgresRepository
+}
+
+func NewPostgresOutboxRepo(baseRepo *PostgresRepository) ports.OutboxRepository {
+	return &PostgresOutboxRepository{PostgresRepository: *baseRepo}
+}
+
+type PostgresTransactor struct {
+	PostgresRepository
+}
+
+func NewPostgresTransactor(baseRepo *PostgresRepository) ports.Transactor {
+	return &PostgresTransactor{PostgresRepository: *baseRepo}
+}
+
+func (r *PostgresTransactor) WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	if tx, ok := ctx.Value(txContextKey{}).(*sql.Tx); ok {
+		return withinSavepoint(ctx, tx, fn)
+	}
+
+	tx, err := r.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	err = fn(context.WithValue(ctx, txContextKey{}, tx.Tx))
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func withinSavepoint(ctx context.Context, tx *sql.Tx, fn func(ctx context.Context) error) error {
+	_, err := tx.ExecContext(ctx, `SAVEPOINT nested_transaction`)
+	if err != nil {
+		return err
+	}
+
+	err = fn(ctx)
+	if err != nil {
+		_, rollbackErr := tx.ExecContext(ctx, `ROLLBACK TO SAVEPOINT nested_transaction`)
+		if rollbackErr != nil {
+			return rollbackErr
+		}
+		_, releaseErr := tx.ExecContext(ctx, `RELEASE SAVEPOINT nested_transaction`)
+		if releaseErr != nil {
+			return releaseErr
+		}
+		return err
+	}
+
+	_, err = tx.ExecContext(ctx, `RELEASE SAVEPOINT nested_transaction`)
+	return err
+}
+
+func scanOutboxEvent(row rowScanner) (*domain.OutboxEvent, error) {
+	var event domain.OutboxEvent
+	var projectID sql.NullString
+	err := row.Scan(&event.ID, &projectID, &event.Sequence, &event.RecipientID, &event.Name, &event.Payload, &event.Status, &event.Attempts, &event.Error, &event.AvailableAt, &event.PublishedAt, &event.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+	event.ProjectID = projectID.String
+	return &event, nil
+}
+
+func (r *PostgresOutboxRepository) Save(ctx context.Context, event *domain.OutboxEvent) error {
+	if event.ProjectID == "" {
+		query := `WITH next AS (SELECT nextval(pg_get_serial_sequence('outbox_events', 'id')) AS id)
+			INSERT INTO outbox_events (id, sequence, recipient_id, name, payload)
+			SELECT id, id, $1, $2, $3 FROM next
+			RETURNING id, sequence, status, available_at, created_at`
+		return r.DB.QueryRowContext(ctx, query, event.RecipientID, event.Name, event.Payload).Scan(&event.ID, &event.Sequence, &event.Status, &event.AvailableAt, &event.CreatedAt)
+	}
+
+	query := `WITH sequence AS (UPDATE projects SET event_sequence = event_sequence + 1 WHERE id = $1 RETURNING event_sequence)
+		INSERT INTO outbox_events (project_id, sequence, recipient_id, name, payload)
+		SELECT $1, event_sequence, $2, $3, $4 FROM sequence
+		RETURNING id, sequence, status, available_at, created_at`
+	return r.DB.QueryRowContext(ctx, query, event.ProjectID, event.RecipientID, event.Name, event.Payload).Scan(&event.ID, &event.Sequence, &event.Status, &event.AvailableAt, &event.CreatedAt)
+}
+
+func (r *PostgresOutboxRepository) ClaimPending(ctx context.Context, limit int, lease time.Duration) ([]*domain.OutboxEvent, error) {
+	tx, err := r.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	_, err = tx.ExecContext(ctx, `SELECT pg_advisory_xact_lock($1)`, outboxClaimLockKey)
+	if err != nil {
+		return nil, err
+	}
+
+	query := `WITH ready AS (
+			SELECT id, ROW_NUMBER() OVER (PARTITION BY project_id ORDER BY sequence, id) AS position
+			FROM outbox_events e
+			WHERE e.status = 'pending' AND e.available_at <= CURRENT_TIMESTAMP
+			AND NOT EXISTS (
+				SELECT 1 FROM outbox_events earlier
+				WHERE earlier.project_id = e.project_id AND earlier.status = 'pending' AND earlier.sequence < e.sequence AND earlier.available_at > CURRENT_TIMESTAMP
+			)
+		), claimed AS (
+			UPDATE outbox_events SET available_at = CURRENT_TIMESTAMP + $2 * INTERVAL '1 second'
+			WHERE id IN (SELECT id FROM ready ORDER BY position, id LIMIT $1)
+			RETURNING ` + outboxEventSelectFields + `
+		)
+		SELECT ` + outboxEventSelectFields + ` FROM claimed ORDER BY project_id, sequence, id`
+	rows, err := tx.QueryContext(ctx, query, limit, lease.Seconds())
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	events := []*domain.OutboxEvent{}
+	for rows.Next() {
+		event, err := scanOutboxEvent(rows)
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, event)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	rows.Close()
+
+	return events, tx.Commit()
+}
+
+func (r *PostgresOutboxRepository) Release(ctx context.Context, ids []int64) error {
+	query := `UPDATE outbox_events SET available_at = CURRENT_TIMESTAMP WHERE id = ANY($1) AND status = 'pending'`
+	_, err := r.DB.ExecContext(ctx, query, pq.Array(ids))
+	return err
+}
+
+func (r *PostgresOutboxRepository) Update(ctx context.Context, event *domain.OutboxEvent) error {
+	query := `UPDATE outbox_events SET status = $1, attempts = $2, error = $3, available_at = $4, published_at = $5 WHERE id = $6`
+	_, err := r.DB.ExecContext(ctx, query, event.Status, event.Attempts, event.Error, event.AvailableAt, event.PublishedAt, event.ID)
+	return err
+}
+
+func (r *PostgresOutboxRepository) DeletePublishedBefore(ctx context.Context, before time.Time) (int64, error) {
+	query := `DELETE FROM outbox_events WHERE status = 'published' AND published_at < $1`
+	result, err := r.DB.ExecContext(ctx, query, before)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
@@ -2,6 +2,7 @@ package db
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
 	"strings"
 
@@ -18,18 +19,10 @@ func NewPostgresProjectMemberRepo(baseRepo *PostgresRepository) ports.ProjectMem
 }
 
 func (r *PostgresProjectMemberRepository) Save(ctx context.Context, projectMember *domain.ProjectMember) error {
-	if projectMember.TeamID == nil {
-		query := `INSERT INTO project_members (user_id, project_id, role) VALUES ($1, $2, $3) RETURNING id, user_id, project_id, role, created_at`
-		err := r.DB.QueryRowContext(ctx, query, projectMember.UserID, projectMember.ProjectID, projectMember.Role).Scan(&projectMember.ID, &projectMember.UserID, &projectMember.ProjectID, &projectMember.Role, &projectMember.CreatedAt)
-		if err != nil {
-			return err
-		}
-	} else {
-		query := `INSERT INTO project_members (user_id, project_id, team_id, role) VALUES ($1, $2, $3, $4) RETURNING id, user_id, project_id, team_id, role, created_at`
-		err := r.DB.QueryRowContext(ctx, query, projectMember.UserID, projectMember.ProjectID, projectMember.TeamID, projectMember.Role).Scan(&projectMember.ID, &projectMember.UserID, &projectMember.ProjectID, &projectMember.TeamID, &projectMember.Role, &projectMember.CreatedAt)
-		if err != nil {
-			return err
-		}
+	query := `INSERT INTO project_members (user_id, project_id, team_id, role, role_id) VALUES ($1, $2, $3, $4, $5) RETURNING id, user_id, project_id, team_id, role, role_id, created_at`
+	err := r.DB.QueryRowContext(ctx, query, projectMember.UserID, projectMember.ProjectID, projectMember.TeamID, projectMember.Role, projectMember.RoleID).Scan(&projectMember.ID, &projectMember.UserID, &projectMember.ProjectID, &projectMember.TeamID, &projectMember.Role, &projectMember.RoleID, &projectMember.CreatedAt)
+	if err != nil {
+		return err
 	}
 	return nil
 }
@@ -40,7 +33,7 @@ func (r *PostgresProjectMemberRepository) GetProjectMembersByProjectID(ctx conte
 
 	if searchQuery != nil && *searchQuery != "" {
 		query = `
-			SELECT DISTINCT pm.id, pm.team_id, pm.user_id, pm.project_id, pm.role, pm.created_at 
+			SELECT DISTINCT pm.id, pm.team_id, pm.user_id, pm.project_id, pm.role, pm.role_id, pm.created_at 
 			FROM project_members pm
 			INNER JOIN users u ON pm.user_id = u.id
 			WHERE pm.project_id = $1 
@@ -49,7 +42,7 @@ func (r *PostgresProjectMemberRepository) GetProjectMembersByProjectID(ctx conte
 			)`
 		args = []interface{}{projectID, "%" + *searchQuery + "%"}
 	} else {
-		query = `SELECT id, team_id, user_id, project_id, role, created_at 
+		query = `SELECT id, team_id, user_id, project_id, role, role_id, created_at 
 				FROM project_members 
 				WHERE project_id = $1`
 		args = []interface{}{projectID}
@@ -64,7 +57,7 @@ func (r *PostgresProjectMemberRepository) GetProjectMembersByProjectID(ctx conte
 	var projectMembers []*domain.ProjectMember
 	for rows.Next() {
 		var projectMember domain.ProjectMember
-		err := rows.Scan(&projectMember.ID, &projectMember.TeamID, &projectMember.UserID, &projectMember.ProjectID, &projectMember.Role, &projectMember.CreatedAt)
+		err := rows.Scan(&projectMember.ID, &projectMember.TeamID, &projectMember.UserID, &projectMember.ProjectID, &projectMember.Role, &projectMember.RoleID, &projectMember.CreatedAt)
 		if err != nil {
 			return nil, err
 		}
@@ -82,10 +75,23 @@ func (r *PostgresProjectMemberRepository) DeleteByID(ctx context.Context, id str
 	return nil
 }
 
+func (r *PostgresProjectMemberRepository) GetByID(ctx context.Context, id string) (*domain.ProjectMember, error) {
+	query := `SELECT id, team_id, user_id, project_id, role, role_id, created_at FROM project_members WHERE id = $1`
+	var projectMember domain.ProjectMember
+	err := r.DB.QueryRowContext(ctx, query, id).Scan(&projectMember.ID, &projectMember.TeamID, &projectMember.UserID, &projectMember.ProjectID, &projectMember.Role, &projectMember.RoleID, &projectMember.CreatedAt)
+	if err == sql.ErrNoRows {
+		return nil, domain.ErrProjectMemberNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &projectMember, nil
+}
+
 func (r *PostgresProjectMemberRepository) GetByUserIDAndProjectID(ctx context.Context, userID, projectID string) (*domain.ProjectMember, error) {
-	query := `SELECT id, team_id, user_id, project_id, role, created_at FROM project_members WHERE user_id = $1 AND project_id = $2`
+	query := `SELECT id, team_id, user_id, project_id, role, role_id, created_at FROM project_members WHERE user_id = $1 AND project_id = $2`
 	var projectMember domain.ProjectMember
-	err := r.DB.QueryRowContext(ctx, query, userID, projectID).Scan(&projectMember.ID, &projectMember.TeamID, &projectMember.UserID, &projectMember.ProjectID, &projectMember.Role, &projectMember.CreatedAt)
+	err := r.DB.QueryRowContext(ctx, query, userID, projectID).Scan(&projectMember.ID, &projectMember.TeamID, &projectMember.UserID, &projectMember.ProjectID, &projectMember.Role, &projectMember.RoleID, &projectMember.CreatedAt)
 	if err != nil {
 		return nil, err
 	}
@@ -93,7 +99,7 @@ func (r *PostgresProjectMemberRepository) GetByUserIDAndProjectID(ctx context.Co
 }
 
 func (r *PostgresProjectMemberRepository) GetByUserID(ctx context.Context, userID string) ([]*domain.ProjectMember, error) {
-	query := `SELECT id, team_id, user_id, project_id, role, created_at FROM project_members WHERE user_id = $1`
+	query := `SELECT id, team_id, user_id, project_id, role, role_id, created_at FROM project_members WHERE user_id = $1`
 	rows, err := r.DB.QueryContext(ctx, query, userID)
 	if err != nil {
 		return nil, err
@@ -103,7 +109,7 @@ func (r *PostgresProjectMemberRepository) GetByUserID(ctx context.Context, userI
 	var projectMembers []*domain.ProjectMember
 	for rows.Next() {
 		var projectMember domain.ProjectMember
-		err := rows.Scan(&projectMember.ID, &projectMember.TeamID, &projectMember.UserID, &projectMember.ProjectID, &projectMember.Role, &projectMember.CreatedAt)
+		err := rows.Scan(&projectMember.ID, &projectMember.TeamID, &projectMember.UserID, &projectMember.ProjectID, &projectMember.Role, &projectMember.RoleID, &projectMember.CreatedAt)
 		if err != nil {
 			return nil, err
 		}
@@ -114,7 +120,7 @@ func (r *PostgresProjectMemberRepository) GetByUserID(ctx context.Context, userI
 
 func (r *PostgresProjectMemberRepository) UpdateProjectMember(ctx context.Context, projectMember *domain.ProjectMember) error {
 	queryBase := "UPDATE project_members SET "
-	queryWhere := " WHERE id = $%d"
+	queryWhere := " WHERE id = $%d AND project_id = $%d"
 
 	setClauses := []string{}
 	args := []interface{}{}
@@ -137,15 +143,26 @@ func (r *PostgresProjectMemberRepository) UpdateProjectMember(ctx context.Contex
 		}
 	}
 
+	if projectMember.RoleID != nil {
+		roleID := *projectMember.RoleID
+		if roleID == "" {
+			setClauses = append(setClauses, "role_id = NULL")
+		} else {
+			setClauses = append(setClauses, fmt.Sprintf("role_id = $%d", paramIndex))
+			args = append(args, roleID)
+			paramIndex++
+		}
+	}
+
 	if len(setClauses) == 0 {
 		return nil
 	}
 
 	querySet := strings.Join(setClauses, ", ")
 
-	args = append(args, projectMember.ID)
+	args = append(args, projectMember.ID, projectMember.ProjectID)
 
-	finalQuery := queryBase + querySet + fmt.Sprintf(queryWhere, paramIndex)
+	finalQuery := queryBase + querySet + fmt.Sprintf(queryWhere, paramIndex, paramIndex+1)
 
 	_, err := r.DB.ExecContext(ctx, finalQuery, args...)
 	if err != nil {
@@ -154,3 +171,23 @@ func (r *PostgresProjectMemberRepository) UpdateProjectMember(ctx context.Contex
 
 	return nil
 }
+
+func (r *PostgresProjectMemberRepository) GetUsersByProjectID(ctx context.Context, projectID string) ([]*domain.User, error) {
+	query := `SELECT DISTINCT u.id, u.name, u.email, u.is_admin, u.created_at FROM users u INNER JOIN project_members pm ON pm.user_id = u.id WHERE pm.project_id = $1`
+	rows, err := r.DB.QueryContext(ctx, query, projectID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	users := []*domain.User{}
+	for rows.Next() {
+		var user domain.User
+		err := rows.Scan(&user.ID, &user.Name, &user.Email, &user.IsAdmin, &user.CreatedAt)
+		if err != nil {
+			return nil, err
+		}
+		users = append(users, &user)
+	}
+	return users, rows.Err()
+}
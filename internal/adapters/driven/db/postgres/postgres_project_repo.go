@@ -2,6 +2,8 @@ package db
 
 import (
 	"context"
+	"fmt"
+	"strings"
 
 	"github.com/fatihsen-dev/kanban-backend/internal/core/domain"
 	ports "github.com/fatihsen-dev/kanban-backend/internal/core/ports/driven"
@@ -17,8 +19,8 @@ func NewPostgresProjectRepo(baseRepo *PostgresRepository) ports.ProjectRepositor
 }
 
 func (r *PostgresProjectRepository) Save(ctx context.Context, project *domain.Project) error {
-	query := `INSERT INTO projects (name, owner_id) VALUES ($1, $2) RETURNING id, name, owner_id, created_at`
-	err := r.DB.QueryRowContext(ctx, query, project.Name, project.OwnerID).Scan(&project.ID, &project.Name, &project.OwnerID, &project.CreatedAt)
+	query := `INSERT INTO projects (name, owner_id, organization_id, key) VALUES ($1, $2, $3, $4) RETURNING id, name, owner_id, organization_id, wip_enforcement, lane_grouping, blocker_enforcement, key, created_at`
+	err := r.DB.QueryRowContext(ctx, query, project.Name, project.OwnerID, project.OrganizationID, project.Key).Scan(&project.ID, &project.Name, &project.OwnerID, &project.OrganizationID, &project.WipEnforcement, &project.LaneGrouping, &project.BlockerEnforcement, &project.Key, &project.CreatedAt)
 	if err != nil {
 		return err
 	}
@@ -27,9 +29,19 @@ func (r *PostgresProjectRepository) Save(ctx context.Context, project *domain.Pr
 }
 
 func (r *PostgresProjectRepository) GetByID(ctx context.Context, id string) (*domain.Project, error) {
-	query := `SELECT id, name, owner_id, created_at FROM projects WHERE id = $1`
+	query := `SELECT id, name, owner_id, organization_id, wip_enforcement, lane_grouping, blocker_enforcement, key, created_at FROM projects WHERE id = $1`
 	var project domain.Project
-	err := r.DB.QueryRowContext(ctx, query, id).Scan(&project.ID, &project.Name, &project.OwnerID, &project.CreatedAt)
+	err := r.DB.QueryRowContext(ctx, query, id).Scan(&project.ID, &project.Name, &project.OwnerID, &project.OrganizationID, &project.WipEnforcement, &project.LaneGrouping, &project.BlockerEnforcement, &project.Key, &project.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+	return &project, nil
+}
+
+func (r *PostgresProjectRepository) GetByKey(ctx context.Context, key string) (*domain.Project, error) {
+	query := `SELECT id, name, owner_id, organization_id, wip_enforcement, lane_grouping, blocker_enforcement, key, created_at FROM projects WHERE key = $1`
+	var project domain.Project
+	err := r.DB.QueryRowContext(ctx, query, key).Scan(&project.ID, &project.Name, &project.OwnerID, &project.OrganizationID, &project.WipEnforcement, &project.LaneGrouping, &project.BlockerEnforcement, &project.Key, &project.CreatedAt)
 	if err != nil {
 		return nil, err
 	}
@@ -37,7 +49,7 @@ func (r *PostgresProjectRepository) GetByID(ctx context.Context, id string) (*do
 }
 
 func (r *PostgresProjectRepository) GetUserProjects(ctx context.Context, userID string) ([]*domain.Project, error) {
-	query := `SELECT id, name, owner_id, created_at FROM projects WHERE owner_id = $1 ORDER BY created_at ASC`
+	query := `SELECT id, name, owner_id, organization_id, wip_enforcement, lane_grouping, blocker_enforcement, key, created_at FROM projects WHERE owner_id = $1 ORDER BY created_at ASC`
 	rows, err := r.DB.QueryContext(ctx, query, userID)
 	if err != nil {
 		return nil, err
@@ -47,7 +59,7 @@ func (r *PostgresProjectRepository) GetUserProjects(ctx context.Context, userID
 	var projects []*domain.Project
 	for rows.Next() {
 		var project domain.Project
-		err := rows.Scan(&project.ID, &project.Name, &project.OwnerID, &project.CreatedAt)
+		err := rows.Scan(&project.ID, &project.Name, &project.OwnerID, &project.OrganizationID, &project.WipEnforcement, &project.LaneGrouping, &project.BlockerEnforcement, &project.Key, &project.CreatedAt)
 		if err != nil {
 			return nil, err
 		}
@@ -58,7 +70,7 @@ func (r *PostgresProjectRepository) GetUserProjects(ctx context.Context, userID
 }
 
 func (r *PostgresProjectRepository) GetByIDs(ctx context.Context, ids []string) ([]*domain.Project, error) {
-	query := `SELECT id, name, owner_id, created_at FROM projects WHERE id = ANY($1)`
+	query := `SELECT id, name, owner_id, organization_id, wip_enforcement, lane_grouping, blocker_enforcement, key, created_at FROM projects WHERE id = ANY($1)`
 	rows, err := r.DB.QueryContext(ctx, query, pq.Array(ids))
 	if err != nil {
 		return nil, err
@@ -68,7 +80,27 @@ func (r *PostgresProjectRepository) GetByIDs(ctx context.Context, ids []string)
 	var projects []*domain.Project
 	for rows.Next() {
 		var project domain.Project
-		err := rows.Scan(&project.ID, &project.Name, &project.OwnerID, &project.CreatedAt)
+		err := rows.Scan(&project.ID, &project.Name, &project.OwnerID, &project.OrganizationID, &project.WipEnforcement, &project.LaneGrouping, &project.BlockerEnforcement, &project.Key, &project.CreatedAt)
+		if err != nil {
+			return nil, err
+		}
+		projects = append(projects, &project)
+	}
+	return projects, nil
+}
+
+func (r *PostgresProjectRepository) GetByOrganizationID(ctx context.Context, organizationID string) ([]*domain.Project, error) {
+	query := `SELECT id, name, owner_id, organization_id, wip_enforcement, lane_grouping, blocker_enforcement, key, created_at FROM projects WHERE organization_id = $1 ORDER BY created_at ASC`
+	rows, err := r.DB.QueryContext(ctx, query, organizationID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var projects []*domain.Project
+	for rows.Next() {
+		var project domain.Project
+		err := rows.Scan(&project.ID, &project.Name, &project.OwnerID, &project.OrganizationID, &project.WipEnforcement, &project.LaneGrouping, &project.BlockerEnforcement, &project.Key, &project.CreatedAt)
 		if err != nil {
 			return nil, err
 		}
@@ -77,6 +109,62 @@ func (r *PostgresProjectRepository) GetByIDs(ctx context.Context, ids []string)
 	return projects, nil
 }
 
+func (r *PostgresProjectRepository) Update(ctx context.Context, project *domain.Project) error {
+	queryBase := "UPDATE projects SET "
+	queryWhere := " WHERE id = $%d"
+
+	setClauses := []string{}
+	args := []interface{}{}
+	paramIndex := 1
+
+	if project.Name != "" {
+		setClauses = append(setClauses, fmt.Sprintf("name = $%d", paramIndex))
+		args = append(args, project.Name)
+		paramIndex++
+	}
+
+	if project.Key != "" {
+		setClauses = append(setClauses, fmt.Sprintf("key = $%d", paramIndex))
+		args = append(args, project.Key)
+		paramIndex++
+	}
+
+	if project.WipEnforcement != "" {
+		setClauses = append(setClauses, fmt.Sprintf("wip_enforcement = $%d", paramIndex))
+		args = append(args, project.WipEnforcement)
+		paramIndex++
+	}
+
+	if project.LaneGrouping != "" {
+		setClauses = append(setClauses, fmt.Sprintf("lane_grouping = $%d", paramIndex))
+		args = append(args, project.LaneGrouping)
+		paramIndex++
+	}
+
+	if project.BlockerEnforcement != "" {
+		setClauses = append(setClauses, fmt.Sprintf("blocker_enforcement = $%d", paramIndex))
+		args = append(args, project.BlockerEnforcement)
+		paramIndex++
+	}
+
+	if len(setClauses) == 0 {
+		return nil
+	}
+
+	querySet := strings.Join(setClauses, ", ")
+
+	args = append(args, project.ID)
+
+	finalQuery := queryBase + querySet + fmt.Sprintf(queryWhere, paramIndex)
+
+	_, err := r.DB.ExecContext(ctx, finalQuery, args...)
+	if err != nil {
+		return fmt.Errorf("project update failed: %w", err)
+	}
+
+	return nil
+}
+
 func (r *PostgresProjectRepository) DeleteByID(ctx context.Context, id string) error {
 	query := `DELETE FROM projects WHERE id = $1`
 	_, err := r.DB.ExecContext(ctx, query, id)
@@ -0,0 +1,125 @@
+package db
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/fatihsen-dev/kanban-backend/internal/core/domain"
+	ports "github.com/fatihsen-dev/kanban-backend/internal/core/ports/driven"
+	"github.com/lib/pq"
+)
+
+type PostgresProjectRoleRepository struct {
+	PostgresRepository
+}
+
+func NewPostgresProjectRoleRepo(baseRepo *PostgresRepository) ports.ProjectRoleRepository {
+	return &PostgresProjectRoleRepository{PostgresRepository: *baseRepo}
+}
+
+func (r *PostgresProjectRoleRepository) Save(ctx context.Context, role *domain.ProjectRole) error {
+	query := `INSERT INTO project_roles (name, project_id, permissions) VALUES ($1, $2, $3) RETURNING id, created_at`
+	err := r.DB.QueryRowContext(ctx, query, role.Name, role.ProjectID, pq.Array(fromPermissions(role.Permissions))).Scan(&role.ID, &role.CreatedAt)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (r *PostgresProjectRoleRepository) GetByID(ctx context.Context, id string) (*domain.ProjectRole, error) {
+	query := `SELECT id, name, project_id, permissions, created_at FROM project_roles WHERE id = $1`
+	var role domain.ProjectRole
+	var permissions []string
+	err := r.DB.QueryRowContext(ctx, query, id).Scan(&role.ID, &role.Name, &role.ProjectID, pq.Array(&permissions), &role.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+	role.Permissions = toPermissions(permissions)
+	return &role, nil
+}
+
+func (r *PostgresProjectRoleRepository) GetRolesByProjectID(ctx context.Context, projectID string) ([]*domain.ProjectRole, error) {
+	query := `SELECT id, name, project_id, permissions, created_at FROM project_roles WHERE project_id = $1 ORDER BY created_at ASC`
+	rows, err := r.DB.QueryContext(ctx, query, projectID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var roles []*domain.ProjectRole
+	for rows.Next() {
+		var role domain.ProjectRole
+		var permissions []string
+		err := rows.Scan(&role.ID, &role.Name, &role.ProjectID, pq.Array(&permissions), &role.CreatedAt)
+		if err != nil {
+			return nil, err
+		}
+		role.Permissions = toPermissions(permissions)
+		roles = append(roles, &role)
+	}
+	return roles, nil
+}
+
+func (r *PostgresProjectRoleRepository) Update(ctx context.Context, role *domain.ProjectRole) error {
+	queryBase := "UPDATE project_roles SET "
+	queryWhere := " WHERE id = $%d"
+
+	setClauses := []string{}
+	args := []interface{}{}
+	paramIndex := 1
+
+	if role.Name != "" {
+		setClauses = append(setClauses, fmt.Sprintf("name = $%d", paramIndex))
+		args = append(args, role.Name)
+		paramIndex++
+	}
+
+	if role.Permissions != nil {
+		setClauses = append(setClauses, fmt.Sprintf("permissions = $%d", paramIndex))
+		args = append(args, pq.Array(fromPermissions(role.Permissions)))
+		paramIndex++
+	}
+
+	if len(setClauses) == 0 {
+		return nil
+	}
+
+	querySet := strings.Join(setClauses, ", ")
+
+	args = append(args, role.ID)
+
+	finalQuery := queryBase + querySet + fmt.Sprintf(queryWhere, paramIndex)
+
+	_, err := r.DB.ExecContext(ctx, finalQuery, args...)
+	if err != nil {
+		return fmt.Errorf("project role update failed: %w", err)
+	}
+
+	return nil
+}
+
+func (r *PostgresProjectRoleRepository) DeleteByID(ctx context.Context, id string) error {
+	query := `DELETE FROM project_roles WHERE id = $1`
+	_, err := r.DB.ExecContext(ctx, query, id)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func toPermissions(values []string) []domain.Permission {
+	permissions := make([]domain.Permission, len(values))
+	for i, value := range values {
+		permissions[i] = domain.Permission(value)
+	}
+	return permissions
+}
+
+func fromPermissions(permissions []domain.Permission) []string {
+	values := make([]string, len(permissions))
+	for i, permission := range permissions {
+		values[i] = string(permission)
+	}
+	return values
+}
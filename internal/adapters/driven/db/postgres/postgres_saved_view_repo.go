@@ -0,0 +1,176 @@
+package db
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/fatihsen-dev/kanban-backend/internal/core/domain"
+	ports "github.com/fatihsen-dev/kanban-backend/internal/core/ports/driven"
+	"github.com/lib/pq"
+)
+
+const savedViewSelectFields = `id, project_id, owner_id, name, visibility, filter, grouping, visible_fields, created_at, updated_at`
+
+type PostgresSavedViewRepository struct {
+	PostgresRepository
+}
+
+func NewPostgresSavedViewRepo(baseRepo *PostgresRepository) ports.SavedViewRepository {
+	return &PostgresSavedViewRepository{PostgresRepository: *baseRepo}
+}
+
+type savedViewFilterRecord struct {
+	State         string            `json:"state,omitempty"`
+	ColumnIDs     []string          `json:"column_ids,omitempty"`
+	AssigneeIDs   []string          `json:"assignee_ids,omitempty"`
+	Unassigned    bool              `json:"unassigned,omitempty"`
+	Labels        []string          `json:"labels,omitempty"`
+	Priorities    []string          `json:"priorities,omitempty"`
+	MinEstimate   *float64          `json:"min_estimate,omitempty"`
+	MaxEstimate   *float64          `json:"max_estimate,omitempty"`
+	DueAfter      *time.Time        `json:"due_after,omitempty"`
+	DueBefore     *time.Time        `json:"due_before,omitempty"`
+	CreatedAfter  *time.Time        `json:"created_after,omitempty"`
+	CreatedBefore *time.Time        `json:"created_before,omitempty"`
+	Text          string            `json:"text,omitempty"`
+	CustomFields  map[string]string `json:"custom_fields,omitempty"`
+	SortBy        string            `json:"sort_by,omitempty"`
+	SortDesc      bool              `json:"sort_desc,omitempty"`
+}
+
+func scanSavedView(row rowScanner) (*domain.SavedView, error) {
+	var view domain.SavedView
+	var filter []byte
+	err := row.Scan(&view.ID, &view.ProjectID, &view.OwnerID, &view.Name, &view.Visibility, &filter, &view.Grouping, pq.Array(&view.VisibleFields), &view.CreatedAt, &view.UpdatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	var record savedViewFilterRecord
+	if err := json.Unmarshal(filter, &record); err != nil {
+		return nil, err
+	}
+
+	view.Filter = &domain.TaskFilter{
+		ProjectID:     view.ProjectID,
+		State:         domain.ArchiveState(record.State),
+		ColumnIDs:     record.ColumnIDs,
+		AssigneeIDs:   record.AssigneeIDs,
+		Unassigned:    record.Unassigned,
+		Labels:        record.Labels,
+		MinEstimate:   record.MinEstimate,
+		MaxEstimate:   record.MaxEstimate,
+		DueAfter:      record.DueAfter,
+		DueBefore:     record.DueBefore,
+		CreatedAfter:  record.CreatedAfter,
+		CreatedBefore: record.CreatedBefore,
+		Text:          record.Text,
+		CustomFields:  record.CustomFields,
+		SortBy:        domain.TaskSortField(record.SortBy),
+		SortDesc:      record.SortDesc,
+	}
+	for _, priority := range record.Priorities {
+		view.Filter.Priorities = append(view.Filter.Priorities, domain.TaskPriority(priority))
+	}
+
+	return &view, nil
+}
+
+func marshalSavedViewFilter(filter *domain.TaskFilter) ([]byte, error) {
+	if filter == nil {
+		filter = &domain.TaskFilter{}
+	}
+
+	record := savedViewFilterRecord{
+		State:         string(filter.State),
+		ColumnIDs:     filter.ColumnIDs,
+		AssigneeIDs:   filter.AssigneeIDs,
+		Unassigned:    filter.Unassigned,
+		Labels:        filter.Labels,
+		MinEstimate:   filter.MinEstimate,
+		MaxEstimate:   filter.MaxEstimate,
+		DueAfter:      filter.DueAfter,
+		DueBefore:     filter.DueBefore,
+		CreatedAfter:  filter.CreatedAfter,
+		CreatedBefore: filter.CreatedBefore,
+		Text:          filter.Text,
+		CustomFields:  filter.CustomFields,
+		SortBy:        string(filter.SortBy),
+		SortDesc:      filter.SortDesc,
+	}
+	for _, priority := range filter.Priorities {
+		record.Priorities = append(record.Priorities, string(priority))
+	}
+
+	return json.Marshal(record)
+}
+
+func (r *PostgresSavedViewRepository) Save(ctx context.Context, view *domain.SavedView) error {
+	filter, err := marshalSavedViewFilter(view.Filter)
+	if err != nil {
+		return err
+	}
+
+	visibleFields := view.VisibleFields
+	if visibleFields == nil {
+		visibleFields = []string{}
+	}
+
+	query := `INSERT INTO saved_views (project_id, owner_id, name, visibility, filter, grouping, visible_fields)
+		VALUES ($1, $2, $3, $4, $5, $6, $7) RETURNING id, created_at, updated_at`
+	return r.DB.QueryRowContext(ctx, query, view.ProjectID, view.OwnerID, view.Name, view.Visibility, filter, view.Grouping, pq.Array(visibleFields)).Scan(&view.ID, &view.CreatedAt, &view.UpdatedAt)
+}
+
+func (r *PostgresSavedViewRepository) GetByID(ctx context.Context, id string) (*domain.SavedView, error) {
+	query := `SELECT ` + savedViewSelectFields + ` FROM saved_views WHERE id = $1`
+	return scanSavedView(r.DB.QueryRowContext(ctx, query, id))
+}
+
+func (r *PostgresSavedViewRepository) GetVisibleViews(ctx context.Context, projectID string, userID string) ([]*domain.SavedView, error) {
+	query := `SELECT ` + savedViewSelectFields + ` FROM saved_views
+		WHERE project_id = $1 AND (visibility = 'shared' OR owner_id = $2)
+		ORDER BY name ASC, created_at ASC`
+	rows, err := r.DB.QueryContext(ctx, query, projectID, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var views []*domain.SavedView
+	for rows.Next() {
+		view, err := scanSavedView(rows)
+		if err != nil {
+			return nil, err
+		}
+		views = append(views, view)
+	}
+	return views, rows.Err()
+}
+
+func (r *PostgresSavedViewRepository) Update(ctx context.Context, view *domain.SavedView) error {
+	filter, err := marshalSavedViewFilter(view.Filter)
+	if err != nil {
+		return err
+	}
+
+	visibleFields := view.VisibleFields
+	if visibleFields == nil {
+		visibleFields = []string{}
+	}
+
+	query := `UPDATE saved_views SET name = $1, visibility = $2, filter = $3, grouping = $4, visible_fields = $5, updated_at = CURRENT_TIMESTAMP
+		WHERE id = $6 RETURNING updated_at`
+	err = r.DB.QueryRowContext(ctx, query, view.Name, view.Visibility, filter, view.Grouping, pq.Array(visibleFields), view.ID).Scan(&view.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("saved view update failed: %w", err)
+	}
+	return nil
+}
+
+func (r *PostgresSavedViewRepository) DeleteByID(ctx context.Context, id string) error {
+	query := `DELETE FROM saved_views WHERE id = $1`
+	_, err := r.DB.ExecContext(ctx, query, id)
+	return err
+}
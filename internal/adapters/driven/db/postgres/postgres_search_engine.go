@@ -0,0 +1,62 @@
+package db
+
+import (
+	"context"
+
+	"github.com/fatihsen-dev/kanban-backend/internal/core/domain"
+	ports "github.com/fatihsen-dev/kanban-backend/internal/core/ports/driven"
+	"github.com/lib/pq"
+)
+
+const searchHeadlineOptions = `StartSel=<mark>, StopSel=</mark>, MaxWords=30, MinWords=10, MaxFragments=2, FragmentDelimiter=" ... "`
+
+type PostgresSearchEngine struct {
+	PostgresRepository
+}
+
+func NewPostgresSearchEngine(baseRepo *PostgresRepository) ports.SearchEngine {
+	return &PostgresSearchEngine{PostgresRepository: *baseRepo}
+}
+
+func (r *PostgresSearchEngine) Search(ctx context.Context, query *domain.SearchQuery) ([]*domain.SearchResult, error) {
+	types := make([]string, len(query.Types))
+	for i, resultType := range query.Types {
+		types[i] = string(resultType)
+	}
+
+	sqlQuery := `WITH search AS (SELECT websearch_to_tsquery('simple', $1) AS query),
+		matches AS (
+			SELECT 'task' AS type, tasks.id, tasks.project_id, projects.key || '-' || tasks.number AS key, tasks.title,
+				tasks.title || ' ' || COALESCE(tasks.content, '') AS document, ts_rank(tasks.search_vector, search.query) AS rank
+			FROM tasks JOIN projects ON projects.id = tasks.project_id, search
+			WHERE 'task' = ANY($3) AND tasks.project_id = ANY($2) AND tasks.deleted_at IS NULL AND tasks.search_vector @@ search.query
+			UNION ALL
+			SELECT 'project', projects.id, projects.id, projects.key, projects.name,
+				projects.name, ts_rank(projects.search_vector, search.query)
+			FROM projects, search
+			WHERE 'project' = ANY($3) AND projects.id = ANY($2) AND projects.search_vector @@ search.query
+			ORDER BY rank DESC, id ASC
+			LIMIT $4
+		)
+		SELECT matches.type, matches.id, matches.project_id, matches.key, matches.title,
+			ts_headline('simple', matches.document, search.query, '` + searchHeadlineOptions + `'), matches.rank
+		FROM matches, search
+		ORDER BY matches.rank DESC, matches.id ASC`
+
+	rows, err := r.DB.QueryContext(ctx, sqlQuery, query.Text, pq.Array(query.ProjectIDs), pq.Array(types), query.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	results := []*domain.SearchResult{}
+	for rows.Next() {
+		var result domain.SearchResult
+		err := rows.Scan(&result.Type, &result.ID, &result.ProjectID, &result.Key, &result.Title, &result.Snippet, &result.Rank)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, &result)
+	}
+	return results, rows.Err()
+}
@@ -0,0 +1,75 @@
+package db
+
+import (
+	"context"
+
+	"github.com/fatihsen-dev/kanban-backend/internal/core/domain"
+	ports "github.com/fatihsen-dev/kanban-backend/internal/core/ports/driven"
+)
+
+type PostgresTaskLinkRepository struct {
+	PostgresRepository
+}
+
+func NewPostgresTaskLinkRepo(baseRepo *PostgresRepository) ports.TaskLinkRepository {
+	return &PostgresTaskLinkRepository{PostgresRepository: *baseRepo}
+}
+
+func (r *PostgresTaskLinkRepository) Save(ctx context.Context, link *domain.TaskLink) error {
+	query := `INSERT INTO task_links (source_task_id, target_task_id, type) VALUES ($1, $2, $3) RETURNING id, created_at`
+	return r.DB.QueryRowContext(ctx, query, link.SourceTaskID, link.TargetTaskID, link.Type).Scan(&link.ID, &link.CreatedAt)
+}
+
+func (r *PostgresTaskLinkRepository) GetByID(ctx context.Context, id string) (*domain.TaskLink, error) {
+	query := `SELECT id, source_task_id, target_task_id, type, created_at FROM task_links WHERE id = $1`
+	var link domain.TaskLink
+	err := r.DB.QueryRowContext(ctx, query, id).Scan(&link.ID, &link.SourceTaskID, &link.TargetTaskID, &link.Type, &link.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+	return &link, nil
+}
+
+func (r *PostgresTaskLinkRepository) GetLinksByTaskID(ctx context.Context, taskID string) ([]*domain.TaskLink, error) {
+	query := `SELECT id, source_task_id, target_task_id, type, created_at FROM task_links WHERE source_task_id = $1 OR target_task_id = $1 ORDER BY created_at ASC`
+	rows, err := r.DB.QueryContext(ctx, query, taskID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var links []*domain.TaskLink
+	for rows.Next() {
+		var link domain.TaskLink
+		err := rows.Scan(&link.ID, &link.SourceTaskID, &link.TargetTaskID, &link.Type, &link.CreatedAt)
+		if err != nil {
+			return nil, err
+		}
+		links = append(links, &link)
+	}
+	return links, rows.Err()
+}
+
+func (r *PostgresTaskLinkRepository) HasPath(ctx context.Context, linkType domain.TaskLinkType, fromTaskID string, toTaskID string) (bool, error) {
+	query := `WITH RECURSIVE reachable(task_id) AS (
+		SELECT target_task_id FROM task_links WHERE source_task_id = $2 AND type = $1
+		UNION
+		SELECT task_links.target_task_id FROM task_links JOIN reachable ON task_links.source_task_id = reachable.task_id WHERE task_links.type = $1
+	)
+	SELECT EXISTS(SELECT 1 FROM reachable WHERE task_id = $3)`
+	var exists bool
+	err := r.DB.QueryRowContext(ctx, query, linkType, fromTaskID, toTaskID).Scan(&exists)
+	if err != nil {
+		return false, err
+	}
+	return exists, nil
+}
+
+func (r *PostgresTaskLinkRepository) DeleteByID(ctx context.Context, id string) error {
+	query := `DELETE FROM task_links WHERE id = $1`
+	_, err := r.DB.ExecContext(ctx, query, id)
+	if err != nil {
+		return err
+	}
+	return nil
+}
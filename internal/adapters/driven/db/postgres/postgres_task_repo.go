@@ -2,14 +2,19 @@ package db
 
 import (
 	"context"
+	"database/sql"
+	"encoding/json"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/fatihsen-dev/kanban-backend/internal/core/domain"
 	ports "github.com/fatihsen-dev/kanban-backend/internal/core/ports/driven"
 	"github.com/lib/pq"
 )
 
+const taskSelectFields = `id, number, (SELECT key FROM projects WHERE projects.id = tasks.project_id), title, content, column_id, project_id, lane_id, assignee_id, labels, priority, estimate, custom_fields, due_date, completed_at, (SELECT COUNT(*) FROM checklist_items WHERE checklist_items.task_id = tasks.id AND checklist_items.done), (SELECT COUNT(*) FROM checklist_items WHERE checklist_items.task_id = tasks.id), (SELECT COUNT(*) FROM task_links JOIN tasks blocker ON blocker.id = task_links.source_task_id JOIN columns blocker_column ON blocker_column.id = blocker.column_id WHERE task_links.target_task_id = tasks.id AND task_links.type = 'blocks' AND blocker.deleted_at IS NULL AND blocker.completed_at IS NULL AND blocker_column.category <> 'done'), archived_at, deleted_at, created_at, mentions, ARRAY(SELECT task_watchers.user_id::text FROM task_watchers WHERE task_watchers.task_id = tasks.id ORDER BY task_watchers.created_at, task_watchers.user_id)`
+
 type PostgresTaskRepository struct {
 	PostgresRepository
 }
@@ -18,50 +23,64 @@ func NewPostgresTaskRepo(baseRepo *PostgresRepository) ports.TaskRepository {
 	return &PostgresTaskRepository{PostgresRepository: *baseRepo}
 }
 
-func (r *PostgresTaskRepository) Save(ctx context.Context, task *domain.Task) error {
-	queryBase := `INSERT INTO tasks (title, column_id, project_id`
-	valuesBase := `VALUES ($1, $2, $3`
-	returningBase := `RETURNING id, title, content, column_id, project_id`
-	args := []interface{}{task.Title, task.ColumnID, task.ProjectID}
-	paramIndex := 4
+type rowScanner interface {
+	Scan(dest ...interface{}) error
+}
 
-	if task.Content != nil {
-		queryBase += `, content`
-		valuesBase += fmt.Sprintf(`, $%d`, paramIndex)
-		returningBase += `, content`
-		args = append(args, *task.Content)
-		paramIndex++
+func scanTask(row rowScanner) (*domain.Task, error) {
+	var task domain.Task
+	var customFields []byte
+	var mentions []byte
+	err := row.Scan(&task.ID, &task.Number, &task.ProjectKey, &task.Title, &task.Content, &task.ColumnID, &task.ProjectID, &task.LaneID, &task.AssigneeID, pq.Array(&task.Labels), &task.Priority, &task.Estimate, &customFields, &task.DueDate, &task.CompletedAt, &task.Checklist.Done, &task.Checklist.Total, &task.OpenBlockers, &task.ArchivedAt, &task.DeletedAt, &task.CreatedAt, &mentions, pq.Array(&task.Watchers))
+	if err != nil {
+		return nil, err
 	}
+	if err := json.Unmarshal(customFields, &task.CustomFields); err != nil {
+		return nil, err
+	}
+	task.Mentions, err = unmarshalMentions(mentions)
+	if err != nil {
+		return nil, err
+	}
+	return &task, nil
+}
 
-	queryBase += `) `
-	valuesBase += `) `
-	returningBase += `, created_at`
-
-	query := queryBase + valuesBase + returningBase
+func marshalCustomFields(customFields map[string]interface{}) ([]byte, error) {
+	if customFields == nil {
+		customFields = map[string]interface{}{}
+	}
+	return json.Marshal(customFields)
+}
 
-	scanArgs := []interface{}{&task.ID, &task.Title, &task.Content, &task.ColumnID, &task.ProjectID}
-	scanArgs = append(scanArgs, &task.CreatedAt)
+type mentionRecord struct {
+	Text   string `json:"text"`
+	UserID string `json:"user_id"`
+	Name   string `json:"name"`
+}
 
-	err := r.DB.QueryRowContext(ctx, query, args...).Scan(scanArgs...)
-	if err != nil {
-		return err
+func marshalMentions(mentions []domain.Mention) ([]byte, error) {
+	records := make([]mentionRecord, len(mentions))
+	for i, mention := range mentions {
+		records[i] = mentionRecord{Text: mention.Text, UserID: mention.UserID, Name: mention.Name}
 	}
-	return nil
+	return json.Marshal(records)
 }
 
-func (r *PostgresTaskRepository) GetByID(ctx context.Context, id string) (*domain.Task, error) {
-	query := `SELECT id, title, content, column_id, project_id, created_at FROM tasks WHERE id = $1`
-	var task domain.Task
-	err := r.DB.QueryRowContext(ctx, query, id).Scan(&task.ID, &task.Title, &task.Content, &task.ColumnID, &task.ProjectID, &task.CreatedAt)
-	if err != nil {
+func unmarshalMentions(data []byte) ([]domain.Mention, error) {
+	var records []mentionRecord
+	if err := json.Unmarshal(data, &records); err != nil {
 		return nil, err
 	}
-	return &task, nil
+
+	mentions := make([]domain.Mention, len(records))
+	for i, record := range records {
+		mentions[i] = domain.Mention{Text: record.Text, UserID: record.UserID, Name: record.Name}
+	}
+	return mentions, nil
 }
 
-func (r *PostgresTaskRepository) GetTasksByColumnIDs(ctx context.Context, columnIDs []string) ([]*domain.Task, error) {
-	query := `SELECT id, title, content, column_id, project_id, created_at FROM tasks WHERE column_id = ANY($1) ORDER BY created_at ASC`
-	rows, err := r.DB.QueryContext(ctx, query, pq.Array(columnIDs))
+func (r *PostgresTaskRepository) queryTasks(ctx context.Context, query string, args ...interface{}) ([]*domain.Task, error) {
+	rows, err := r.DB.QueryContext(ctx, query, args...)
 	if err != nil {
 		return nil, err
 	}
@@ -69,34 +88,231 @@ func (r *PostgresTaskRepository) GetTasksByColumnIDs(ctx context.Context, column
 
 	var tasks []*domain.Task
 	for rows.Next() {
-		var task domain.Task
-		err := rows.Scan(&task.ID, &task.Title, &task.Content, &task.ColumnID, &task.ProjectID, &task.CreatedAt)
+		task, err := scanTask(rows)
 		if err != nil {
 			return nil, err
 		}
-		tasks = append(tasks, &task)
+		tasks = append(tasks, task)
 	}
-	return tasks, nil
+	return tasks, rows.Err()
 }
 
-func (r *PostgresTaskRepository) GetAll(ctx context.Context) ([]*domain.Task, error) {
-	query := `SELECT id, title, content, column_id, project_id, created_at FROM tasks ORDER BY created_at ASC`
-	rows, err := r.DB.QueryContext(ctx, query)
+func (r *PostgresTaskRepository) Save(ctx context.Context, task *domain.Task) error {
+	labels := task.Labels
+	if labels == nil {
+		labels = []string{}
+	}
+
+	customFields, err := marshalCustomFields(task.CustomFields)
+	if err != nil {
+		return err
+	}
+
+	mentions, err := marshalMentions(task.Mentions)
+	if err != nil {
+		return err
+	}
+
+	query := `WITH sequence AS (UPDATE projects SET task_sequence = task_sequence + 1 WHERE id = $4 RETURNING task_sequence)
+		INSERT INTO tasks (title, content, column_id, project_id, lane_id, assignee_id, labels, priority, estimate, custom_fields, due_date, mentions, number)
+		SELECT $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, task_sequence FROM sequence
+		RETURNING ` + taskSelectFields
+	savedTask, err := scanTask(r.DB.QueryRowContext(ctx, query, task.Title, task.Content, task.ColumnID, task.ProjectID, task.LaneID, task.AssigneeID, pq.Array(labels), task.Priority, task.Estimate, customFields, task.DueDate, mentions))
+	if err != nil {
+		return err
+	}
+	*task = *savedTask
+	return nil
+}
+
+func (r *PostgresTaskRepository) GetByID(ctx context.Context, id string) (*domain.Task, error) {
+	query := `SELECT ` + taskSelectFields + ` FROM tasks WHERE id = $1`
+	task, err := scanTask(r.DB.QueryRowContext(ctx, query, id))
+	if err == sql.ErrNoRows {
+		return nil, domain.ErrTaskNotFound
+	}
+	return task, err
+}
+
+func (r *PostgresTaskRepository) GetByProjectKeyAndNumber(ctx context.Context, projectKey string, number int) (*domain.Task, error) {
+	query := `SELECT ` + taskSelectFields + ` FROM tasks WHERE project_id = (SELECT id FROM projects WHERE key = $1) AND number = $2`
+	return scanTask(r.DB.QueryRowContext(ctx, query, projectKey, number))
+}
+
+func (r *PostgresTaskRepository) GetTasksByColumnIDs(ctx context.Context, columnIDs []string) ([]*domain.Task, error) {
+	query := `SELECT ` + taskSelectFields + ` FROM tasks WHERE column_id = ANY($1) AND archived_at IS NULL AND deleted_at IS NULL ORDER BY created_at ASC`
+	return r.queryTasks(ctx, query, pq.Array(columnIDs))
+}
+
+func (r *PostgresTaskRepository) TrashByColumnID(ctx context.Context, columnID string) ([]string, error) {
+	query := `UPDATE tasks SET deleted_at = CURRENT_TIMESTAMP WHERE column_id = $1 AND deleted_at IS NULL RETURNING id`
+	return r.queryTaskIDs(ctx, query, columnID)
+}
+
+func (r *PostgresTaskRepository) MoveToColumn(ctx context.Context, fromColumnID, toColumnID string) ([]string, error) {
+	query := `UPDATE tasks SET column_id = $1 WHERE column_id = $2 RETURNING id`
+	return r.queryTaskIDs(ctx, query, toColumnID, fromColumnID)
+}
+
+func (r *PostgresTaskRepository) queryTaskIDs(ctx context.Context, query string, args ...interface{}) ([]string, error) {
+	rows, err := r.DB.QueryContext(ctx, query, args...)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
 
-	var tasks []*domain.Task
+	taskIDs := []string{}
 	for rows.Next() {
-		var task domain.Task
-		err := rows.Scan(&task.ID, &task.Title, &task.Content, &task.ColumnID, &task.ProjectID, &task.CreatedAt)
+		var taskID string
+		if err := rows.Scan(&taskID); err != nil {
+			return nil, err
+		}
+		taskIDs = append(taskIDs, taskID)
+	}
+	return taskIDs, rows.Err()
+}
+
+func (r *PostgresTaskRepository) Query(ctx context.Context, filter *domain.TaskFilter) (*domain.TaskPage, error) {
+	whereClauses := []string{"project_id = $1"}
+	args := []interface{}{filter.ProjectID}
+	paramIndex := 2
+
+	switch filter.State {
+	case domain.ArchiveStateArchived:
+		whereClauses = append(whereClauses, "deleted_at IS NULL", "archived_at IS NOT NULL")
+	case domain.ArchiveStateTrashed:
+		whereClauses = append(whereClauses, "deleted_at IS NOT NULL")
+	default:
+		whereClauses = append(whereClauses, "archived_at IS NULL", "deleted_at IS NULL")
+	}
+
+	if len(filter.ColumnIDs) > 0 {
+		whereClauses = append(whereClauses, fmt.Sprintf("column_id = ANY($%d)", paramIndex))
+		args = append(args, pq.Array(filter.ColumnIDs))
+		paramIndex++
+	}
+
+	if len(filter.AssigneeIDs) > 0 && filter.Unassigned {
+		whereClauses = append(whereClauses, fmt.Sprintf("(assignee_id = ANY($%d) OR assignee_id IS NULL)", paramIndex))
+		args = append(args, pq.Array(filter.AssigneeIDs))
+		paramIndex++
+	} else if len(filter.AssigneeIDs) > 0 {
+		whereClauses = append(whereClauses, fmt.Sprintf("assignee_id = ANY($%d)", paramIndex))
+		args = append(args, pq.Array(filter.AssigneeIDs))
+		paramIndex++
+	} else if filter.Unassigned {
+		whereClauses = append(whereClauses, "assignee_id IS NULL")
+	}
+
+	if len(filter.Labels) > 0 {
+		whereClauses = append(whereClauses, fmt.Sprintf("labels && $%d", paramIndex))
+		args = append(args, pq.Array(filter.Labels))
+		paramIndex++
+	}
+
+	if len(filter.Priorities) > 0 {
+		priorities := make([]string, len(filter.Priorities))
+		for i, priority := range filter.Priorities {
+			priorities[i] = string(priority)
+		}
+		whereClauses = append(whereClauses, fmt.Sprintf("priority = ANY($%d)", paramIndex))
+		args = append(args, pq.Array(priorities))
+		paramIndex++
+	}
+
+	if filter.MinEstimate != nil {
+		whereClauses = append(whereClauses, fmt.Sprintf("estimate >= $%d", paramIndex))
+		args = append(args, *filter.MinEstimate)
+		paramIndex++
+	}
+
+	if filter.MaxEstimate != nil {
+		whereClauses = append(whereClauses, fmt.Sprintf("estimate <= $%d", paramIndex))
+		args = append(args, *filter.MaxEstimate)
+		paramIndex++
+	}
+
+	if filter.DueAfter != nil {
+		whereClauses = append(whereClauses, fmt.Sprintf("due_date >= $%d", paramIndex))
+		args = append(args, *filter.DueAfter)
+		paramIndex++
+	}
+
+	if filter.DueBefore != nil {
+		whereClauses = append(whereClauses, fmt.Sprintf("due_date < $%d", paramIndex))
+		args = append(args, *filter.DueBefore)
+		paramIndex++
+	}
+
+	if filter.CreatedAfter != nil {
+		whereClauses = append(whereClauses, fmt.Sprintf("created_at >= $%d", paramIndex))
+		args = append(args, filter.CreatedAfter.UTC())
+		paramIndex++
+	}
+
+	if filter.CreatedBefore != nil {
+		whereClauses = append(whereClauses, fmt.Sprintf("created_at < $%d", paramIndex))
+		args = append(args, filter.CreatedBefore.UTC())
+		paramIndex++
+	}
+
+	if filter.Text != "" {
+		whereClauses = append(whereClauses, fmt.Sprintf("search_vector @@ websearch_to_tsquery('simple', $%d)", paramIndex))
+		args = append(args, filter.Text)
+		paramIndex++
+	}
+
+	if len(filter.CustomFieldMatch) > 0 {
+		customFields, err := json.Marshal(filter.CustomFieldMatch)
 		if err != nil {
 			return nil, err
 		}
-		tasks = append(tasks, &task)
+		whereClauses = append(whereClauses, fmt.Sprintf("custom_fields @> $%d", paramIndex))
+		args = append(args, customFields)
+		paramIndex++
+	}
+
+	sortExpression, sortCast := taskSortExpression(filter.SortBy, filter.SortDesc)
+
+	direction, comparison := "ASC", ">"
+	if filter.SortDesc {
+		direction, comparison = "DESC", "<"
+	}
+
+	if filter.Cursor != "" {
+		cursor, err := decodeTaskCursor(filter.Cursor)
+		if err != nil || cursor.SortBy != filter.SortBy || cursor.SortDesc != filter.SortDesc {
+			return nil, domain.ErrInvalidTaskCursor
+		}
+		whereClauses = append(whereClauses, fmt.Sprintf("(%s, id) %s ($%d::%s, $%d::uuid)", sortExpression, comparison, paramIndex, sortCast, paramIndex+1))
+		args = append(args, cursor.Value, cursor.ID)
+		paramIndex += 2
 	}
-	return tasks, nil
+
+	pageSize := filter.PageSize()
+	args = append(args, pageSize+1)
+
+	query := `SELECT ` + taskSelectFields + ` FROM tasks WHERE ` + strings.Join(whereClauses, " AND ") +
+		fmt.Sprintf(" ORDER BY %s %s, id %s LIMIT $%d", sortExpression, direction, direction, paramIndex)
+
+	tasks, err := r.queryTasks(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	page := &domain.TaskPage{Tasks: tasks}
+	if len(tasks) > pageSize {
+		page.Tasks = tasks[:pageSize]
+		last := page.Tasks[pageSize-1]
+		page.NextCursor = encodeTaskCursor(taskCursor{
+			SortBy:   filter.SortBy,
+			SortDesc: filter.SortDesc,
+			Value:    taskSortValue(last, filter.SortBy, filter.SortDesc),
+			ID:       last.ID,
+		})
+	}
+
+	return page, nil
 }
 
 func (r *PostgresTaskRepository) Update(ctx context.Context, task *domain.Task) error {
@@ -125,6 +341,88 @@ func (r *PostgresTaskRepository) Update(ctx context.Context, task *domain.Task)
 		paramIndex++
 	}
 
+	if task.LaneID != nil {
+		if *task.LaneID == "" {
+			setClauses = append(setClauses, "lane_id = NULL")
+		} else {
+			setClauses = append(setClauses, fmt.Sprintf("lane_id = $%d", paramIndex))
+			args = append(args, *task.LaneID)
+			paramIndex++
+		}
+	}
+
+	if task.AssigneeID != nil {
+		if *task.AssigneeID == "" {
+			setClauses = append(setClauses, "assignee_id = NULL")
+		} else {
+			setClauses = append(setClauses, fmt.Sprintf("assignee_id = $%d", paramIndex))
+			args = append(args, *task.AssigneeID)
+			paramIndex++
+		}
+	}
+
+	if task.Mentions != nil {
+		mentions, err := marshalMentions(task.Mentions)
+		if err != nil {
+			return err
+		}
+		setClauses = append(setClauses, fmt.Sprintf("mentions = $%d", paramIndex))
+		args = append(args, mentions)
+		paramIndex++
+	}
+
+	if task.Labels != nil {
+		setClauses = append(setClauses, fmt.Sprintf("labels = $%d", paramIndex))
+		args = append(args, pq.Array(task.Labels))
+		paramIndex++
+	}
+
+	if task.Priority != "" {
+		setClauses = append(setClauses, fmt.Sprintf("priority = $%d", paramIndex))
+		args = append(args, task.Priority)
+		paramIndex++
+	}
+
+	if task.Estimate != nil {
+		if *task.Estimate == 0 {
+			setClauses = append(setClauses, "estimate = NULL")
+		} else {
+			setClauses = append(setClauses, fmt.Sprintf("estimate = $%d", paramIndex))
+			args = append(args, *task.Estimate)
+			paramIndex++
+		}
+	}
+
+	if task.CustomFields != nil {
+		customFields, err := marshalCustomFields(task.CustomFields)
+		if err != nil {
+			return err
+		}
+		setClauses = append(setClauses, fmt.Sprintf("custom_fields = $%d", paramIndex))
+		args = append(args, customFields)
+		paramIndex++
+	}
+
+	if task.DueDate != nil {
+		if task.DueDate.IsZero() {
+			setClauses = append(setClauses, "due_date = NULL")
+		} else {
+			setClauses = append(setClauses, fmt.Sprintf("due_date = $%d", paramIndex))
+			args = append(args, *task.DueDate)
+			paramIndex++
+		}
+	}
+
+	if task.CompletedAt != nil {
+		if task.CompletedAt.IsZero() {
+			setClauses = append(setClauses, "completed_at = NULL")
+		} else {
+			setClauses = append(setClauses, fmt.Sprintf("completed_at = $%d", paramIndex))
+			args = append(args, *task.CompletedAt)
+			paramIndex++
+		}
+	}
+
 	if len(setClauses) == 0 {
 		return nil
 	}
@@ -133,7 +431,7 @@ func (r *PostgresTaskRepository) Update(ctx context.Context, task *domain.Task)
 
 	args = append(args, task.ID)
 
-	finalQuery := queryBase + querySet + fmt.Sprintf(queryWhere, paramIndex)
+	finalQuery := queryBase + querySet + fmt.Sprintf(queryWhere, paramIndex) + " AND deleted_at IS NULL"
 
 	_, err := r.DB.ExecContext(ctx, finalQuery, args...)
 	if err != nil {
@@ -144,10 +442,60 @@ func (r *PostgresTaskRepository) Update(ctx context.Context, task *domain.Task)
 }
 
 func (r *PostgresTaskRepository) Delete(ctx context.Context, id string) error {
-	query := `DELETE FROM tasks WHERE id = $1`
-	_, err := r.DB.ExecContext(ctx, query, id)
+	query := `UPDATE tasks SET deleted_at = CURRENT_TIMESTAMP WHERE id = $1 AND deleted_at IS NULL`
+	result, err := r.DB.ExecContext(ctx, query, id)
 	if err != nil {
 		return err
 	}
-	return nil
+	return checkRowsAffected(result)
+}
+
+func (r *PostgresTaskRepository) Archive(ctx context.Context, id string) error {
+	query := `UPDATE tasks SET archived_at = CURRENT_TIMESTAMP WHERE id = $1 AND archived_at IS NULL AND deleted_at IS NULL`
+	result, err := r.DB.ExecContext(ctx, query, id)
+	if err != nil {
+		return err
+	}
+	return checkRowsAffected(result)
+}
+
+func (r *PostgresTaskRepository) Restore(ctx context.Context, id string) error {
+	query := `UPDATE tasks SET archived_at = NULL, deleted_at = NULL WHERE id = $1 AND (archived_at IS NOT NULL OR deleted_at IS NOT NULL)`
+	result, err := r.DB.ExecContext(ctx, query, id)
+	if err != nil {
+		return err
+	}
+	return checkRowsAffected(result)
+}
+
+func (r *PostgresTaskRepository) GetByProjectIDAndState(ctx context.Context, projectID string, state domain.ArchiveState) ([]*domain.Task, error) {
+	query := `SELECT ` + taskSelectFields + ` FROM tasks WHERE project_id = $1 AND deleted_at IS NULL AND archived_at IS NOT NULL ORDER BY archived_at DESC`
+	if state == domain.ArchiveStateTrashed {
+		query = `SELECT ` + taskSelectFields + ` FROM tasks WHERE project_id = $1 AND deleted_at IS NOT NULL ORDER BY deleted_at DESC`
+	}
+	return r.queryTasks(ctx, query, projectID)
+}
+
+func (r *PostgresTaskRepository) PurgeDeleted(ctx context.Context, olderThan time.Duration) (int64, error) {
+	query := `DELETE FROM tasks WHERE deleted_at IS NOT NULL AND deleted_at < CURRENT_TIMESTAMP - make_interval(secs => $1)`
+	result, err := r.DB.ExecContext(ctx, query, olderThan.Seconds())
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+func (r *PostgresTaskRepository) GetOverdueTasksForRule(ctx context.Context, projectID string, ruleID string) ([]*domain.Task, error) {
+	query := `SELECT ` + taskSelectFields + ` FROM tasks WHERE project_id = $1 AND due_date IS NOT NULL AND due_date < CURRENT_TIMESTAMP AND completed_at IS NULL AND archived_at IS NULL AND deleted_at IS NULL AND NOT EXISTS (SELECT 1 FROM automation_executions WHERE automation_executions.rule_id = $2 AND automation_executions.task_id = tasks.id AND automation_executions.created_at >= tasks.due_date) ORDER BY due_date ASC`
+	return r.queryTasks(ctx, query, projectID, ruleID)
+}
+
+func (r *PostgresTaskRepository) GetTasksDueForReminder(ctx context.Context, window time.Duration) ([]*domain.Task, error) {
+	query := `SELECT ` + taskSelectFields + ` FROM tasks WHERE assignee_id IS NOT NULL AND due_date IS NOT NULL AND due_date >= CURRENT_TIMESTAMP AND due_date < CURRENT_TIMESTAMP + $1 * INTERVAL '1 second' AND completed_at IS NULL AND archived_at IS NULL AND deleted_at IS NULL AND NOT EXISTS (SELECT 1 FROM notifications WHERE notifications.type = 'due_date_reminder' AND notifications.entity_id = tasks.id AND notifications.recipient_id = tasks.assignee_id AND notifications.created_at >= tasks.due_date - $1 * INTERVAL '1 second') ORDER BY due_date ASC`
+	return r.queryTasks(ctx, query, window.Seconds())
+}
+
+func (r *PostgresTaskRepository) GetOpenTasksByAssignee(ctx context.Context, assigneeID string, limit int) ([]*domain.Task, error) {
+	query := `SELECT ` + taskSelectFields + ` FROM tasks WHERE assignee_id = $1 AND completed_at IS NULL AND archived_at IS NULL AND deleted_at IS NULL AND EXISTS (SELECT 1 FROM columns WHERE columns.id = tasks.column_id AND columns.category <> 'done') ORDER BY due_date ASC NULLS LAST, created_at DESC LIMIT $2`
+	return r.queryTasks(ctx, query, assigneeID, limit)
 }
@@ -0,0 +1,140 @@
+package db
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/fatihsen-dev/kanban-backend/internal/core/domain"
+	ports "github.com/fatihsen-dev/kanban-backend/internal/core/ports/driven"
+)
+
+const taskRevisionSelectFields = `id, task_id, project_id, number, actor_id, state, created_at`
+
+type PostgresTaskRevisionRepository struct {
+	PostgresRepository
+}
+
+func NewPostgresTaskRevisionRepo(baseRepo *PostgresRepository) ports.TaskRevisionRepository {
+	return &PostgresTaskRevisionRepository{PostgresRepository: *baseRepo}
+}
+
+type taskRevisionStateRecord struct {
+	Title        string                 `json:"title"`
+	Content      *string                `json:"content"`
+	ColumnID     string                 `json:"column_id"`
+	LaneID       *string                `json:"lane_id"`
+	AssigneeID   *string                `json:"assignee_id"`
+	Labels       []string               `json:"labels"`
+	Priority     string                 `json:"priority"`
+	Estimate     *float64               `json:"estimate"`
+	CustomFields map[string]interface{} `json:"custom_fields"`
+	DueDate      *time.Time             `json:"due_date"`
+	CompletedAt  *time.Time             `json:"completed_at"`
+}
+
+func scanTaskRevision(row rowScanner) (*domain.TaskRevision, error) {
+	var revision domain.TaskRevision
+	var state []byte
+	err := row.Scan(&revision.ID, &revision.TaskID, &revision.ProjectID, &revision.Number, &revision.ActorID, &state, &revision.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	var record taskRevisionStateRecord
+	if err := json.Unmarshal(state, &record); err != nil {
+		return nil, err
+	}
+
+	revision.State = &domain.Task{
+		ID:           revision.TaskID,
+		ProjectID:    revision.ProjectID,
+		Title:        record.Title,
+		Content:      record.Content,
+		ColumnID:     record.ColumnID,
+		LaneID:       record.LaneID,
+		AssigneeID:   record.AssigneeID,
+		Labels:       record.Labels,
+		Priority:     domain.TaskPriority(record.Priority),
+		Estimate:     record.Estimate,
+		CustomFields: record.CustomFields,
+		DueDate:      record.DueDate,
+		CompletedAt:  record.CompletedAt,
+	}
+
+	return &revision, nil
+}
+
+func (r *PostgresTaskRevisionRepository) Save(ctx context.Context, revision *domain.TaskRevision) error {
+	task := revision.State
+	state, err := json.Marshal(taskRevisionStateRecord{
+		Title:        task.Title,
+		Content:      task.Content,
+		ColumnID:     task.ColumnID,
+		LaneID:       task.LaneID,
+		AssigneeID:   task.AssigneeID,
+		Labels:       task.Labels,
+		Priority:     string(task.Priority),
+		Estimate:     task.Estimate,
+		CustomFields: task.CustomFields,
+		DueDate:      task.DueDate,
+		CompletedAt:  task.CompletedAt,
+	})
+	if err != nil {
+		return err
+	}
+
+	tx, err := r.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	query := `SELECT id FROM tasks WHERE id = $1 FOR UPDATE`
+	var taskID string
+	err = tx.QueryRowContext(ctx, query, revision.TaskID).Scan(&taskID)
+	if err != nil {
+		return err
+	}
+
+	query = `INSERT INTO task_revisions (task_id, project_id, number, actor_id, state)
+		VALUES ($1, $2, (SELECT COALESCE(MAX(number), 0) + 1 FROM task_revisions WHERE task_id = $1), $3, $4)
+		RETURNING id, number, created_at`
+	err = tx.QueryRowContext(ctx, query, revision.TaskID, revision.ProjectID, revision.ActorID, state).Scan(&revision.ID, &revision.Number, &revision.CreatedAt)
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (r *PostgresTaskRevisionRepository) Exists(ctx context.Context, taskID string) (bool, error) {
+	query := `SELECT EXISTS(SELECT 1 FROM task_revisions WHERE task_id = $1)`
+	var exists bool
+	err := r.DB.QueryRowContext(ctx, query, taskID).Scan(&exists)
+	return exists, err
+}
+
+func (r *PostgresTaskRevisionRepository) GetByTaskID(ctx context.Context, taskID string) ([]*domain.TaskRevision, error) {
+	query := `SELECT ` + taskRevisionSelectFields + ` FROM task_revisions WHERE task_id = $1 ORDER BY number DESC`
+	rows, err := r.DB.QueryContext(ctx, query, taskID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	revisions := []*domain.TaskRevision{}
+	for rows.Next() {
+		revision, err := scanTaskRevision(rows)
+		if err != nil {
+			return nil, err
+		}
+		revisions = append(revisions, revision)
+	}
+	return revisions, rows.Err()
+}
+
+func (r *PostgresTaskRevisionRepository) GetByNumber(ctx context.Context, taskID string, number int) (*domain.TaskRevision, error) {
+	query := `SELECT ` + taskRevisionSelectFields + ` FROM task_revisions WHERE task_id = $1 AND number = $2`
+	return scanTaskRevision(r.DB.QueryRowContext(ctx, query, taskID, number))
+}
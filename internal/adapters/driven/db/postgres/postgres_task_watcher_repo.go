@@ -0,0 +1,47 @@
+package db
+
+import (
+	"context"
+
+	ports "github.com/fatihsen-dev/kanban-backend/internal/core/ports/driven"
+	"github.com/lib/pq"
+)
+
+type PostgresTaskWatcherRepository struct {
+	PostgresRepository
+}
+
+func NewPostgresTaskWatcherRepo(baseRepo *PostgresRepository) ports.TaskWatcherRepository {
+	return &PostgresTaskWatcherRepository{PostgresRepository: *baseRepo}
+}
+
+func (r *PostgresTaskWatcherRepository) Add(ctx context.Context, taskID string, userIDs []string) error {
+	query := `INSERT INTO task_watchers (task_id, user_id) SELECT $1, UNNEST($2::uuid[]) ON CONFLICT (task_id, user_id) DO NOTHING`
+	_, err := r.DB.ExecContext(ctx, query, taskID, pq.Array(userIDs))
+	return err
+}
+
+func (r *PostgresTaskWatcherRepository) Remove(ctx context.Context, taskID, userID string) error {
+	query := `DELETE FROM task_watchers WHERE task_id = $1 AND user_id = $2`
+	_, err := r.DB.ExecContext(ctx, query, taskID, userID)
+	return err
+}
+
+func (r *PostgresTaskWatcherRepository) GetUserIDsByTaskID(ctx context.Context, taskID string) ([]string, error) {
+	query := `SELECT user_id FROM task_watchers WHERE task_id = $1 ORDER BY created_at, user_id`
+	rows, err := r.DB.QueryContext(ctx, query, taskID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	userIDs := []string{}
+	for rows.Next() {
+		var userID string
+		if err := rows.Scan(&userID); err != nil {
+			return nil, err
+		}
+		userIDs = append(userIDs, userID)
+	}
+	return userIDs, rows.Err()
+}
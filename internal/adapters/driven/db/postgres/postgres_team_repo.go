@@ -2,6 +2,7 @@ package db
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
 	"strings"
 
@@ -18,8 +19,8 @@ func NewPostgresTeamRepo(baseRepo *PostgresRepository) ports.TeamRepository {
 }
 
 func (r *PostgresTeamRepository) Save(ctx context.Context, team *domain.Team) error {
-	query := `INSERT INTO teams (name, role, project_id) VALUES ($1, $2, $3) RETURNING id, created_at`
-	err := r.DB.QueryRowContext(ctx, query, team.Name, team.Role, team.ProjectID).Scan(&team.ID, &team.CreatedAt)
+	query := `INSERT INTO teams (name, role, role_id, project_id) VALUES ($1, $2, $3, $4) RETURNING id, created_at`
+	err := r.DB.QueryRowContext(ctx, query, team.Name, team.Role, team.RoleID, team.ProjectID).Scan(&team.ID, &team.CreatedAt)
 	if err != nil {
 		return err
 	}
@@ -27,9 +28,12 @@ func (r *PostgresTeamRepository) Save(ctx context.Context, team *domain.Team) er
 }
 
 func (r *PostgresTeamRepository) GetByID(ctx context.Context, id string) (*domain.Team, error) {
-	query := `SELECT id, name, role, project_id, created_at FROM teams WHERE id = $1`
+	query := `SELECT id, name, role, role_id, project_id, created_at FROM teams WHERE id = $1`
 	var team domain.Team
-	err := r.DB.QueryRowContext(ctx, query, id).Scan(&team.ID, &team.Name, &team.Role, &team.ProjectID, &team.CreatedAt)
+	err := r.DB.QueryRowContext(ctx, query, id).Scan(&team.ID, &team.Name, &team.Role, &team.RoleID, &team.ProjectID, &team.CreatedAt)
+	if err == sql.ErrNoRows {
+		return nil, domain.ErrTeamNotFound
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -37,7 +41,7 @@ func (r *PostgresTeamRepository) GetByID(ctx context.Context, id string) (*domai
 }
 
 func (r *PostgresTeamRepository) GetTeamsByProjectID(ctx context.Context, projectID string) ([]*domain.Team, error) {
-	query := `SELECT id, name, role, project_id, created_at FROM teams WHERE project_id = $1 ORDER BY created_at ASC`
+	query := `SELECT id, name, role, role_id, project_id, created_at FROM teams WHERE project_id = $1 ORDER BY created_at ASC`
 	rows, err := r.DB.QueryContext(ctx, query, projectID)
 	if err != nil {
 		return nil, err
@@ -47,7 +51,7 @@ func (r *PostgresTeamRepository) GetTeamsByProjectID(ctx context.Context, projec
 	var teams []*domain.Team
 	for rows.Next() {
 		var team domain.Team
-		err := rows.Scan(&team.ID, &team.Name, &team.Role, &team.ProjectID, &team.CreatedAt)
+		err := rows.Scan(&team.ID, &team.Name, &team.Role, &team.RoleID, &team.ProjectID, &team.CreatedAt)
 		if err != nil {
 			return nil, err
 		}
@@ -58,7 +62,7 @@ func (r *PostgresTeamRepository) GetTeamsByProjectID(ctx context.Context, projec
 
 func (r *PostgresTeamRepository) Update(ctx context.Context, team *domain.Team) error {
 	queryBase := "UPDATE teams SET "
-	queryWhere := " WHERE id = $%d"
+	queryWhere := " WHERE id = $%d AND project_id = $%d"
 
 	setClauses := []string{}
 	args := []interface{}{}
@@ -76,15 +80,26 @@ func (r *PostgresTeamRepository) Update(ctx context.Context, team *domain.Team)
 		paramIndex++
 	}
 
+	if team.RoleID != nil {
+		roleID := *team.RoleID
+		if roleID == "" {
+			setClauses = append(setClauses, "role_id = NULL")
+		} else {
+			setClauses = append(setClauses, fmt.Sprintf("role_id = $%d", paramIndex))
+			args = append(args, roleID)
+			paramIndex++
+		}
+	}
+
 	if len(setClauses) == 0 {
 		return nil
 	}
 
 	querySet := strings.Join(setClauses, ", ")
 
-	args = append(args, team.ID)
+	args = append(args, team.ID, team.ProjectID)
 
-	finalQuery := queryBase + querySet + fmt.Sprintf(queryWhere, paramIndex)
+	finalQuery := queryBase + querySet + fmt.Sprintf(queryWhere, paramIndex, paramIndex+1)
 
 	_, err := r.DB.ExecContext(ctx, finalQuery, args...)
 	if err != nil {
@@ -94,9 +109,9 @@ func (r *PostgresTeamRepository) Update(ctx context.Context, team *domain.Team)
 	return nil
 }
 
-func (r *PostgresTeamRepository) DeleteByID(ctx context.Context, id string) error {
-	query := `DELETE FROM teams WHERE id = $1`
-	_, err := r.DB.ExecContext(ctx, query, id)
+func (r *PostgresTeamRepository) DeleteByID(ctx context.Context, projectID, id string) error {
+	query := `DELETE FROM teams WHERE id = $1 AND project_id = $2`
+	_, err := r.DB.ExecContext(ctx, query, id, projectID)
 	if err != nil {
 		return err
 	}
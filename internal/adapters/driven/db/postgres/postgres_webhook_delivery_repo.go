@@ -0,0 +1,123 @@
+package db
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/fatihsen-dev/kanban-backend/internal/core/domain"
+	ports "github.com/fatihsen-dev/kanban-backend/internal/core/ports/driven"
+)
+
+const webhookDeliverySelectFields = `id, webhook_id, project_id, event, payload, status, attempts, response_status, response_body, error, next_attempt_at, delivered_at, created_at`
+
+type PostgresWebhookDeliveryRepository struct {
+	PostgresRepository
+}
+
+func NewPostgresWebhookDeliveryRepo(baseRepo *PostgresRepository) ports.WebhookDeliveryRepository {
+	return &PostgresWebhookDeliveryRepository{PostgresRepository: *baseRepo}
+}
+
+func scanWebhookDelivery(row rowScanner) (*domain.WebhookDelivery, error) {
+	var delivery domain.WebhookDelivery
+	err := row.Scan(&delivery.ID, &delivery.WebhookID, &delivery.ProjectID, &delivery.Event, &delivery.Payload, &delivery.Status, &delivery.Attempts, &delivery.ResponseStatus, &delivery.ResponseBody, &delivery.Error, &delivery.NextAttemptAt, &delivery.DeliveredAt, &delivery.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+	return &delivery, nil
+}
+
+func (r *PostgresWebhookDeliveryRepository) Save(ctx context.Context, delivery *domain.WebhookDelivery) error {
+	query := `INSERT INTO webhook_deliveries (webhook_id, project_id, event, payload, status, next_attempt_at)
+		VALUES ($1, $2, $3, $4, $5, $6) RETURNING id, attempts, created_at`
+	return r.DB.QueryRowContext(ctx, query, delivery.WebhookID, delivery.ProjectID, delivery.Event, delivery.Payload, delivery.Status, delivery.NextAttemptAt).Scan(&delivery.ID, &delivery.Attempts, &delivery.CreatedAt)
+}
+
+func (r *PostgresWebhookDeliveryRepository) GetByID(ctx context.Context, id string) (*domain.WebhookDelivery, error) {
+	query := `SELECT ` + webhookDeliverySelectFields + ` FROM webhook_deliveries WHERE id = $1`
+	return scanWebhookDelivery(r.DB.QueryRowContext(ctx, query, id))
+}
+
+func (r *PostgresWebhookDeliveryRepository) Query(ctx context.Context, filter *domain.WebhookDeliveryFilter) (*domain.WebhookDeliveryPage, error) {
+	whereClauses := []string{"webhook_id = $1"}
+	args := []interface{}{filter.WebhookID}
+	paramIndex := 2
+
+	if filter.Cursor != "" {
+		cursor, err := decodeTimeCursor(filter.Cursor)
+		if err != nil {
+			return nil, domain.ErrInvalidPageCursor
+		}
+		whereClauses = append(whereClauses, fmt.Sprintf("(created_at, id) < ($%d::timestamp, $%d::uuid)", paramIndex, paramIndex+1))
+		args = append(args, cursor.CreatedAt, cursor.ID)
+		paramIndex += 2
+	}
+
+	pageSize := filter.PageSize()
+	args = append(args, pageSize+1)
+
+	query := `SELECT ` + webhookDeliverySelectFields + ` FROM webhook_deliveries WHERE ` + strings.Join(whereClauses, " AND ") +
+		fmt.Sprintf(" ORDER BY created_at DESC, id DESC LIMIT $%d", paramIndex)
+
+	rows, err := r.DB.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	deliveries := []*domain.WebhookDelivery{}
+	for rows.Next() {
+		delivery, err := scanWebhookDelivery(rows)
+		if err != nil {
+			return nil, err
+		}
+		deliveries = append(deliveries, delivery)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	page := &domain.WebhookDeliveryPage{Deliveries: deliveries}
+	if len(deliveries) > pageSize {
+		page.Deliveries = deliveries[:pageSize]
+		last := page.Deliveries[pageSize-1]
+		page.NextCursor = encodeTimeCursor(last.CreatedAt, last.ID)
+	}
+
+	return page, nil
+}
+
+func (r *PostgresWebhookDeliveryRepository) ClaimDue(ctx context.Context, limit int, lease time.Duration) ([]*domain.WebhookDelivery, error) {
+	query := `UPDATE webhook_deliveries SET next_attempt_at = CURRENT_TIMESTAMP + $2 * INTERVAL '1 second'
+		WHERE id IN (
+			SELECT id FROM webhook_deliveries
+			WHERE status = 'pending' AND next_attempt_at <= CURRENT_TIMESTAMP
+			ORDER BY next_attempt_at ASC
+			LIMIT $1
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING ` + webhookDeliverySelectFields
+	rows, err := r.DB.QueryContext(ctx, query, limit, lease.Seconds())
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	deliveries := []*domain.WebhookDelivery{}
+	for rows.Next() {
+		delivery, err := scanWebhookDelivery(rows)
+		if err != nil {
+			return nil, err
+		}
+		deliveries = append(deliveries, delivery)
+	}
+	return deliveries, rows.Err()
+}
+
+func (r *PostgresWebhookDeliveryRepository) Update(ctx context.Context, delivery *domain.WebhookDelivery) error {
+	query := `UPDATE webhook_deliveries SET status = $1, attempts = $2, response_status = $3, response_body = $4, error = $5, next_attempt_at = $6, delivered_at = $7 WHERE id = $8`
+	_, err := r.DB.ExecContext(ctx, query, delivery.Status, delivery.Attempts, delivery.ResponseStatus, delivery.ResponseBody, delivery.Error, delivery.NextAttemptAt, delivery.DeliveredAt, delivery.ID)
+	return err
+}
@@ -0,0 +1,78 @@
+package db
+
+import (
+	"context"
+
+	"github.com/fatihsen-dev/kanban-backend/internal/core/domain"
+	ports "github.com/fatihsen-dev/kanban-backend/internal/core/ports/driven"
+	"github.com/lib/pq"
+)
+
+const webhookSelectFields = `id, project_id, url, secret, events, active, created_at`
+
+type PostgresWebhookRepository struct {
+	PostgresRepository
+}
+
+func NewPostgresWebhookRepo(baseRepo *PostgresRepository) ports.WebhookRepository {
+	return &PostgresWebhookRepository{PostgresRepository: *baseRepo}
+}
+
+func scanWebhook(row rowScanner) (*domain.Webhook, error) {
+	var webhook domain.Webhook
+	err := row.Scan(&webhook.ID, &webhook.ProjectID, &webhook.URL, &webhook.Secret, pq.Array(&webhook.Events), &webhook.Active, &webhook.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+	return &webhook, nil
+}
+
+func (r *PostgresWebhookRepository) queryWebhooks(ctx context.Context, query string, args ...interface{}) ([]*domain.Webhook, error) {
+	rows, err := r.DB.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	webhooks := []*domain.Webhook{}
+	for rows.Next() {
+		webhook, err := scanWebhook(rows)
+		if err != nil {
+			return nil, err
+		}
+		webhooks = append(webhooks, webhook)
+	}
+	return webhooks, rows.Err()
+}
+
+func (r *PostgresWebhookRepository) Save(ctx context.Context, webhook *domain.Webhook) error {
+	query := `INSERT INTO webhooks (project_id, url, secret, events, active) VALUES ($1, $2, $3, $4, $5) RETURNING id, created_at`
+	return r.DB.QueryRowContext(ctx, query, webhook.ProjectID, webhook.URL, webhook.Secret, pq.Array(webhook.Events), webhook.Active).Scan(&webhook.ID, &webhook.CreatedAt)
+}
+
+func (r *PostgresWebhookRepository) GetByID(ctx context.Context, id string) (*domain.Webhook, error) {
+	query := `SELECT ` + webhookSelectFields + ` FROM webhooks WHERE id = $1`
+	return scanWebhook(r.DB.QueryRowContext(ctx, query, id))
+}
+
+func (r *PostgresWebhookRepository) GetByProjectID(ctx context.Context, projectID string) ([]*domain.Webhook, error) {
+	query := `SELECT ` + webhookSelectFields + ` FROM webhooks WHERE project_id = $1 ORDER BY created_at ASC`
+	return r.queryWebhooks(ctx, query, projectID)
+}
+
+func (r *PostgresWebhookRepository) GetActiveByProjectIDAndEvent(ctx context.Context, projectID, event string) ([]*domain.Webhook, error) {
+	query := `SELECT ` + webhookSelectFields + ` FROM webhooks WHERE project_id = $1 AND active AND $2 = ANY(events) ORDER BY created_at ASC`
+	return r.queryWebhooks(ctx, query, projectID, event)
+}
+
+func (r *PostgresWebhookRepository) Update(ctx context.Context, webhook *domain.Webhook) error {
+	query := `UPDATE webhooks SET url = $1, secret = $2, events = $3, active = $4 WHERE id = $5`
+	_, err := r.DB.ExecContext(ctx, query, webhook.URL, webhook.Secret, pq.Array(webhook.Events), webhook.Active, webhook.ID)
+	return err
+}
+
+func (r *PostgresWebhookRepository) DeleteByID(ctx context.Context, id string) error {
+	query := `DELETE FROM webhooks WHERE id = $1`
+	_, err := r.DB.ExecContext(ctx, query, id)
+	return err
+}
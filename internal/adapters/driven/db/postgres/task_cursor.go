@@ -0,0 +1,87 @@
+package db
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"strconv"
+	"time"
+
+	"github.com/fatihsen-dev/kanban-backend/internal/core/domain"
+)
+
+const taskCursorTimestampLayout = "2006-01-02T15:04:05.999999"
+
+type taskCursor struct {
+	SortBy   domain.TaskSortField `json:"s"`
+	SortDesc bool                 `json:"d"`
+	Value    string               `json:"v"`
+	ID       string               `json:"i"`
+}
+
+func encodeTaskCursor(cursor taskCursor) string {
+	data, _ := json.Marshal(cursor)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func decodeTaskCursor(encoded string) (*taskCursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, err
+	}
+
+	var cursor taskCursor
+	if err := json.Unmarshal(data, &cursor); err != nil {
+		return nil, err
+	}
+	return &cursor, nil
+}
+
+func taskSortExpression(field domain.TaskSortField, desc bool) (string, string) {
+	switch field {
+	case domain.TaskSortPriority:
+		return "array_position(ARRAY['none', 'low', 'medium', 'high', 'urgent'], priority)", "integer"
+	case domain.TaskSortEstimate:
+		if desc {
+			return "COALESCE(estimate::float8, '-Infinity')", "float8"
+		}
+		return "COALESCE(estimate::float8, 'Infinity')", "float8"
+	case domain.TaskSortDueDate:
+		if desc {
+			return "COALESCE(due_date, '-infinity')", "timestamptz"
+		}
+		return "COALESCE(due_date, 'infinity')", "timestamptz"
+	case domain.TaskSortTitle:
+		return "title", "text"
+	case domain.TaskSortNumber:
+		return "number", "integer"
+	}
+	return "created_at", "timestamp"
+}
+
+func taskSortValue(task *domain.Task, field domain.TaskSortField, desc bool) string {
+	switch field {
+	case domain.TaskSortPriority:
+		return strconv.Itoa(task.Priority.Rank())
+	case domain.TaskSortEstimate:
+		if task.Estimate != nil {
+			return strconv.FormatFloat(*task.Estimate, 'f', -1, 64)
+		}
+		if desc {
+			return "-Infinity"
+		}
+		return "Infinity"
+	case domain.TaskSortDueDate:
+		if task.DueDate != nil {
+			return task.DueDate.Format(time.RFC3339Nano)
+		}
+		if desc {
+			return "-infinity"
+		}
+		return "infinity"
+	case domain.TaskSortTitle:
+		return task.Title
+	case domain.TaskSortNumber:
+		return strconv.Itoa(task.Number)
+	}
+	return task.CreatedAt.Format(taskCursorTimestampLayout)
+}
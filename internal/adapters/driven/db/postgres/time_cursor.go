@@ -0,0 +1,30 @@
+package db
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"time"
+)
+
+type timeCursor struct {
+	CreatedAt string `json:"c"`
+	ID        string `json:"i"`
+}
+
+func encodeTimeCursor(createdAt time.Time, id string) string {
+	data, _ := json.Marshal(timeCursor{CreatedAt: createdAt.Format(taskCursorTimestampLayout), ID: id})
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func decodeTimeCursor(encoded string) (*timeCursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, err
+	}
+
+	var cursor timeCursor
+	if err := json.Unmarshal(data, &cursor); err != nil {
+		return nil, err
+	}
+	return &cursor, nil
+}
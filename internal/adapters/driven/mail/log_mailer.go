@@ -0,0 +1,20 @@
+package mail
+
+import (
+	"context"
+
+	"github.com/fatihsen-dev/kanban-backend/internal/core/domain"
+	ports "github.com/fatihsen-dev/kanban-backend/internal/core/ports/driven"
+	"go.uber.org/zap"
+)
+
+type LogMailer struct{}
+
+func NewLogMailer() ports.Mailer {
+	return &LogMailer{}
+}
+
+func (m *LogMailer) Send(_ context.Context, message *domain.MailMessage) error {
+	zap.L().Info("Mail", zap.String("to", message.To), zap.String("subject", message.Subject), zap.String("body", message.TextBody))
+	return nil
+}
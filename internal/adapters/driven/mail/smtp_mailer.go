@@ -0,0 +1,43 @@
+package mail
+
+import (
+	"context"
+	"net"
+	netmail "net/mail"
+	"net/smtp"
+
+	"github.com/fatihsen-dev/kanban-backend/internal/core/domain"
+	ports "github.com/fatihsen-dev/kanban-backend/internal/core/ports/driven"
+)
+
+type SMTPMailer struct {
+	addr string
+	auth smtp.Auth
+	from string
+}
+
+func NewSMTPMailer(host, port, username, password, from string) ports.Mailer {
+	var auth smtp.Auth
+	if username != "" {
+		auth = smtp.PlainAuth("", username, password, host)
+	}
+	return &SMTPMailer{addr: net.JoinHostPort(host, port), auth: auth, from: from}
+}
+
+func (m *SMTPMailer) Send(ctx context.Context, message *domain.MailMessage) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	sender, err := netmail.ParseAddress(m.from)
+	if err != nil {
+		return err
+	}
+
+	raw, err := buildMIMEMessage(m.from, message)
+	if err != nil {
+		return err
+	}
+
+	return smtp.SendMail(m.addr, m.auth, sender.Address, []string{message.To}, raw)
+}
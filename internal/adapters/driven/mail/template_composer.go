@@ -0,0 +1,78 @@
+package mail
+
+import (
+	"bytes"
+	"embed"
+	"fmt"
+	htmltemplate "html/template"
+	"strings"
+	texttemplate "text/template"
+	"time"
+
+	"github.com/fatihsen-dev/kanban-backend/internal/core/domain"
+	ports "github.com/fatihsen-dev/kanban-backend/internal/core/ports/driven"
+)
+
+//go:embed templates/*.tmpl
+var templateFS embed.FS
+
+type notificationMailData struct {
+	Recipient      *domain.User
+	Notification   *domain.Notification
+	UnsubscribeURL string
+}
+
+type TemplateComposer struct {
+	html *htmltemplate.Template
+	text *texttemplate.Template
+}
+
+func NewTemplateComposer(clientURL string) ports.MailComposer {
+	funcs := map[string]interface{}{
+		"projectURL": func(projectID string) string {
+			return strings.TrimRight(clientURL, "/") + "/projects/" + projectID
+		},
+		"formatDate": formatDate,
+	}
+
+	return &TemplateComposer{
+		html: htmltemplate.Must(htmltemplate.New("").Funcs(funcs).ParseFS(templateFS, "templates/*.html.tmpl")),
+		text: texttemplate.Must(texttemplate.New("").Funcs(funcs).ParseFS(templateFS, "templates/*.txt.tmpl")),
+	}
+}
+
+func formatDate(value interface{}) string {
+	switch date := value.(type) {
+	case time.Time:
+		return date.UTC().Format("Jan 2, 15:04 MST")
+	case *time.Time:
+		if date != nil {
+			return date.UTC().Format("Jan 2, 15:04 MST")
+		}
+	}
+	return ""
+}
+
+func (c *TemplateComposer) ComposeNotification(recipient *domain.User, notification *domain.Notification, unsubscribeURL string) (*domain.MailMessage, error) {
+	data := notificationMailData{Recipient: recipient, Notification: notification, UnsubscribeURL: unsubscribeURL}
+	return c.compose("notification", recipient.Email, notification.Message, data)
+}
+
+func (c *TemplateComposer) ComposeDigest(digest *domain.Digest) (*domain.MailMessage, error) {
+	subject := fmt.Sprintf("Your daily digest: %d assigned, %d due soon, %d unread", len(digest.AssignedTasks), len(digest.DueSoon), len(digest.Notifications))
+	return c.compose("digest", digest.Recipient.Email, subject, digest)
+}
+
+func (c *TemplateComposer) compose(name, to, subject string, data interface{}) (*domain.MailMessage, error) {
+	var html bytes.Buffer
+	if err := c.html.ExecuteTemplate(&html, name+".html.tmpl", data); err != nil {
+		return nil, err
+	}
+
+	var text bytes.Buffer
+	if err := c.text.ExecuteTemplate(&text, name+".txt.tmpl", data); err != nil {
+		return nil, err
+	}
+
+	return &domain.MailMessage{To: to, Subject: subject, HTMLBody: html.String(), TextBody: text.String()}, nil
+}
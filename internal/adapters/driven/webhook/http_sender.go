@@ -0,0 +1,81 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"net/netip"
+	"syscall"
+	"time"
+
+	"github.com/fatihsen-dev/kanban-backend/internal/core/domain"
+	ports "github.com/fatihsen-dev/kanban-backend/internal/core/ports/driven"
+)
+
+const maxResponseBodySize = 4096
+
+var errAddressNotAllowed = errors.New("webhook address resolves to a loopback, private or link-local network")
+
+type dialControl func(network, address string, conn syscall.RawConn) error
+
+type HTTPSender struct {
+	client *http.Client
+}
+
+func NewHTTPSender(timeout time.Duration) ports.WebhookSender {
+	return newHTTPSender(timeout, publicAddressOnly)
+}
+
+func newHTTPSender(timeout time.Duration, control dialControl) *HTTPSender {
+	dialer := &net.Dialer{Timeout: timeout, Control: control}
+	transport := &http.Transport{
+		DialContext:         dialer.DialContext,
+		TLSHandshakeTimeout: timeout,
+		MaxIdleConns:        100,
+		IdleConnTimeout:     90 * time.Second,
+	}
+
+	return &HTTPSender{client: &http.Client{Timeout: timeout, Transport: transport}}
+}
+
+func (s *HTTPSender) Send(ctx context.Context, url string, headers map[string]string, payload []byte) (*domain.WebhookResponse, error) {
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(payload))
+	if err != nil {
+		return nil, err
+	}
+
+	for key, value := range headers {
+		request.Header.Set(key, value)
+	}
+
+	response, err := s.client.Do(request)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	body, err := io.ReadAll(io.LimitReader(response.Body, maxResponseBodySize))
+	if err != nil {
+		return nil, err
+	}
+
+	return &domain.WebhookResponse{StatusCode: response.StatusCode, Body: string(body)}, nil
+}
+
+func publicAddressOnly(network, address string, conn syscall.RawConn) error {
+	addrPort, err := netip.ParseAddrPort(address)
+	if err != nil {
+		return err
+	}
+
+	addr := addrPort.Addr().Unmap()
+	if addr.IsLoopback() || addr.IsPrivate() || addr.IsLinkLocalUnicast() || addr.IsLinkLocalMulticast() ||
+		addr.IsInterfaceLocalMulticast() || addr.IsMulticast() || addr.IsUnspecified() {
+		return errAddressNotAllowed
+	}
+
+	return nil
+}
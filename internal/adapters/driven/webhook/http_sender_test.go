@@ -0,0 +1,132 @@
+package webhook
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/fatihsen-dev/kanban-backend/internal/core/domain"
+)
+
+func TestHTTPSenderSendsHeadersAndPayload(t *testing.T) {
+	payload := []byte(`{"event":"task.created"}`)
+	signature := domain.SignWebhookPayload("secret", payload)
+
+	var gotMethod, gotSignature, gotContentType string
+	var gotBody []byte
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotMethod = r.Method
+		gotSignature = r.Header.Get(domain.WebhookSignatureHeader)
+		gotContentType = r.Header.Get("Content-Type")
+		gotBody, _ = io.ReadAll(r.Body)
+		w.WriteHeader(http.StatusAccepted)
+		w.Write([]byte("queued"))
+	}))
+	defer server.Close()
+
+	sender := newHTTPSender(time.Second, nil)
+	response, err := sender.Send(context.Background(), server.URL, map[string]string{
+		"Content-Type":                "application/json",
+		domain.WebhookSignatureHeader: signature,
+	}, payload)
+	if err != nil {
+		t.Fatalf("Send returned error: %v", err)
+	}
+
+	if gotMethod != http.MethodPost {
+		t.Errorf("method = %q, want POST", gotMethod)
+	}
+	if gotSignature != signature {
+		t.Errorf("signature header = %q, want %q", gotSignature, signature)
+	}
+	if gotContentType != "application/json" {
+		t.Errorf("content type = %q, want application/json", gotContentType)
+	}
+	if string(gotBody) != string(payload) {
+		t.Errorf("body = %q, want %q", gotBody, payload)
+	}
+	if response.StatusCode != http.StatusAccepted || response.Body != "queued" {
+		t.Errorf("response = %+v, want 202 queued", response)
+	}
+}
+
+func TestHTTPSenderTruncatesResponseBody(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(strings.Repeat("x", maxResponseBodySize*2)))
+	}))
+	defer server.Close()
+
+	response, err := newHTTPSender(time.Second, nil).Send(context.Background(), server.URL, nil, nil)
+	if err != nil {
+		t.Fatalf("Send returned error: %v", err)
+	}
+
+	if response.StatusCode != http.StatusInternalServerError {
+		t.Errorf("status = %d, want 500", response.StatusCode)
+	}
+	if len(response.Body) != maxResponseBodySize {
+		t.Errorf("body length = %d, want %d", len(response.Body), maxResponseBodySize)
+	}
+}
+
+func TestHTTPSenderTimesOut(t *testing.T) {
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-release
+	}))
+	defer server.Close()
+	defer close(release)
+
+	_, err := newHTTPSender(50*time.Millisecond, nil).Send(context.Background(), server.URL, nil, nil)
+	if err == nil {
+		t.Fatal("Send returned no error for a slow endpoint")
+	}
+}
+
+func TestHTTPSenderRefusesLoopbackAddresses(t *testing.T) {
+	called := false
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		called = true
+	}))
+	defer server.Close()
+
+	_, err := NewHTTPSender(time.Second).Send(context.Background(), server.URL, nil, nil)
+	if !errors.Is(err, errAddressNotAllowed) {
+		t.Fatalf("Send error = %v, want %v", err, errAddressNotAllowed)
+	}
+	if called {
+		t.Error("endpoint on a loopback address was called")
+	}
+}
+
+func TestPublicAddressOnly(t *testing.T) {
+	tests := []struct {
+		address string
+		allowed bool
+	}{
+		{"127.0.0.1:80", false},
+		{"[::1]:443", false},
+		{"10.1.2.3:80", false},
+		{"172.16.0.1:80", false},
+		{"192.168.1.1:80", false},
+		{"169.254.169.254:80", false},
+		{"[fe80::1]:80", false},
+		{"[::ffff:127.0.0.1]:80", false},
+		{"0.0.0.0:80", false},
+		{"93.184.216.34:443", true},
+		{"[2606:2800:220:1::248]:443", true},
+	}
+
+	for _, test := range tests {
+		err := publicAddressOnly("tcp", test.address, nil)
+		if (err == nil) != test.allowed {
+			t.Errorf("publicAddressOnly(%q) error = %v, want allowed %v", test.address, err, test.allowed)
+		}
+	}
+}
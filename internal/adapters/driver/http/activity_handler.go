@@ -0,0 +1,88 @@
+package http
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/fatihsen-dev/kanban-backend/internal/adapters/driver/http/datatransfers"
+	"github.com/fatihsen-dev/kanban-backend/internal/adapters/driver/http/datatransfers/requests"
+	"github.com/fatihsen-dev/kanban-backend/internal/adapters/driver/http/datatransfers/responses"
+	middlewares "github.com/fatihsen-dev/kanban-backend/internal/adapters/driver/http/middleware"
+	"github.com/fatihsen-dev/kanban-backend/internal/adapters/driver/validation"
+	"github.com/fatihsen-dev/kanban-backend/internal/core/domain"
+	ports "github.com/fatihsen-dev/kanban-backend/internal/core/ports/driver"
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+type activityHandler struct {
+	activityService        ports.ActivityService
+	authMiddleware         *middlewares.AuthnMiddleware
+	projectAuthzMiddleware *middlewares.ProjectAuthzMiddleware
+	taskKeyMiddleware      *middlewares.TaskKeyMiddleware
+}
+
+func NewActivityHandler(activityService ports.ActivityService, authMiddleware *middlewares.AuthnMiddleware, projectAuthzMiddleware *middlewares.ProjectAuthzMiddleware, taskKeyMiddleware *middlewares.TaskKeyMiddleware) *activityHandler {
+	return &activityHandler{activityService: activityService, authMiddleware: authMiddleware, projectAuthzMiddleware: projectAuthzMiddleware, taskKeyMiddleware: taskKeyMiddleware}
+}
+
+func (h *activityHandler) RegisterActivityRouter(r *gin.Engine) {
+	r.GET("/projects/:project_id/activity", h.authMiddleware.Handle(false), h.projectAuthzMiddleware.Handle(domain.PermissionProjectView), h.GetProjectActivityHandler)
+	r.GET("/projects/:project_id/tasks/:task_id/activity", h.authMiddleware.Handle(false), h.taskKeyMiddleware.Handle("task_id"), h.projectAuthzMiddleware.Handle(domain.PermissionProjectView), h.GetTaskActivityHandler)
+}
+
+func (h *activityHandler) GetProjectActivityHandler(c *gin.Context) {
+	var requestData requests.ActivityListRequest
+	if err := c.ShouldBindQuery(&requestData); err != nil {
+		c.JSON(http.StatusBadRequest, datatransfers.ResponseError("Invalid query parameters"))
+		return
+	}
+
+	if err := validation.Validate(requestData); err != nil {
+		c.JSON(http.StatusBadRequest, datatransfers.ResponseError(err.Error()))
+		return
+	}
+
+	h.respondWithActivity(c, &domain.ActivityFilter{
+		ProjectID:  c.Param("project_id"),
+		EntityType: domain.EntityType(requestData.EntityType),
+		Limit:      requestData.Limit,
+		Cursor:     requestData.Cursor,
+	})
+}
+
+func (h *activityHandler) GetTaskActivityHandler(c *gin.Context) {
+	var requestData requests.ActivityListRequest
+	if err := c.ShouldBindQuery(&requestData); err != nil {
+		c.JSON(http.StatusBadRequest, datatransfers.ResponseError("Invalid query parameters"))
+		return
+	}
+
+	if err := validation.Validate(requestData); err != nil {
+		c.JSON(http.StatusBadRequest, datatransfers.ResponseError(err.Error()))
+		return
+	}
+
+	h.respondWithActivity(c, &domain.ActivityFilter{
+		ProjectID:  c.Param("project_id"),
+		EntityType: domain.EntityTask,
+		EntityID:   c.Param("task_id"),
+		Limit:      requestData.Limit,
+		Cursor:     requestData.Cursor,
+	})
+}
+
+func (h *activityHandler) respondWithActivity(c *gin.Context, filter *domain.ActivityFilter) {
+	page, err := h.activityService.GetProjectActivity(c.Request.Context(), filter)
+	if errors.Is(err, domain.ErrInvalidPageCursor) {
+		c.JSON(http.StatusBadRequest, datatransfers.ResponseError(err.Error()))
+		return
+	}
+	if err != nil {
+		zap.L().Error("Failed to get activity", zap.Error(err))
+		c.JSON(http.StatusInternalServerError, datatransfers.ResponseError("Failed to get activity"))
+		return
+	}
+
+	c.JSON(http.StatusOK, datatransfers.ResponseSuccess("Activity fetched successfully", responses.NewActivityPageResponse(page)))
+}
@@ -0,0 +1,214 @@
+package http
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/fatihsen-dev/kanban-backend/internal/adapters/driver/http/datatransfers"
+	"github.com/fatihsen-dev/kanban-backend/internal/adapters/driver/http/datatransfers/requests"
+	"github.com/fatihsen-dev/kanban-backend/internal/adapters/driver/http/datatransfers/responses"
+	middlewares "github.com/fatihsen-dev/kanban-backend/internal/adapters/driver/http/middleware"
+	"github.com/fatihsen-dev/kanban-backend/internal/adapters/driver/validation"
+	"github.com/fatihsen-dev/kanban-backend/internal/core/domain"
+	ports "github.com/fatihsen-dev/kanban-backend/internal/core/ports/driver"
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+type automationHandler struct {
+	automationService      ports.AutomationService
+	authMiddleware         *middlewares.AuthnMiddleware
+	projectAuthzMiddleware *middlewares.ProjectAuthzMiddleware
+}
+
+func NewAutomationHandler(automationService ports.AutomationService, authMiddleware *middlewares.AuthnMiddleware, projectAuthzMiddleware *middlewares.ProjectAuthzMiddleware) *automationHandler {
+	return &automationHandler{automationService: automationService, authMiddleware: authMiddleware, projectAuthzMiddleware: projectAuthzMiddleware}
+}
+
+func (h *automationHandler) RegisterAutomationRouter(r *gin.Engine) {
+	automationGroup := r.Group("/projects/:project_id/automations")
+
+	automationGroup.Use(h.authMiddleware.Handle(false))
+
+	automationGroup.POST("", h.projectAuthzMiddleware.Handle(domain.PermissionProjectManage), h.CreateAutomationRuleHandler)
+	automationGroup.GET("", h.projectAuthzMiddleware.Handle(domain.PermissionProjectView), h.GetAutomationRulesHandler)
+	automationGroup.GET("/:rule_id", h.projectAuthzMiddleware.Handle(domain.PermissionProjectView), h.GetAutomationRuleHandler)
+	automationGroup.PUT("/:rule_id", h.projectAuthzMiddleware.Handle(domain.PermissionProjectManage), h.UpdateAutomationRuleHandler)
+	automationGroup.DELETE("/:rule_id", h.projectAuthzMiddleware.Handle(domain.PermissionProjectManage), h.DeleteAutomationRuleHandler)
+	automationGroup.GET("/:rule_id/executions", h.projectAuthzMiddleware.Handle(domain.PermissionProjectView), h.GetAutomationExecutionsHandler)
+}
+
+func (h *automationHandler) CreateAutomationRuleHandler(c *gin.Context) {
+	projectID := c.Param("project_id")
+
+	var requestData requests.AutomationRuleRequest
+	if err := c.ShouldBindJSON(&requestData); err != nil {
+		c.JSON(http.StatusBadRequest, datatransfers.ResponseError("Invalid request data"))
+		return
+	}
+
+	if err := validation.Validate(requestData); err != nil {
+		c.JSON(http.StatusBadRequest, datatransfers.ResponseError(err.Error()))
+		return
+	}
+
+	rule := toAutomationRule(requestData)
+	rule.ProjectID = projectID
+
+	err := h.automationService.CreateRule(c.Request.Context(), rule)
+	if errors.Is(err, domain.ErrInvalidAutomationRule) {
+		c.JSON(http.StatusBadRequest, datatransfers.ResponseError(err.Error()))
+		return
+	}
+
+	if err != nil {
+		zap.L().Error("Failed to create automation rule", zap.Error(err))
+		c.JSON(http.StatusInternalServerError, datatransfers.ResponseError("Failed to create automation"))
+		return
+	}
+
+	responseData := responses.NewAutomationRuleResponse(rule)
+
+	c.JSON(http.StatusCreated, datatransfers.ResponseSuccess("Automation created successfully", responseData))
+}
+
+func (h *automationHandler) GetAutomationRulesHandler(c *gin.Context) {
+	projectID := c.Param("project_id")
+
+	rules, err := h.automationService.GetRulesByProjectID(c.Request.Context(), projectID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, datatransfers.ResponseError("Failed to get automations"))
+		return
+	}
+
+	responseData := make([]responses.AutomationRuleResponse, len(rules))
+	for i, rule := range rules {
+		responseData[i] = responses.NewAutomationRuleResponse(rule)
+	}
+
+	c.JSON(http.StatusOK, datatransfers.ResponseSuccess("Automations fetched successfully", responseData))
+}
+
+func (h *automationHandler) GetAutomationRuleHandler(c *gin.Context) {
+	rule, ok := h.getProjectRule(c)
+	if !ok {
+		return
+	}
+
+	c.JSON(http.StatusOK, datatransfers.ResponseSuccess("Automation fetched successfully", responses.NewAutomationRuleResponse(rule)))
+}
+
+func (h *automationHandler) UpdateAutomationRuleHandler(c *gin.Context) {
+
+	rule, ok := h.getProjectRule(c)
+	if !ok {
+		return
+	}
+
+	var requestData requests.AutomationRuleRequest
+	if err := c.ShouldBindJSON(&requestData); err != nil {
+		c.JSON(http.StatusBadRequest, datatransfers.ResponseError("Invalid request data"))
+		return
+	}
+
+	if err := validation.Validate(requestData); err != nil {
+		c.JSON(http.StatusBadRequest, datatransfers.ResponseError(err.Error()))
+		return
+	}
+
+	updatedRule := toAutomationRule(requestData)
+	updatedRule.ID = rule.ID
+	updatedRule.ProjectID = rule.ProjectID
+	updatedRule.CreatedAt = rule.CreatedAt
+
+	err := h.automationService.UpdateRule(c.Request.Context(), updatedRule)
+	if errors.Is(err, domain.ErrInvalidAutomationRule) {
+		c.JSON(http.StatusBadRequest, datatransfers.ResponseError(err.Error()))
+		return
+	}
+
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, datatransfers.ResponseError("Failed to update automation"))
+		return
+	}
+
+	responseData := responses.NewAutomationRuleResponse(updatedRule)
+
+	c.JSON(http.StatusOK, datatransfers.ResponseSuccess("Automation updated successfully", responseData))
+}
+
+func (h *automationHandler) DeleteAutomationRuleHandler(c *gin.Context) {
+
+	rule, ok := h.getProjectRule(c)
+	if !ok {
+		return
+	}
+
+	err := h.automationService.DeleteRuleByID(c.Request.Context(), rule.ID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, datatransfers.ResponseError("Failed to delete automation"))
+		return
+	}
+
+	responseData := responses.AutomationRuleDeleteResponse{
+		ID: rule.ID,
+	}
+
+	c.JSON(http.StatusOK, datatransfers.ResponseSuccess("Automation deleted successfully", responseData))
+}
+
+func (h *automationHandler) GetAutomationExecutionsHandler(c *gin.Context) {
+	rule, ok := h.getProjectRule(c)
+	if !ok {
+		return
+	}
+
+	executions, err := h.automationService.GetExecutionsByRuleID(c.Request.Context(), rule.ID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, datatransfers.ResponseError("Failed to get automation executions"))
+		return
+	}
+
+	responseData := make([]responses.AutomationExecutionResponse, len(executions))
+	for i, execution := range executions {
+		responseData[i] = responses.NewAutomationExecutionResponse(execution)
+	}
+
+	c.JSON(http.StatusOK, datatransfers.ResponseSuccess("Automation executions fetched successfully", responseData))
+}
+
+func (h *automationHandler) getProjectRule(c *gin.Context) (*domain.AutomationRule, bool) {
+	ruleID := c.Param("rule_id")
+
+	err := validation.ValidateUUID(ruleID)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, datatransfers.ResponseError("Invalid automation ID"))
+		return nil, false
+	}
+
+	rule, err := h.automationService.GetRuleByID(c.Request.Context(), ruleID)
+	if err != nil || rule.ProjectID != c.Param("project_id") {
+		c.JSON(http.StatusNotFound, datatransfers.ResponseError("Automation not found"))
+		return nil, false
+	}
+
+	return rule, true
+}
+
+func toAutomationRule(requestData requests.AutomationRuleRequest) *domain.AutomationRule {
+	rule := &domain.AutomationRule{
+		Name:    requestData.Name,
+		Enabled: requestData.Enabled == nil || *requestData.Enabled,
+		Trigger: domain.AutomationTrigger(requestData.Trigger),
+	}
+
+	for _, condition := range requestData.Conditions {
+		rule.Conditions = append(rule.Conditions, domain.AutomationCondition{Type: domain.AutomationConditionType(condition.Type), Value: condition.Value})
+	}
+
+	for _, action := range requestData.Actions {
+		rule.Actions = append(rule.Actions, domain.AutomationAction{Type: domain.AutomationActionType(action.Type), Value: action.Value})
+	}
+
+	return rule
+}
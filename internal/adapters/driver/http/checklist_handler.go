@@ -0,0 +1,281 @@
+package http
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/fatihsen-dev/kanban-backend/internal/adapters/driver/http/datatransfers"
+	"github.com/fatihsen-dev/kanban-backend/internal/adapters/driver/http/datatransfers/requests"
+	"github.com/fatihsen-dev/kanban-backend/internal/adapters/driver/http/datatransfers/responses"
+	middlewares "github.com/fatihsen-dev/kanban-backend/internal/adapters/driver/http/middleware"
+	"github.com/fatihsen-dev/kanban-backend/internal/adapters/driver/validation"
+	"github.com/fatihsen-dev/kanban-backend/internal/core/domain"
+	ports "github.com/fatihsen-dev/kanban-backend/internal/core/ports/driver"
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+type checklistHandler struct {
+	checklistService       ports.ChecklistService
+	taskService            ports.TaskService
+	authMiddleware         *middlewares.AuthnMiddleware
+	projectAuthzMiddleware *middlewares.ProjectAuthzMiddleware
+	taskKeyMiddleware      *middlewares.TaskKeyMiddleware
+}
+
+func NewChecklistHandler(checklistService ports.ChecklistService, taskService ports.TaskService, authMiddleware *middlewares.AuthnMiddleware, projectAuthzMiddleware *middlewares.ProjectAuthzMiddleware, taskKeyMiddleware *middlewares.TaskKeyMiddleware) *checklistHandler {
+	return &checklistHandler{checklistService: checklistService, taskService: taskService, authMiddleware: authMiddleware, projectAuthzMiddleware: projectAuthzMiddleware, taskKeyMiddleware: taskKeyMiddleware}
+}
+
+func (h *checklistHandler) RegisterChecklistRouter(r *gin.Engine) {
+	checklistGroup := r.Group("/projects/:project_id/tasks/:task_id/checklist")
+
+	checklistGroup.Use(h.authMiddleware.Handle(false), h.taskKeyMiddleware.Handle("task_id"))
+
+	checklistGroup.GET("", h.projectAuthzMiddleware.Handle(domain.PermissionProjectView), h.GetChecklistHandler)
+	checklistGroup.POST("", h.projectAuthzMiddleware.Handle(domain.PermissionTaskUpdate), h.CreateChecklistItemHandler)
+	checklistGroup.PUT("/order", h.projectAuthzMiddleware.Handle(domain.PermissionTaskUpdate), h.ReorderChecklistHandler)
+	checklistGroup.PUT("/:item_id", h.projectAuthzMiddleware.Handle(domain.PermissionTaskUpdate), h.UpdateChecklistItemHandler)
+	checklistGroup.POST("/:item_id/toggle", h.projectAuthzMiddleware.Handle(domain.PermissionTaskUpdate), h.ToggleChecklistItemHandler)
+	checklistGroup.DELETE("/:item_id", h.projectAuthzMiddleware.Handle(domain.PermissionTaskUpdate), h.DeleteChecklistItemHandler)
+}
+
+func (h *checklistHandler) GetChecklistHandler(c *gin.Context) {
+	task, ok := h.getProjectTask(c)
+	if !ok {
+		return
+	}
+
+	items, err := h.checklistService.GetItemsByTaskID(c.Request.Context(), task.ID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, datatransfers.ResponseError("Failed to get checklist"))
+		return
+	}
+
+	c.JSON(http.StatusOK, datatransfers.ResponseSuccess("Checklist fetched successfully", responses.NewChecklistItemResponses(items)))
+}
+
+func (h *checklistHandler) CreateChecklistItemHandler(c *gin.Context) {
+	task, ok := h.getProjectTask(c)
+	if !ok {
+		return
+	}
+
+	var requestData requests.ChecklistItemCreateRequest
+	if err := c.ShouldBindJSON(&requestData); err != nil {
+		c.JSON(http.StatusBadRequest, datatransfers.ResponseError("Invalid request data"))
+		return
+	}
+
+	if err := validation.Validate(requestData); err != nil {
+		c.JSON(http.StatusBadRequest, datatransfers.ResponseError(err.Error()))
+		return
+	}
+
+	item := &domain.ChecklistItem{
+		TaskID:     task.ID,
+		Text:       requestData.Text,
+		AssigneeID: requestData.AssigneeID,
+	}
+
+	if requestData.DueDate != nil {
+		dueDate, _ := time.Parse(time.RFC3339, *requestData.DueDate)
+		item.DueDate = &dueDate
+	}
+
+	progress, err := h.checklistService.AddItem(c.Request.Context(), task.ProjectID, item)
+	if errors.Is(err, domain.ErrInvalidAssignee) {
+		c.JSON(http.StatusBadRequest, datatransfers.ResponseError(err.Error()))
+		return
+	}
+
+	if err != nil {
+		zap.L().Error("Failed to create checklist item", zap.Error(err))
+		c.JSON(http.StatusInternalServerError, datatransfers.ResponseError("Failed to create checklist item"))
+		return
+	}
+
+	responseData := responses.ChecklistItemChangeResponse{
+		Item:     responses.NewChecklistItemResponse(item),
+		Progress: responses.NewChecklistProgressResponse(progress),
+	}
+
+	c.JSON(http.StatusCreated, datatransfers.ResponseSuccess("Checklist item created successfully", responseData))
+}
+
+func (h *checklistHandler) UpdateChecklistItemHandler(c *gin.Context) {
+	task, item, ok := h.getTaskItem(c)
+	if !ok {
+		return
+	}
+
+	var requestData requests.ChecklistItemUpdateRequest
+	if err := c.ShouldBindJSON(&requestData); err != nil {
+		c.JSON(http.StatusBadRequest, datatransfers.ResponseError("Invalid request data"))
+		return
+	}
+
+	if err := validation.Validate(requestData); err != nil {
+		c.JSON(http.StatusBadRequest, datatransfers.ResponseError(err.Error()))
+		return
+	}
+
+	if requestData.Text != nil {
+		item.Text = *requestData.Text
+	}
+
+	if requestData.Done != nil {
+		item.Done = *requestData.Done
+	}
+
+	if requestData.AssigneeID != nil {
+		item.AssigneeID = requestData.AssigneeID
+		if *requestData.AssigneeID == "" {
+			item.AssigneeID = nil
+		}
+	}
+
+	if requestData.DueDate != nil {
+		item.DueDate = nil
+		if *requestData.DueDate != "" {
+			dueDate, _ := time.Parse(time.RFC3339, *requestData.DueDate)
+			item.DueDate = &dueDate
+		}
+	}
+
+	progress, err := h.checklistService.UpdateItem(c.Request.Context(), task.ProjectID, item)
+	if errors.Is(err, domain.ErrInvalidAssignee) {
+		c.JSON(http.StatusBadRequest, datatransfers.ResponseError(err.Error()))
+		return
+	}
+
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, datatransfers.ResponseError("Failed to update checklist item"))
+		return
+	}
+
+	h.respondItemUpdated(c, item, progress, "Checklist item updated successfully")
+}
+
+func (h *checklistHandler) ToggleChecklistItemHandler(c *gin.Context) {
+	_, item, ok := h.getTaskItem(c)
+	if !ok {
+		return
+	}
+
+	progress, err := h.checklistService.ToggleItem(c.Request.Context(), item)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, datatransfers.ResponseError("Failed to toggle checklist item"))
+		return
+	}
+
+	h.respondItemUpdated(c, item, progress, "Checklist item toggled successfully")
+}
+
+func (h *checklistHandler) ReorderChecklistHandler(c *gin.Context) {
+	task, ok := h.getProjectTask(c)
+	if !ok {
+		return
+	}
+
+	var requestData requests.ChecklistReorderRequest
+	if err := c.ShouldBindJSON(&requestData); err != nil {
+		c.JSON(http.StatusBadRequest, datatransfers.ResponseError("Invalid request data"))
+		return
+	}
+
+	if err := validation.Validate(requestData); err != nil {
+		c.JSON(http.StatusBadRequest, datatransfers.ResponseError(err.Error()))
+		return
+	}
+
+	items, err := h.checklistService.ReorderItems(c.Request.Context(), task.ID, requestData.ItemIDs)
+	if errors.Is(err, domain.ErrInvalidChecklistOrder) {
+		c.JSON(http.StatusBadRequest, datatransfers.ResponseError(err.Error()))
+		return
+	}
+
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, datatransfers.ResponseError("Failed to reorder checklist"))
+		return
+	}
+
+	responseData := responses.ChecklistReorderResponse{
+		TaskID: task.ID,
+		Items:  responses.NewChecklistItemResponses(items),
+	}
+
+	c.JSON(http.StatusOK, datatransfers.ResponseSuccess("Checklist reordered successfully", responseData))
+}
+
+func (h *checklistHandler) DeleteChecklistItemHandler(c *gin.Context) {
+	task, item, ok := h.getTaskItem(c)
+	if !ok {
+		return
+	}
+
+	progress, err := h.checklistService.DeleteItem(c.Request.Context(), item)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, datatransfers.ResponseError("Failed to delete checklist item"))
+		return
+	}
+
+	responseData := responses.ChecklistItemDeleteResponse{
+		ID:       item.ID,
+		TaskID:   task.ID,
+		Progress: responses.NewChecklistProgressResponse(progress),
+	}
+
+	c.JSON(http.StatusOK, datatransfers.ResponseSuccess("Checklist item deleted successfully", responseData))
+}
+
+func (h *checklistHandler) respondItemUpdated(c *gin.Context, item *domain.ChecklistItem, progress domain.ChecklistProgress, message string) {
+	responseData := responses.ChecklistItemChangeResponse{
+		Item:     responses.NewChecklistItemResponse(item),
+		Progress: responses.NewChecklistProgressResponse(progress),
+	}
+
+	c.JSON(http.StatusOK, datatransfers.ResponseSuccess(message, responseData))
+}
+
+func (h *checklistHandler) getProjectTask(c *gin.Context) (*domain.Task, bool) {
+	taskID := c.Param("task_id")
+
+	err := validation.ValidateUUID(taskID)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, datatransfers.ResponseError("Invalid task ID"))
+		return nil, false
+	}
+
+	task, err := h.taskService.GetTaskByID(c.Request.Context(), c.Param("project_id"), taskID)
+	if err != nil || task.DeletedAt != nil {
+		c.JSON(http.StatusNotFound, datatransfers.ResponseError("Task not found"))
+		return nil, false
+	}
+
+	return task, true
+}
+
+func (h *checklistHandler) getTaskItem(c *gin.Context) (*domain.Task, *domain.ChecklistItem, bool) {
+	task, ok := h.getProjectTask(c)
+	if !ok {
+		return nil, nil, false
+	}
+
+	itemID := c.Param("item_id")
+
+	err := validation.ValidateUUID(itemID)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, datatransfers.ResponseError("Invalid checklist item ID"))
+		return nil, nil, false
+	}
+
+	item, err := h.checklistService.GetItemByID(c.Request.Context(), itemID)
+	if err != nil || item.TaskID != task.ID {
+		c.JSON(http.StatusNotFound, datatransfers.ResponseError("Checklist item not found"))
+		return nil, nil, false
+	}
+
+	return task, item, true
+}
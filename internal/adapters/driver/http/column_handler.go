@@ -1,16 +1,15 @@
 package http
 
 import (
+	"errors"
 	"fmt"
 	"net/http"
-	"time"
 
 	"github.com/fatihsen-dev/kanban-backend/internal/adapters/driver/http/datatransfers"
 	"github.com/fatihsen-dev/kanban-backend/internal/adapters/driver/http/datatransfers/requests"
 	"github.com/fatihsen-dev/kanban-backend/internal/adapters/driver/http/datatransfers/responses"
 	middlewares "github.com/fatihsen-dev/kanban-backend/internal/adapters/driver/http/middleware"
 	"github.com/fatihsen-dev/kanban-backend/internal/adapters/driver/validation"
-	"github.com/fatihsen-dev/kanban-backend/internal/adapters/driver/ws"
 	"github.com/fatihsen-dev/kanban-backend/internal/core/domain"
 	ports "github.com/fatihsen-dev/kanban-backend/internal/core/ports/driver"
 	"github.com/gin-gonic/gin"
@@ -20,11 +19,10 @@ type columnHandler struct {
 	columnService          ports.ColumnService
 	authMiddleware         *middlewares.AuthnMiddleware
 	projectAuthzMiddleware *middlewares.ProjectAuthzMiddleware
-	hub                    *ws.Hub
 }
 
-func NewColumnHandler(columnService ports.ColumnService, authMiddleware *middlewares.AuthnMiddleware, projectAuthzMiddleware *middlewares.ProjectAuthzMiddleware, hub *ws.Hub) *columnHandler {
-	return &columnHandler{columnService: columnService, authMiddleware: authMiddleware, projectAuthzMiddleware: projectAuthzMiddleware, hub: hub}
+func NewColumnHandler(columnService ports.ColumnService, authMiddleware *middlewares.AuthnMiddleware, projectAuthzMiddleware *middlewares.ProjectAuthzMiddleware) *columnHandler {
+	return &columnHandler{columnService: columnService, authMiddleware: authMiddleware, projectAuthzMiddleware: projectAuthzMiddleware}
 }
 
 func (h *columnHandler) RegisterColumnRouter(r *gin.Engine) {
@@ -32,11 +30,14 @@ func (h *columnHandler) RegisterColumnRouter(r *gin.Engine) {
 
 	columnGroup.Use(h.authMiddleware.Handle(false))
 
-	columnGroup.POST("", h.projectAuthzMiddleware.Handle(middlewares.Admin), h.CreateColumnHandler)
-	columnGroup.GET("", h.projectAuthzMiddleware.Handle(middlewares.Member), h.GetColumnsHandler)
-	columnGroup.GET("/:column_id", h.projectAuthzMiddleware.Handle(middlewares.Member), h.GetColumnHandler)
-	columnGroup.PUT("/:column_id", h.projectAuthzMiddleware.Handle(middlewares.Admin), h.UpdateColumnHandler)
-	columnGroup.DELETE("/:column_id", h.projectAuthzMiddleware.Handle(middlewares.Admin), h.DeleteColumnHandler)
+	columnGroup.POST("", h.projectAuthzMiddleware.Handle(domain.PermissionColumnManage), h.CreateColumnHandler)
+	columnGroup.GET("", h.projectAuthzMiddleware.Handle(domain.PermissionProjectView), h.GetColumnsHandler)
+	columnGroup.GET("/archived", h.projectAuthzMiddleware.Handle(domain.PermissionProjectView), h.GetArchivedColumnsHandler)
+	columnGroup.GET("/:column_id", h.projectAuthzMiddleware.Handle(domain.PermissionProjectView), h.GetColumnHandler)
+	columnGroup.PUT("/:column_id", h.projectAuthzMiddleware.Handle(domain.PermissionColumnManage), h.UpdateColumnHandler)
+	columnGroup.DELETE("/:column_id", h.projectAuthzMiddleware.Handle(domain.PermissionColumnManage), h.DeleteColumnHandler)
+	columnGroup.POST("/:column_id/archive", h.projectAuthzMiddleware.Handle(domain.PermissionColumnManage), h.ArchiveColumnHandler)
+	columnGroup.POST("/:column_id/restore", h.projectAuthzMiddleware.Handle(domain.PermissionColumnManage), h.RestoreColumnHandler)
 }
 
 func (h *columnHandler) CreateColumnHandler(c *gin.Context) {
@@ -59,9 +60,14 @@ func (h *columnHandler) CreateColumnHandler(c *gin.Context) {
 	column := &domain.Column{
 		Name:      requestData.Name,
 		Color:     requestData.Color,
+		WipLimit:  requestData.WipLimit,
 		ProjectID: requestData.ProjectID,
 	}
 
+	if requestData.Category != nil {
+		column.Category = domain.ColumnCategory(*requestData.Category)
+	}
+
 	err := h.columnService.CreateColumn(c.Request.Context(), column)
 
 	if err != nil {
@@ -70,18 +76,7 @@ func (h *columnHandler) CreateColumnHandler(c *gin.Context) {
 		return
 	}
 
-	responseData := responses.ColumnResponse{
-		ID:        column.ID,
-		Name:      column.Name,
-		Color:     column.Color,
-		ProjectID: column.ProjectID,
-		CreatedAt: column.CreatedAt.Format(time.RFC3339),
-	}
-
-	h.hub.SendMessageToProject(column.ProjectID, ws.BaseResponse{
-		Name: ws.EventNameColumnCreated,
-		Data: responseData,
-	})
+	responseData := responses.NewColumnResponse(column)
 
 	c.JSON(http.StatusCreated, datatransfers.ResponseSuccess("Column created successfully", responseData))
 }
@@ -95,29 +90,17 @@ func (h *columnHandler) GetColumnHandler(c *gin.Context) {
 		return
 	}
 
-	column, tasks, err := h.columnService.GetColumnWithDetails(c.Request.Context(), id)
+	column, tasks, err := h.columnService.GetColumnWithDetails(c.Request.Context(), c.Param("project_id"), id)
+	if errors.Is(err, domain.ErrColumnNotFound) {
+		c.JSON(http.StatusNotFound, datatransfers.ResponseError(err.Error()))
+		return
+	}
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, datatransfers.ResponseError("Failed to get column with tasks"))
 		return
 	}
 
-	responseData := responses.ColumnWithDetailsResponse{
-		ID:        column.ID,
-		Name:      column.Name,
-		Color:     column.Color,
-		CreatedAt: column.CreatedAt.Format(time.RFC3339),
-		Tasks:     make([]responses.TaskResponse, len(tasks)),
-	}
-
-	for i, task := range tasks {
-		responseData.Tasks[i] = responses.TaskResponse{
-			ID:        task.ID,
-			Title:     task.Title,
-			ProjectID: task.ProjectID,
-			ColumnID:  task.ColumnID,
-			CreatedAt: task.CreatedAt.Format(time.RFC3339),
-		}
-	}
+	responseData := responses.NewColumnWithDetailsResponse(column, tasks)
 
 	c.JSON(http.StatusOK, datatransfers.ResponseSuccess("Column with tasks fetched successfully", responseData))
 }
@@ -133,13 +116,7 @@ func (h *columnHandler) GetColumnsHandler(c *gin.Context) {
 
 	responseData := make([]responses.ColumnResponse, len(columns))
 	for i, column := range columns {
-		responseData[i] = responses.ColumnResponse{
-			ID:        column.ID,
-			Name:      column.Name,
-			Color:     column.Color,
-			ProjectID: column.ProjectID,
-			CreatedAt: column.CreatedAt.Format(time.RFC3339),
-		}
+		responseData[i] = responses.NewColumnResponse(column)
 	}
 
 	c.JSON(http.StatusOK, datatransfers.ResponseSuccess("Columns fetched successfully", responseData))
@@ -173,35 +150,113 @@ func (h *columnHandler) UpdateColumnHandler(c *gin.Context) {
 	}
 
 	column := &domain.Column{
-		ID:    id,
-		Color: requestData.Color,
+		ID:        id,
+		ProjectID: projectID,
+		Color:     requestData.Color,
+		WipLimit:  requestData.WipLimit,
 	}
 
 	if requestData.Name != nil {
 		column.Name = *requestData.Name
 	}
 
+	if requestData.Category != nil {
+		column.Category = domain.ColumnCategory(*requestData.Category)
+	}
+
 	err = h.columnService.UpdateColumn(c.Request.Context(), column)
+	if errors.Is(err, domain.ErrColumnNotFound) {
+		c.JSON(http.StatusNotFound, datatransfers.ResponseError(err.Error()))
+		return
+	}
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, datatransfers.ResponseError("Failed to update column"))
 		return
 	}
 
 	responseData := responses.ColumnUpdateResponse{
-		ID:    column.ID,
-		Name:  column.Name,
-		Color: column.Color,
+		ID:       column.ID,
+		Name:     column.Name,
+		Color:    column.Color,
+		WipLimit: column.WipLimit,
+		Category: string(column.Category),
 	}
 
-	h.hub.SendMessageToProject(projectID, ws.BaseResponse{
-		Name: ws.EventNameColumnUpdated,
-		Data: responseData,
-	})
-
 	c.JSON(http.StatusOK, datatransfers.ResponseSuccess("Column updated successfully", responseData))
 }
 
 func (h *columnHandler) DeleteColumnHandler(c *gin.Context) {
+	var requestData requests.ColumnDeleteRequest
+	if err := c.ShouldBindQuery(&requestData); err != nil {
+		c.JSON(http.StatusBadRequest, datatransfers.ResponseError("Invalid query parameters"))
+		return
+	}
+
+	requestData.ColumnID = c.Param("column_id")
+	requestData.ProjectID = c.Param("project_id")
+	if requestData.TargetColumnID != nil && *requestData.TargetColumnID == "" {
+		requestData.TargetColumnID = nil
+	}
+
+	if err := validation.Validate(requestData); err != nil {
+		c.JSON(http.StatusBadRequest, datatransfers.ResponseError(err.Error()))
+		return
+	}
+
+	movedTaskIDs, err := h.columnService.DeleteColumn(c.Request.Context(), requestData.ProjectID, requestData.ColumnID, requestData.TargetColumnID)
+	if errors.Is(err, domain.ErrColumnNotFound) {
+		c.JSON(http.StatusNotFound, datatransfers.ResponseError(err.Error()))
+		return
+	}
+
+	if errors.Is(err, domain.ErrColumnNotEmpty) || errors.Is(err, domain.ErrWipLimitExceeded) {
+		c.JSON(http.StatusConflict, datatransfers.ResponseError(err.Error()))
+		return
+	}
+
+	if errors.Is(err, domain.ErrInvalidTargetColumn) {
+		c.JSON(http.StatusBadRequest, datatransfers.ResponseError(err.Error()))
+		return
+	}
+
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, datatransfers.ResponseError("Failed to delete column"))
+		return
+	}
+
+	responseData := responses.ColumnDeleteResponse{
+		ID:             requestData.ColumnID,
+		TargetColumnID: requestData.TargetColumnID,
+		MovedTaskIDs:   movedTaskIDs,
+	}
+
+	c.JSON(http.StatusOK, datatransfers.ResponseSuccess("Column deleted successfully", responseData))
+}
+
+func (h *columnHandler) GetArchivedColumnsHandler(c *gin.Context) {
+	projectID := c.Param("project_id")
+
+	state := c.DefaultQuery("state", string(domain.ArchiveStateArchived))
+	if !domain.IsValidArchiveState(state) {
+		c.JSON(http.StatusBadRequest, datatransfers.ResponseError("Invalid state"))
+		return
+	}
+
+	columns, err := h.columnService.GetColumnsByState(c.Request.Context(), projectID, domain.ArchiveState(state))
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, datatransfers.ResponseError("Failed to get columns"))
+		return
+	}
+
+	responseData := make([]responses.ColumnResponse, len(columns))
+	for i, column := range columns {
+		responseData[i] = responses.NewColumnResponse(column)
+	}
+
+	c.JSON(http.StatusOK, datatransfers.ResponseSuccess("Columns fetched successfully", responseData))
+}
+
+func (h *columnHandler) ArchiveColumnHandler(c *gin.Context) {
 	id := c.Param("column_id")
 	projectID := c.Param("project_id")
 
@@ -211,26 +266,36 @@ func (h *columnHandler) DeleteColumnHandler(c *gin.Context) {
 		return
 	}
 
-	err = validation.ValidateUUID(projectID)
+	err = h.columnService.ArchiveColumn(c.Request.Context(), projectID, id)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, datatransfers.ResponseError("Invalid project ID"))
+		c.JSON(http.StatusNotFound, datatransfers.ResponseError("Column not found or already archived"))
 		return
 	}
 
-	err = h.columnService.DeleteColumn(c.Request.Context(), id)
+	responseData := responses.ColumnDeleteResponse{
+		ID: id,
+	}
+
+	c.JSON(http.StatusOK, datatransfers.ResponseSuccess("Column archived successfully", responseData))
+}
+
+func (h *columnHandler) RestoreColumnHandler(c *gin.Context) {
+	id := c.Param("column_id")
+	projectID := c.Param("project_id")
+
+	err := validation.ValidateUUID(id)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, datatransfers.ResponseError("Failed to delete column"))
+		c.JSON(http.StatusBadRequest, datatransfers.ResponseError("Invalid column ID"))
 		return
 	}
 
-	responseData := responses.ColumnDeleteResponse{
-		ID: id,
+	column, tasks, err := h.columnService.RestoreColumn(c.Request.Context(), projectID, id)
+	if err != nil {
+		c.JSON(http.StatusNotFound, datatransfers.ResponseError("Column not found or not archived"))
+		return
 	}
 
-	h.hub.SendMessageToProject(projectID, ws.BaseResponse{
-		Name: ws.EventNameColumnDeleted,
-		Data: responseData,
-	})
+	responseData := responses.NewColumnWithDetailsResponse(column, tasks)
 
-	c.JSON(http.StatusOK, datatransfers.ResponseSuccess("Column deleted successfully", responseData))
+	c.JSON(http.StatusOK, datatransfers.ResponseSuccess("Column restored successfully", responseData))
 }
@@ -0,0 +1,168 @@
+package http
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/fatihsen-dev/kanban-backend/internal/adapters/driver/http/datatransfers"
+	"github.com/fatihsen-dev/kanban-backend/internal/adapters/driver/http/datatransfers/requests"
+	"github.com/fatihsen-dev/kanban-backend/internal/adapters/driver/http/datatransfers/responses"
+	middlewares "github.com/fatihsen-dev/kanban-backend/internal/adapters/driver/http/middleware"
+	"github.com/fatihsen-dev/kanban-backend/internal/adapters/driver/validation"
+	"github.com/fatihsen-dev/kanban-backend/internal/core/domain"
+	ports "github.com/fatihsen-dev/kanban-backend/internal/core/ports/driver"
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+type columnTransitionHandler struct {
+	columnTransitionService ports.ColumnTransitionService
+	authMiddleware          *middlewares.AuthnMiddleware
+	projectAuthzMiddleware  *middlewares.ProjectAuthzMiddleware
+}
+
+func NewColumnTransitionHandler(columnTransitionService ports.ColumnTransitionService, authMiddleware *middlewares.AuthnMiddleware, projectAuthzMiddleware *middlewares.ProjectAuthzMiddleware) *columnTransitionHandler {
+	return &columnTransitionHandler{columnTransitionService: columnTransitionService, authMiddleware: authMiddleware, projectAuthzMiddleware: projectAuthzMiddleware}
+}
+
+func (h *columnTransitionHandler) RegisterColumnTransitionRouter(r *gin.Engine) {
+	transitionGroup := r.Group("/projects/:project_id/transitions")
+
+	transitionGroup.Use(h.authMiddleware.Handle(false))
+
+	transitionGroup.POST("", h.projectAuthzMiddleware.Handle(domain.PermissionColumnManage), h.CreateColumnTransitionHandler)
+	transitionGroup.GET("", h.projectAuthzMiddleware.Handle(domain.PermissionProjectView), h.GetColumnTransitionsHandler)
+	transitionGroup.PUT("/:transition_id", h.projectAuthzMiddleware.Handle(domain.PermissionColumnManage), h.UpdateColumnTransitionHandler)
+	transitionGroup.DELETE("/:transition_id", h.projectAuthzMiddleware.Handle(domain.PermissionColumnManage), h.DeleteColumnTransitionHandler)
+}
+
+func (h *columnTransitionHandler) CreateColumnTransitionHandler(c *gin.Context) {
+	var requestData requests.CreateColumnTransitionRequest
+	if err := c.ShouldBindJSON(&requestData); err != nil {
+		c.JSON(http.StatusBadRequest, datatransfers.ResponseError("Invalid request data"))
+		return
+	}
+
+	requestData.ProjectID = c.Param("project_id")
+	if err := validation.Validate(requestData); err != nil {
+		c.JSON(http.StatusBadRequest, datatransfers.ResponseError(err.Error()))
+		return
+	}
+
+	transition := &domain.ColumnTransition{
+		ProjectID:    requestData.ProjectID,
+		FromColumnID: requestData.FromColumnID,
+		ToColumnID:   requestData.ToColumnID,
+		Conditions:   toTransitionConditions(requestData.Conditions),
+	}
+
+	err := h.columnTransitionService.CreateTransition(c.Request.Context(), transition)
+	if errors.Is(err, domain.ErrInvalidTransition) {
+		c.JSON(http.StatusBadRequest, datatransfers.ResponseError(err.Error()))
+		return
+	}
+
+	if err != nil {
+		zap.L().Error("Failed to create column transition", zap.Error(err))
+		c.JSON(http.StatusInternalServerError, datatransfers.ResponseError("Failed to create transition"))
+		return
+	}
+
+	responseData := responses.NewColumnTransitionResponse(transition)
+
+	c.JSON(http.StatusCreated, datatransfers.ResponseSuccess("Transition created successfully", responseData))
+}
+
+func (h *columnTransitionHandler) GetColumnTransitionsHandler(c *gin.Context) {
+	projectID := c.Param("project_id")
+
+	transitions, err := h.columnTransitionService.GetTransitionsByProjectID(c.Request.Context(), projectID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, datatransfers.ResponseError("Failed to get transitions"))
+		return
+	}
+
+	responseData := make([]responses.ColumnTransitionResponse, len(transitions))
+	for i, transition := range transitions {
+		responseData[i] = responses.NewColumnTransitionResponse(transition)
+	}
+
+	c.JSON(http.StatusOK, datatransfers.ResponseSuccess("Transitions fetched successfully", responseData))
+}
+
+func (h *columnTransitionHandler) UpdateColumnTransitionHandler(c *gin.Context) {
+	transitionID := c.Param("transition_id")
+	projectID := c.Param("project_id")
+
+	err := validation.ValidateUUID(transitionID)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, datatransfers.ResponseError("Invalid transition ID"))
+		return
+	}
+
+	var requestData requests.UpdateColumnTransitionRequest
+	if err := c.ShouldBindJSON(&requestData); err != nil {
+		c.JSON(http.StatusBadRequest, datatransfers.ResponseError("Invalid request data"))
+		return
+	}
+
+	if err := validation.Validate(requestData); err != nil {
+		c.JSON(http.StatusBadRequest, datatransfers.ResponseError(err.Error()))
+		return
+	}
+
+	transition, err := h.columnTransitionService.GetTransitionByID(c.Request.Context(), transitionID)
+	if err != nil || transition.ProjectID != projectID {
+		c.JSON(http.StatusNotFound, datatransfers.ResponseError("Transition not found"))
+		return
+	}
+
+	transition.Conditions = toTransitionConditions(requestData.Conditions)
+
+	err = h.columnTransitionService.UpdateTransition(c.Request.Context(), transition)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, datatransfers.ResponseError("Failed to update transition"))
+		return
+	}
+
+	responseData := responses.NewColumnTransitionResponse(transition)
+
+	c.JSON(http.StatusOK, datatransfers.ResponseSuccess("Transition updated successfully", responseData))
+}
+
+func (h *columnTransitionHandler) DeleteColumnTransitionHandler(c *gin.Context) {
+	transitionID := c.Param("transition_id")
+	projectID := c.Param("project_id")
+
+	err := validation.ValidateUUID(transitionID)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, datatransfers.ResponseError("Invalid transition ID"))
+		return
+	}
+
+	transition, err := h.columnTransitionService.GetTransitionByID(c.Request.Context(), transitionID)
+	if err != nil || transition.ProjectID != projectID {
+		c.JSON(http.StatusNotFound, datatransfers.ResponseError("Transition not found"))
+		return
+	}
+
+	err = h.columnTransitionService.DeleteTransitionByID(c.Request.Context(), transitionID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, datatransfers.ResponseError("Failed to delete transition"))
+		return
+	}
+
+	responseData := responses.ColumnTransitionDeleteResponse{
+		ID: transitionID,
+	}
+
+	c.JSON(http.StatusOK, datatransfers.ResponseSuccess("Transition deleted successfully", responseData))
+}
+
+func toTransitionConditions(values []string) []domain.TransitionCondition {
+	conditions := make([]domain.TransitionCondition, len(values))
+	for i, value := range values {
+		conditions[i] = domain.TransitionCondition(value)
+	}
+	return conditions
+}
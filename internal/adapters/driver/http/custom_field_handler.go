@@ -0,0 +1,199 @@
+package http
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/fatihsen-dev/kanban-backend/internal/adapters/driver/http/datatransfers"
+	"github.com/fatihsen-dev/kanban-backend/internal/adapters/driver/http/datatransfers/requests"
+	"github.com/fatihsen-dev/kanban-backend/internal/adapters/driver/http/datatransfers/responses"
+	middlewares "github.com/fatihsen-dev/kanban-backend/internal/adapters/driver/http/middleware"
+	"github.com/fatihsen-dev/kanban-backend/internal/adapters/driver/validation"
+	"github.com/fatihsen-dev/kanban-backend/internal/core/domain"
+	ports "github.com/fatihsen-dev/kanban-backend/internal/core/ports/driver"
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+type customFieldHandler struct {
+	customFieldService     ports.CustomFieldService
+	authMiddleware         *middlewares.AuthnMiddleware
+	projectAuthzMiddleware *middlewares.ProjectAuthzMiddleware
+}
+
+func NewCustomFieldHandler(customFieldService ports.CustomFieldService, authMiddleware *middlewares.AuthnMiddleware, projectAuthzMiddleware *middlewares.ProjectAuthzMiddleware) *customFieldHandler {
+	return &customFieldHandler{customFieldService: customFieldService, authMiddleware: authMiddleware, projectAuthzMiddleware: projectAuthzMiddleware}
+}
+
+func (h *customFieldHandler) RegisterCustomFieldRouter(r *gin.Engine) {
+	customFieldGroup := r.Group("/projects/:project_id/custom-fields")
+
+	customFieldGroup.Use(h.authMiddleware.Handle(false))
+
+	customFieldGroup.POST("", h.projectAuthzMiddleware.Handle(domain.PermissionProjectManage), h.CreateCustomFieldHandler)
+	customFieldGroup.GET("", h.projectAuthzMiddleware.Handle(domain.PermissionProjectView), h.GetCustomFieldsHandler)
+	customFieldGroup.PUT("/:field_id", h.projectAuthzMiddleware.Handle(domain.PermissionProjectManage), h.UpdateCustomFieldHandler)
+	customFieldGroup.DELETE("/:field_id", h.projectAuthzMiddleware.Handle(domain.PermissionProjectManage), h.DeleteCustomFieldHandler)
+}
+
+func (h *customFieldHandler) CreateCustomFieldHandler(c *gin.Context) {
+	var requestData requests.CreateCustomFieldRequest
+	if err := c.ShouldBindJSON(&requestData); err != nil {
+		c.JSON(http.StatusBadRequest, datatransfers.ResponseError("Invalid request data"))
+		return
+	}
+
+	requestData.ProjectID = c.Param("project_id")
+	if err := validation.Validate(requestData); err != nil {
+		c.JSON(http.StatusBadRequest, datatransfers.ResponseError(err.Error()))
+		return
+	}
+
+	field := &domain.CustomField{
+		ProjectID: requestData.ProjectID,
+		Name:      requestData.Name,
+		Type:      domain.CustomFieldType(requestData.Type),
+		Required:  requestData.Required,
+		Options:   requestData.Options,
+		MinValue:  requestData.MinValue,
+		MaxValue:  requestData.MaxValue,
+		MaxLength: requestData.MaxLength,
+	}
+
+	if requestData.Position != nil {
+		field.Position = *requestData.Position
+	}
+
+	err := h.customFieldService.CreateCustomField(c.Request.Context(), field)
+	if errors.Is(err, domain.ErrInvalidCustomField) {
+		c.JSON(http.StatusBadRequest, datatransfers.ResponseError(err.Error()))
+		return
+	}
+
+	if err != nil {
+		zap.L().Error("Failed to create custom field", zap.Error(err))
+		c.JSON(http.StatusInternalServerError, datatransfers.ResponseError("Failed to create custom field"))
+		return
+	}
+
+	responseData := responses.NewCustomFieldResponse(field)
+
+	c.JSON(http.StatusCreated, datatransfers.ResponseSuccess("Custom field created successfully", responseData))
+}
+
+func (h *customFieldHandler) GetCustomFieldsHandler(c *gin.Context) {
+	projectID := c.Param("project_id")
+
+	fields, err := h.customFieldService.GetCustomFieldsByProjectID(c.Request.Context(), projectID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, datatransfers.ResponseError("Failed to get custom fields"))
+		return
+	}
+
+	responseData := make([]responses.CustomFieldResponse, len(fields))
+	for i, field := range fields {
+		responseData[i] = responses.NewCustomFieldResponse(field)
+	}
+
+	c.JSON(http.StatusOK, datatransfers.ResponseSuccess("Custom fields fetched successfully", responseData))
+}
+
+func (h *customFieldHandler) UpdateCustomFieldHandler(c *gin.Context) {
+	fieldID := c.Param("field_id")
+	projectID := c.Param("project_id")
+
+	err := validation.ValidateUUID(fieldID)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, datatransfers.ResponseError("Invalid custom field ID"))
+		return
+	}
+
+	var requestData requests.UpdateCustomFieldRequest
+	if err := c.ShouldBindJSON(&requestData); err != nil {
+		c.JSON(http.StatusBadRequest, datatransfers.ResponseError("Invalid request data"))
+		return
+	}
+
+	if err := validation.Validate(requestData); err != nil {
+		c.JSON(http.StatusBadRequest, datatransfers.ResponseError(err.Error()))
+		return
+	}
+
+	field, err := h.customFieldService.GetCustomFieldByID(c.Request.Context(), fieldID)
+	if err != nil || field.ProjectID != projectID {
+		c.JSON(http.StatusNotFound, datatransfers.ResponseError("Custom field not found"))
+		return
+	}
+
+	if requestData.Name != nil {
+		field.Name = *requestData.Name
+	}
+
+	if requestData.Required != nil {
+		field.Required = *requestData.Required
+	}
+
+	if requestData.Options != nil {
+		field.Options = requestData.Options
+	}
+
+	if requestData.MinValue != nil {
+		field.MinValue = requestData.MinValue
+	}
+
+	if requestData.MaxValue != nil {
+		field.MaxValue = requestData.MaxValue
+	}
+
+	if requestData.MaxLength != nil {
+		field.MaxLength = requestData.MaxLength
+	}
+
+	if requestData.Position != nil {
+		field.Position = *requestData.Position
+	}
+
+	err = h.customFieldService.UpdateCustomField(c.Request.Context(), field)
+	if errors.Is(err, domain.ErrInvalidCustomField) {
+		c.JSON(http.StatusBadRequest, datatransfers.ResponseError(err.Error()))
+		return
+	}
+
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, datatransfers.ResponseError("Failed to update custom field"))
+		return
+	}
+
+	responseData := responses.NewCustomFieldResponse(field)
+
+	c.JSON(http.StatusOK, datatransfers.ResponseSuccess("Custom field updated successfully", responseData))
+}
+
+func (h *customFieldHandler) DeleteCustomFieldHandler(c *gin.Context) {
+	fieldID := c.Param("field_id")
+	projectID := c.Param("project_id")
+
+	err := validation.ValidateUUID(fieldID)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, datatransfers.ResponseError("Invalid custom field ID"))
+		return
+	}
+
+	field, err := h.customFieldService.GetCustomFieldByID(c.Request.Context(), fieldID)
+	if err != nil || field.ProjectID != projectID {
+		c.JSON(http.StatusNotFound, datatransfers.ResponseError("Custom field not found"))
+		return
+	}
+
+	err = h.customFieldService.DeleteCustomFieldByID(c.Request.Context(), fieldID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, datatransfers.ResponseError("Failed to delete custom field"))
+		return
+	}
+
+	responseData := responses.CustomFieldDeleteResponse{
+		ID: fieldID,
+	}
+
+	c.JSON(http.StatusOK, datatransfers.ResponseSuccess("Custom field deleted successfully", responseData))
+}
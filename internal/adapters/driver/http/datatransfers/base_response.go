@@ -21,6 +21,14 @@ func ResponseError(message string) *BaseResponse {
 	}
 }
 
+func ResponseErrorWithData(message string, data interface{}) *BaseResponse {
+	return &BaseResponse{
+		Success: false,
+		Message: message,
+		Data:    data,
+	}
+}
+
 func ResponseAbort(message string) *BaseResponse {
 	return &BaseResponse{
 		Success: false,
@@ -0,0 +1,7 @@
+package requests
+
+type ActivityListRequest struct {
+	EntityType string `form:"entity_type" validate:"omitempty,oneof=task column team project_member invitation project"`
+	Limit      int    `form:"limit" validate:"omitempty,min=1,max=200"`
+	Cursor     string `form:"cursor" validate:"omitempty,max=512"`
+}
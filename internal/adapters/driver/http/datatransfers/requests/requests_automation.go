@@ -0,0 +1,19 @@
+package requests
+
+type AutomationConditionRequest struct {
+	Type  string `json:"type" validate:"required,automation_condition"`
+	Value string `json:"value,omitempty" validate:"omitempty,max=255"`
+}
+
+type AutomationActionRequest struct {
+	Type  string `json:"type" validate:"required,automation_action"`
+	Value string `json:"value,omitempty" validate:"omitempty,max=255"`
+}
+
+type AutomationRuleRequest struct {
+	Name       string                       `json:"name" validate:"required,min=3,max=64,notblank"`
+	Enabled    *bool                        `json:"enabled,omitempty"`
+	Trigger    string                       `json:"trigger" validate:"required,automation_trigger"`
+	Conditions []AutomationConditionRequest `json:"conditions,omitempty" validate:"omitempty,max=10,dive"`
+	Actions    []AutomationActionRequest    `json:"actions" validate:"required,min=1,max=10,dive"`
+}
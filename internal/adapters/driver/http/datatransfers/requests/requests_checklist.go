@@ -0,0 +1,18 @@
+package requests
+
+type ChecklistItemCreateRequest struct {
+	Text       string  `json:"text" validate:"required,max=255,notblank"`
+	AssigneeID *string `json:"assignee_id,omitempty" validate:"omitempty,uuid4"`
+	DueDate    *string `json:"due_date,omitempty" validate:"omitempty,datetime=2006-01-02T15:04:05Z07:00"`
+}
+
+type ChecklistItemUpdateRequest struct {
+	Text       *string `json:"text,omitempty" validate:"omitempty,max=255,notblank"`
+	Done       *bool   `json:"done,omitempty"`
+	AssigneeID *string `json:"assignee_id,omitempty" validate:"omitempty,uuid4|eq="`
+	DueDate    *string `json:"due_date,omitempty" validate:"omitempty,datetime=2006-01-02T15:04:05Z07:00|eq="`
+}
+
+type ChecklistReorderRequest struct {
+	ItemIDs []string `json:"item_ids" validate:"required,min=1,dive,uuid4"`
+}
@@ -3,15 +3,20 @@ package requests
 type ColumnCreateRequest struct {
 	Name      string  `json:"name" validate:"required,min=3,max=26,notblank"`
 	Color     *string `json:"color" validate:"omitempty,hexcolor"`
+	WipLimit  *int    `json:"wip_limit,omitempty" validate:"omitempty,min=1"`
+	Category  *string `json:"category,omitempty" validate:"omitempty,oneof=active done"`
 	ProjectID string  `json:"project_id" validate:"required,uuid4"`
 }
 
 type ColumnUpdateRequest struct {
-	Name  *string `json:"name,omitempty" validate:"omitempty,min=3,max=26,notblank"`
-	Color *string `json:"color,omitempty" validate:"omitempty,hexcolor|eq="`
+	Name     *string `json:"name,omitempty" validate:"omitempty,min=3,max=26,notblank"`
+	Color    *string `json:"color,omitempty" validate:"omitempty,hexcolor|eq="`
+	WipLimit *int    `json:"wip_limit,omitempty" validate:"omitempty,min=0"`
+	Category *string `json:"category,omitempty" validate:"omitempty,oneof=active done"`
 }
 
 type ColumnDeleteRequest struct {
-	ColumnID  string `json:"column_id" validate:"required,uuid4"`
-	ProjectID string `json:"project_id" validate:"required,uuid4"`
+	ColumnID       string  `json:"column_id" validate:"required,uuid4"`
+	ProjectID      string  `json:"project_id" validate:"required,uuid4"`
+	TargetColumnID *string `form:"target_column_id" validate:"omitempty,uuid4"`
 }
@@ -0,0 +1,12 @@
+package requests
+
+type CreateColumnTransitionRequest struct {
+	FromColumnID string   `json:"from_column_id" validate:"required,uuid4"`
+	ToColumnID   string   `json:"to_column_id" validate:"required,uuid4"`
+	Conditions   []string `json:"conditions,omitempty" validate:"omitempty,dive,transition_condition"`
+	ProjectID    string   `json:"project_id" validate:"required,uuid4"`
+}
+
+type UpdateColumnTransitionRequest struct {
+	Conditions []string `json:"conditions" validate:"required,dive,transition_condition"`
+}
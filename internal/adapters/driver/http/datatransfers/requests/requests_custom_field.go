@@ -0,0 +1,23 @@
+package requests
+
+type CreateCustomFieldRequest struct {
+	Name      string   `json:"name" validate:"required,min=1,max=64,notblank"`
+	Type      string   `json:"type" validate:"required,oneof=text number date single_select multi_select user checkbox"`
+	Required  bool     `json:"required"`
+	Options   []string `json:"options,omitempty" validate:"omitempty,max=50,unique,dive,min=1,max=64,notblank"`
+	MinValue  *float64 `json:"min_value,omitempty"`
+	MaxValue  *float64 `json:"max_value,omitempty"`
+	MaxLength *int     `json:"max_length,omitempty" validate:"omitempty,min=1,max=10000"`
+	Position  *int     `json:"position,omitempty" validate:"omitempty,min=0"`
+	ProjectID string   `json:"project_id" validate:"required,uuid4"`
+}
+
+type UpdateCustomFieldRequest struct {
+	Name      *string  `json:"name,omitempty" validate:"omitempty,min=1,max=64,notblank"`
+	Required  *bool    `json:"required,omitempty"`
+	Options   []string `json:"options,omitempty" validate:"omitempty,max=50,unique,dive,min=1,max=64,notblank"`
+	MinValue  *float64 `json:"min_value,omitempty"`
+	MaxValue  *float64 `json:"max_value,omitempty"`
+	MaxLength *int     `json:"max_length,omitempty" validate:"omitempty,min=1,max=10000"`
+	Position  *int     `json:"position,omitempty" validate:"omitempty,min=0"`
+}
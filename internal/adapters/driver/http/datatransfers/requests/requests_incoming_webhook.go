@@ -0,0 +1,28 @@
+package requests
+
+type UpdateIncomingWebhookRequest struct {
+	TargetColumnID *string `json:"target_column_id,omitempty" validate:"omitempty,uuid"`
+	ClearColumn    bool    `json:"clear_column,omitempty"`
+	RotateSecret   bool    `json:"rotate_secret,omitempty"`
+}
+
+type PushEventAuthorRequest struct {
+	Name string `json:"name"`
+}
+
+type PushEventCommitRequest struct {
+	ID      string                 `json:"id" validate:"max=255"`
+	Message string                 `json:"message" validate:"max=65536"`
+	URL     string                 `json:"url" validate:"max=2048"`
+	Author  PushEventAuthorRequest `json:"author"`
+}
+
+type PushEventRepositoryRequest struct {
+	FullName string `json:"full_name"`
+}
+
+type PushEventRequest struct {
+	Ref        string                     `json:"ref" validate:"max=255"`
+	Repository PushEventRepositoryRequest `json:"repository"`
+	Commits    []PushEventCommitRequest   `json:"commits" validate:"max=2048,dive"`
+}
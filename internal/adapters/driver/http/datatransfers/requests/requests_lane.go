@@ -0,0 +1,12 @@
+package requests
+
+type CreateLaneRequest struct {
+	Name      string `json:"name" validate:"required,min=1,max=26,notblank"`
+	Position  *int   `json:"position,omitempty" validate:"omitempty,min=0"`
+	ProjectID string `json:"project_id" validate:"required,uuid4"`
+}
+
+type UpdateLaneRequest struct {
+	Name     *string `json:"name,omitempty" validate:"omitempty,min=1,max=26,notblank"`
+	Position *int    `json:"position,omitempty" validate:"omitempty,min=0"`
+}
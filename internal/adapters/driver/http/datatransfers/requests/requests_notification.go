@@ -0,0 +1,19 @@
+package requests
+
+type NotificationListRequest struct {
+	Unread bool   `form:"unread"`
+	Limit  int    `form:"limit" validate:"omitempty,min=1,max=100"`
+	Cursor string `form:"cursor" validate:"omitempty,max=512"`
+}
+
+type NotificationMarkReadRequest struct {
+	IDs []string `json:"ids" validate:"required,min=1,max=100,dive,uuid4"`
+}
+
+type NotificationPreferencesUpdateRequest struct {
+	Preferences map[string]string `json:"preferences" validate:"required,min=1,dive,keys,required,endkeys,oneof=immediate digest off"`
+}
+
+type NotificationUnsubscribeRequest struct {
+	Token string `form:"token" validate:"required,max=512"`
+}
@@ -0,0 +1,31 @@
+package requests
+
+type CreateOrganizationRequest struct {
+	Name string `json:"name" validate:"required,min=3,max=26,notblank"`
+}
+
+type UpdateOrganizationRequest struct {
+	Name *string `json:"name,omitempty" validate:"omitempty,min=3,max=26,notblank"`
+}
+
+type AddOrganizationMemberRequest struct {
+	UserID string `json:"user_id" validate:"required,uuid4"`
+	Role   string `json:"role" validate:"required,oneof=admin member"`
+}
+
+type UpdateOrganizationMemberRequest struct {
+	Role string `json:"role" validate:"required,oneof=admin member"`
+}
+
+type CreateOrganizationTeamRequest struct {
+	Name string `json:"name" validate:"required,min=3,max=26,notblank"`
+}
+
+type AddOrganizationTeamMembersRequest struct {
+	UserIDs []string `json:"user_ids" validate:"required,dive,uuid4"`
+}
+
+type GrantOrganizationTeamProjectsRequest struct {
+	ProjectIDs []string `json:"project_ids" validate:"required,min=1,dive,uuid4"`
+	Role       string   `json:"role" validate:"required,oneof=admin write read"`
+}
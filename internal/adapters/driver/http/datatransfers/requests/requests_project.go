@@ -1,5 +1,15 @@
 package requests
 
 type ProjectCreateRequest struct {
-	Name string `json:"name" validate:"required,min=3,max=26,notblank"`
+	Name           string  `json:"name" validate:"required,min=3,max=26,notblank"`
+	Key            *string `json:"key,omitempty" validate:"omitempty,project_key"`
+	OrganizationID *string `json:"organization_id,omitempty" validate:"omitempty,uuid4"`
+}
+
+type ProjectUpdateRequest struct {
+	Name               *string `json:"name,omitempty" validate:"omitempty,min=3,max=26,notblank"`
+	Key                *string `json:"key,omitempty" validate:"omitempty,project_key"`
+	WipEnforcement     *string `json:"wip_enforcement,omitempty" validate:"omitempty,oneof=hard soft"`
+	LaneGrouping       *string `json:"lane_grouping,omitempty" validate:"omitempty,oneof=custom assignee label priority"`
+	BlockerEnforcement *string `json:"blocker_enforcement,omitempty" validate:"omitempty,oneof=none hard"`
 }
@@ -10,4 +10,5 @@ type UpdateProjectMemberRequest struct {
 	ID     string  `json:"id" validate:"required,uuid4"`
 	Role   *string `json:"role,omitempty" validate:"omitempty,oneof=admin write read"`
 	TeamID *string `json:"team_id,omitempty" validate:"omitempty,uuid4|eq="`
+	RoleID *string `json:"role_id,omitempty" validate:"omitempty,uuid4|eq="`
 }
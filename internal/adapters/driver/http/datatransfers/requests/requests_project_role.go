@@ -0,0 +1,12 @@
+package requests
+
+type CreateProjectRoleRequest struct {
+	Name        string   `json:"name" validate:"required,min=3,max=26,notblank"`
+	Permissions []string `json:"permissions" validate:"required,dive,permission"`
+	ProjectID   string   `json:"project_id" validate:"required,uuid4"`
+}
+
+type UpdateProjectRoleRequest struct {
+	Name        *string  `json:"name,omitempty" validate:"omitempty,min=3,max=26,notblank"`
+	Permissions []string `json:"permissions,omitempty" validate:"omitempty,dive,permission"`
+}
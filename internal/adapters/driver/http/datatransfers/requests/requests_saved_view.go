@@ -0,0 +1,17 @@
+package requests
+
+type CreateSavedViewRequest struct {
+	Name          string          `json:"name" validate:"required,min=1,max=64,notblank"`
+	Visibility    string          `json:"visibility" validate:"required,oneof=personal shared"`
+	Filter        TaskListRequest `json:"filter"`
+	Grouping      string          `json:"grouping,omitempty" validate:"omitempty,oneof=custom assignee label priority"`
+	VisibleFields []string        `json:"visible_fields,omitempty" validate:"omitempty,max=50,unique,dive,min=1,max=64"`
+}
+
+type UpdateSavedViewRequest struct {
+	Name          *string          `json:"name,omitempty" validate:"omitempty,min=1,max=64,notblank"`
+	Visibility    *string          `json:"visibility,omitempty" validate:"omitempty,oneof=personal shared"`
+	Filter        *TaskListRequest `json:"filter,omitempty"`
+	Grouping      *string          `json:"grouping,omitempty" validate:"omitempty,oneof=custom assignee label priority|eq="`
+	VisibleFields []string         `json:"visible_fields,omitempty" validate:"omitempty,max=50,unique,dive,min=1,max=64"`
+}
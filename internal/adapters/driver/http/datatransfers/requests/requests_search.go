@@ -0,0 +1,7 @@
+package requests
+
+type SearchRequest struct {
+	Query string   `form:"q" validate:"required,min=2,max=200,notblank"`
+	Type  []string `form:"type" validate:"omitempty,max=2,dive,oneof=task project"`
+	Limit int      `form:"limit" validate:"omitempty,min=1,max=100"`
+}
@@ -1,14 +1,47 @@
 package requests
 
 type TaskCreateRequest struct {
-	Title     string  `json:"title" validate:"required,min=3,max=26,notblank"`
-	Content   *string `json:"content,omitempty" validate:"omitempty,max=1400"`
-	ColumnID  string  `json:"column_id" validate:"required,uuid4"`
-	ProjectID string  `json:"project_id" validate:"required,uuid4"`
+	Title        string                 `json:"title" validate:"required,min=3,max=26,notblank"`
+	Content      *string                `json:"content,omitempty" validate:"omitempty,max=1400"`
+	ColumnID     string                 `json:"column_id" validate:"required,uuid4"`
+	ProjectID    string                 `json:"project_id" validate:"required,uuid4"`
+	LaneID       *string                `json:"lane_id,omitempty" validate:"omitempty,uuid4"`
+	AssigneeID   *string                `json:"assignee_id,omitempty" validate:"omitempty,uuid4"`
+	Labels       []string               `json:"labels,omitempty" validate:"omitempty,max=20,dive,min=1,max=32,notblank"`
+	Priority     *string                `json:"priority,omitempty" validate:"omitempty,oneof=none low medium high urgent"`
+	Estimate     *float64               `json:"estimate,omitempty" validate:"omitempty,gt=0,max=1000"`
+	CustomFields map[string]interface{} `json:"custom_fields,omitempty" validate:"omitempty,max=50"`
+	DueDate      *string                `json:"due_date,omitempty" validate:"omitempty,datetime=2006-01-02T15:04:05Z07:00"`
 }
 
 type TaskUpdateRequest struct {
-	Title    *string `json:"title,omitempty" validate:"omitempty,min=3,max=26,notblank"`
-	Content  *string `json:"content,omitempty" validate:"omitempty,max=1400"`
-	ColumnID *string `json:"column_id,omitempty" validate:"omitempty,uuid4"`
+	Title        *string                `json:"title,omitempty" validate:"omitempty,min=3,max=26,notblank"`
+	Content      *string                `json:"content,omitempty" validate:"omitempty,max=1400"`
+	ColumnID     *string                `json:"column_id,omitempty" validate:"omitempty,uuid4"`
+	LaneID       *string                `json:"lane_id,omitempty" validate:"omitempty,uuid4|eq="`
+	AssigneeID   *string                `json:"assignee_id,omitempty" validate:"omitempty,uuid4|eq="`
+	Labels       []string               `json:"labels,omitempty" validate:"omitempty,max=20,dive,min=1,max=32,notblank"`
+	Priority     *string                `json:"priority,omitempty" validate:"omitempty,oneof=none low medium high urgent"`
+	Estimate     *float64               `json:"estimate,omitempty" validate:"omitempty,min=0,max=1000"`
+	CustomFields map[string]interface{} `json:"custom_fields,omitempty" validate:"omitempty,max=50"`
+	DueDate      *string                `json:"due_date,omitempty" validate:"omitempty,datetime=2006-01-02T15:04:05Z07:00|eq="`
+}
+
+type TaskListRequest struct {
+	State         string            `json:"state,omitempty" form:"state" validate:"omitempty,oneof=active archived trashed"`
+	ColumnID      []string          `json:"column_id,omitempty" form:"column_id" validate:"omitempty,max=50,dive,uuid4"`
+	AssigneeID    []string          `json:"assignee_id,omitempty" form:"assignee_id" validate:"omitempty,max=50,dive,uuid4|eq=none|eq=me"`
+	Label         []string          `json:"label,omitempty" form:"label" validate:"omitempty,max=20,dive,min=1,max=32"`
+	Priority      []string          `json:"priority,omitempty" form:"priority" validate:"omitempty,dive,oneof=none low medium high urgent"`
+	MinEstimate   *float64          `json:"min_estimate,omitempty" form:"min_estimate" validate:"omitempty,min=0"`
+	MaxEstimate   *float64          `json:"max_estimate,omitempty" form:"max_estimate" validate:"omitempty,min=0"`
+	DueAfter      *string           `json:"due_after,omitempty" form:"due_after" validate:"omitempty,datetime=2006-01-02T15:04:05Z07:00"`
+	DueBefore     *string           `json:"due_before,omitempty" form:"due_before" validate:"omitempty,datetime=2006-01-02T15:04:05Z07:00"`
+	CreatedAfter  *string           `json:"created_after,omitempty" form:"created_after" validate:"omitempty,datetime=2006-01-02T15:04:05Z07:00"`
+	CreatedBefore *string           `json:"created_before,omitempty" form:"created_before" validate:"omitempty,datetime=2006-01-02T15:04:05Z07:00"`
+	Query         string            `json:"q,omitempty" form:"q" validate:"omitempty,max=200"`
+	CustomFields  map[string]string `json:"custom_fields,omitempty" form:"-" validate:"omitempty,max=20"`
+	Sort          string            `json:"sort,omitempty" form:"sort" validate:"omitempty,oneof=created_at -created_at priority -priority estimate -estimate due_date -due_date title -title number -number"`
+	Limit         int               `json:"limit,omitempty" form:"limit" validate:"omitempty,min=1,max=200"`
+	Cursor        string            `json:"cursor,omitempty" form:"cursor" validate:"omitempty,max=512"`
 }
@@ -0,0 +1,6 @@
+package requests
+
+type TaskLinkCreateRequest struct {
+	TaskID string `json:"task_id" validate:"required,uuid4"`
+	Type   string `json:"type" validate:"required,task_link_type"`
+}
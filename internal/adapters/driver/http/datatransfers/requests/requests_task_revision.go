@@ -0,0 +1,6 @@
+package requests
+
+type TaskRevisionCompareRequest struct {
+	From int `form:"from" validate:"required,min=1"`
+	To   int `form:"to" validate:"required,min=1"`
+}
@@ -1,14 +1,16 @@
 package requests
 
 type CreateTeamRequest struct {
-	Name      string `json:"name" validate:"required,min=3,max=26,notblank"`
-	Role      string `json:"role" validate:"required,oneof=admin write read"`
-	ProjectID string `json:"project_id" validate:"required,uuid4"`
+	Name      string  `json:"name" validate:"required,min=3,max=26,notblank"`
+	Role      string  `json:"role" validate:"required_without=RoleID,omitempty,oneof=admin write read"`
+	RoleID    *string `json:"role_id,omitempty" validate:"omitempty,uuid4"`
+	ProjectID string  `json:"project_id" validate:"required,uuid4"`
 }
 
 type UpdateTeamRequest struct {
-	Role *string `json:"role,omitempty" validate:"omitempty,oneof=admin write read"`
-	Name *string `json:"name,omitempty" validate:"omitempty,min=3,max=26,notblank"`
+	Role   *string `json:"role,omitempty" validate:"omitempty,oneof=admin write read"`
+	RoleID *string `json:"role_id,omitempty" validate:"omitempty,uuid4|eq="`
+	Name   *string `json:"name,omitempty" validate:"omitempty,min=3,max=26,notblank"`
 }
 
 type AddTeamMemberRequest struct {
@@ -0,0 +1,20 @@
+package requests
+
+type CreateWebhookRequest struct {
+	URL    string   `json:"url" validate:"required,http_url,max=2048"`
+	Secret string   `json:"secret,omitempty" validate:"omitempty,min=16,max=255"`
+	Events []string `json:"events" validate:"required,min=1,max=100,unique,dive,required,max=100"`
+	Active *bool    `json:"active,omitempty"`
+}
+
+type UpdateWebhookRequest struct {
+	URL    *string  `json:"url,omitempty" validate:"omitempty,http_url,max=2048"`
+	Secret *string  `json:"secret,omitempty" validate:"omitempty,min=16,max=255"`
+	Events []string `json:"events,omitempty" validate:"omitempty,min=1,max=100,unique,dive,required,max=100"`
+	Active *bool    `json:"active,omitempty"`
+}
+
+type WebhookDeliveryListRequest struct {
+	Limit  int    `form:"limit" validate:"omitempty,min=1,max=100"`
+	Cursor string `form:"cursor" validate:"omitempty,max=512"`
+}
@@ -0,0 +1,66 @@
+package responses
+
+import (
+	"time"
+
+	"github.com/fatihsen-dev/kanban-backend/internal/core/domain"
+)
+
+type FieldChangeResponse struct {
+	Before interface{} `json:"before"`
+	After  interface{} `json:"after"`
+}
+
+type ActivityResponse struct {
+	ID         string                         `json:"id"`
+	ProjectID  string                         `json:"project_id"`
+	ActorID    *string                        `json:"actor_id"`
+	EntityType string                         `json:"entity_type"`
+	EntityID   string                         `json:"entity_id"`
+	Action     string                         `json:"action"`
+	Changes    map[string]FieldChangeResponse `json:"changes"`
+	CreatedAt  string                         `json:"created_at"`
+}
+
+type ActivityPageResponse struct {
+	Activities []ActivityResponse `json:"activities"`
+	NextCursor *string            `json:"next_cursor"`
+}
+
+func NewFieldChangesResponse(changes map[string]domain.FieldChange) map[string]FieldChangeResponse {
+	response := make(map[string]FieldChangeResponse, len(changes))
+	for field, change := range changes {
+		response[field] = FieldChangeResponse{Before: change.Before, After: change.After}
+	}
+	return response
+}
+
+func NewActivityResponse(activity *domain.Activity) ActivityResponse {
+	return ActivityResponse{
+		ID:         activity.ID,
+		ProjectID:  activity.ProjectID,
+		ActorID:    activity.ActorID,
+		EntityType: string(activity.EntityType),
+		EntityID:   activity.EntityID,
+		Action:     string(activity.Action),
+		Changes:    NewFieldChangesResponse(activity.Changes),
+		CreatedAt:  activity.CreatedAt.Format(time.RFC3339),
+	}
+}
+
+func NewActivityPageResponse(page *domain.ActivityPage) ActivityPageResponse {
+	activityResponses := make([]ActivityResponse, len(page.Activities))
+	for i, activity := range page.Activities {
+		activityResponses[i] = NewActivityResponse(activity)
+	}
+
+	var nextCursor *string
+	if page.NextCursor != "" {
+		nextCursor = &page.NextCursor
+	}
+
+	return ActivityPageResponse{
+		Activities: activityResponses,
+		NextCursor: nextCursor,
+	}
+}
@@ -0,0 +1,75 @@
+package responses
+
+import (
+	"time"
+
+	"github.com/fatihsen-dev/kanban-backend/internal/core/domain"
+)
+
+type AutomationConditionResponse struct {
+	Type  string `json:"type"`
+	Value string `json:"value,omitempty"`
+}
+
+type AutomationActionResponse struct {
+	Type  string `json:"type"`
+	Value string `json:"value,omitempty"`
+}
+
+type AutomationRuleResponse struct {
+	ID         string                        `json:"id"`
+	ProjectID  string                        `json:"project_id"`
+	Name       string                        `json:"name"`
+	Enabled    bool                          `json:"enabled"`
+	Trigger    string                        `json:"trigger"`
+	Conditions []AutomationConditionResponse `json:"conditions"`
+	Actions    []AutomationActionResponse    `json:"actions"`
+	CreatedAt  string                        `json:"created_at"`
+}
+
+type AutomationRuleDeleteResponse struct {
+	ID string `json:"id"`
+}
+
+type AutomationExecutionResponse struct {
+	ID        string  `json:"id"`
+	RuleID    string  `json:"rule_id"`
+	TaskID    *string `json:"task_id"`
+	Status    string  `json:"status"`
+	Message   *string `json:"message"`
+	CreatedAt string  `json:"created_at"`
+}
+
+func NewAutomationRuleResponse(rule *domain.AutomationRule) AutomationRuleResponse {
+	conditions := make([]AutomationConditionResponse, len(rule.Conditions))
+	for i, condition := range rule.Conditions {
+		conditions[i] = AutomationConditionResponse{Type: string(condition.Type), Value: condition.Value}
+	}
+
+	actions := make([]AutomationActionResponse, len(rule.Actions))
+	for i, action := range rule.Actions {
+		actions[i] = AutomationActionResponse{Type: string(action.Type), Value: action.Value}
+	}
+
+	return AutomationRuleResponse{
+		ID:         rule.ID,
+		ProjectID:  rule.ProjectID,
+		Name:       rule.Name,
+		Enabled:    rule.Enabled,
+		Trigger:    string(rule.Trigger),
+		Conditions: conditions,
+		Actions:    actions,
+		CreatedAt:  rule.CreatedAt.Format(time.RFC3339),
+	}
+}
+
+func NewAutomationExecutionResponse(execution *domain.AutomationExecution) AutomationExecutionResponse {
+	return AutomationExecutionResponse{
+		ID:        execution.ID,
+		RuleID:    execution.RuleID,
+		TaskID:    execution.TaskID,
+		Status:    string(execution.Status),
+		Message:   execution.Message,
+		CreatedAt: execution.CreatedAt.Format(time.RFC3339),
+	}
+}
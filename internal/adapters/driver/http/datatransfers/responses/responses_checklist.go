@@ -0,0 +1,67 @@
+package responses
+
+import (
+	"time"
+
+	"github.com/fatihsen-dev/kanban-backend/internal/core/domain"
+)
+
+type ChecklistItemResponse struct {
+	ID         string  `json:"id"`
+	TaskID     string  `json:"task_id"`
+	Text       string  `json:"text"`
+	Done       bool    `json:"done"`
+	AssigneeID *string `json:"assignee_id"`
+	DueDate    *string `json:"due_date"`
+	Position   int     `json:"position"`
+	CreatedAt  string  `json:"created_at"`
+}
+
+type ChecklistProgressResponse struct {
+	Done  int `json:"done"`
+	Total int `json:"total"`
+}
+
+type ChecklistItemChangeResponse struct {
+	Item     ChecklistItemResponse     `json:"item"`
+	Progress ChecklistProgressResponse `json:"progress"`
+}
+
+type ChecklistItemDeleteResponse struct {
+	ID       string                    `json:"id"`
+	TaskID   string                    `json:"task_id"`
+	Progress ChecklistProgressResponse `json:"progress"`
+}
+
+type ChecklistReorderResponse struct {
+	TaskID string                  `json:"task_id"`
+	Items  []ChecklistItemResponse `json:"items"`
+}
+
+func NewChecklistItemResponse(item *domain.ChecklistItem) ChecklistItemResponse {
+	return ChecklistItemResponse{
+		ID:         item.ID,
+		TaskID:     item.TaskID,
+		Text:       item.Text,
+		Done:       item.Done,
+		AssigneeID: item.AssigneeID,
+		DueDate:    formatOptionalTime(item.DueDate),
+		Position:   item.Position,
+		CreatedAt:  item.CreatedAt.Format(time.RFC3339),
+	}
+}
+
+func NewChecklistProgressResponse(progress domain.ChecklistProgress) ChecklistProgressResponse {
+	return ChecklistProgressResponse{
+		Done:  progress.Done,
+		Total: progress.Total,
+	}
+}
+
+func NewChecklistItemResponses(items []*domain.ChecklistItem) []ChecklistItemResponse {
+	responseData := make([]ChecklistItemResponse, len(items))
+	for i, item := range items {
+		responseData[i] = NewChecklistItemResponse(item)
+	}
+	return responseData
+}
@@ -1,27 +1,105 @@
 package responses
 
+import (
+	"time"
+
+	"github.com/fatihsen-dev/kanban-backend/internal/core/domain"
+)
+
 type ColumnResponse struct {
-	ID        string  `json:"id"`
-	Name      string  `json:"name"`
-	Color     *string `json:"color"`
-	ProjectID string  `json:"project_id"`
-	CreatedAt string  `json:"created_at"`
+	ID           string  `json:"id"`
+	Name         string  `json:"name"`
+	Color        *string `json:"color"`
+	ProjectID    string  `json:"project_id"`
+	WipLimit     *int    `json:"wip_limit"`
+	Category     string  `json:"category"`
+	TaskCount    int     `json:"task_count"`
+	OverWipLimit bool    `json:"over_wip_limit"`
+	ArchivedAt   *string `json:"archived_at,omitempty"`
+	DeletedAt    *string `json:"deleted_at,omitempty"`
+	CreatedAt    string  `json:"created_at"`
 }
 
 type ColumnUpdateResponse struct {
-	ID    string  `json:"id"`
-	Name  string  `json:"name,omitempty"`
-	Color *string `json:"color,omitempty"`
+	ID       string  `json:"id"`
+	Name     string  `json:"name,omitempty"`
+	Color    *string `json:"color,omitempty"`
+	WipLimit *int    `json:"wip_limit,omitempty"`
+	Category string  `json:"category,omitempty"`
 }
 
 type ColumnDeleteResponse struct {
-	ID string `json:"id"`
+	ID             string   `json:"id"`
+	TargetColumnID *string  `json:"target_column_id,omitempty"`
+	MovedTaskIDs   []string `json:"moved_task_ids,omitempty"`
 }
 
 type ColumnWithDetailsResponse struct {
-	ID        string         `json:"id"`
-	Name      string         `json:"name"`
-	Color     *string        `json:"color"`
-	CreatedAt string         `json:"created_at"`
-	Tasks     []TaskResponse `json:"tasks"`
+	ID            string                    `json:"id"`
+	Name          string                    `json:"name"`
+	Color         *string                   `json:"color"`
+	WipLimit      *int                      `json:"wip_limit"`
+	Category      string                    `json:"category"`
+	TaskCount     int                       `json:"task_count"`
+	OverWipLimit  bool                      `json:"over_wip_limit"`
+	EstimateTotal float64                   `json:"estimate_total"`
+	CreatedAt     string                    `json:"created_at"`
+	Tasks         []TaskResponse            `json:"tasks"`
+	Lanes         map[string][]TaskResponse `json:"lanes,omitempty"`
+}
+
+type ColumnLoadResponse struct {
+	ColumnID     string `json:"column_id"`
+	TaskCount    int    `json:"task_count"`
+	WipLimit     *int   `json:"wip_limit"`
+	OverWipLimit bool   `json:"over_wip_limit"`
+}
+
+func NewColumnLoadResponse(load *domain.ColumnLoad) *ColumnLoadResponse {
+	if load == nil {
+		return nil
+	}
+
+	return &ColumnLoadResponse{
+		ColumnID:     load.ColumnID,
+		TaskCount:    load.TaskCount,
+		WipLimit:     load.WipLimit,
+		OverWipLimit: load.OverLimit(),
+	}
+}
+
+func NewColumnResponse(column *domain.Column) ColumnResponse {
+	return ColumnResponse{
+		ID:           column.ID,
+		Name:         column.Name,
+		Color:        column.Color,
+		ProjectID:    column.ProjectID,
+		WipLimit:     column.WipLimit,
+		Category:     string(column.Category),
+		TaskCount:    column.TaskCount,
+		OverWipLimit: column.Load().OverLimit(),
+		ArchivedAt:   formatOptionalTime(column.ArchivedAt),
+		DeletedAt:    formatOptionalTime(column.DeletedAt),
+		CreatedAt:    column.CreatedAt.Format(time.RFC3339),
+	}
+}
+
+func NewColumnWithDetailsResponse(column *domain.Column, tasks []*domain.Task) ColumnWithDetailsResponse {
+	taskResponses := make([]TaskResponse, len(tasks))
+	for i, task := range tasks {
+		taskResponses[i] = NewTaskResponse(task)
+	}
+
+	return ColumnWithDetailsResponse{
+		ID:            column.ID,
+		Name:          column.Name,
+		Color:         column.Color,
+		WipLimit:      column.WipLimit,
+		Category:      string(column.Category),
+		TaskCount:     column.TaskCount,
+		OverWipLimit:  column.Load().OverLimit(),
+		EstimateTotal: domain.TotalEstimate(tasks),
+		CreatedAt:     column.CreatedAt.Format(time.RFC3339),
+		Tasks:         taskResponses,
+	}
 }
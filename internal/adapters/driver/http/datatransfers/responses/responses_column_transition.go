@@ -0,0 +1,57 @@
+package responses
+
+import (
+	"time"
+
+	"github.com/fatihsen-dev/kanban-backend/internal/core/domain"
+)
+
+type ColumnTransitionResponse struct {
+	ID           string   `json:"id"`
+	ProjectID    string   `json:"project_id"`
+	FromColumnID string   `json:"from_column_id"`
+	ToColumnID   string   `json:"to_column_id"`
+	Conditions   []string `json:"conditions"`
+	CreatedAt    string   `json:"created_at"`
+}
+
+type ColumnTransitionDeleteResponse struct {
+	ID string `json:"id"`
+}
+
+type TransitionErrorResponse struct {
+	FromColumnID     string   `json:"from_column_id"`
+	ToColumnID       string   `json:"to_column_id"`
+	Allowed          bool     `json:"allowed"`
+	AllowedColumnIDs []string `json:"allowed_column_ids"`
+	UnmetConditions  []string `json:"unmet_conditions"`
+}
+
+func NewColumnTransitionResponse(transition *domain.ColumnTransition) ColumnTransitionResponse {
+	return ColumnTransitionResponse{
+		ID:           transition.ID,
+		ProjectID:    transition.ProjectID,
+		FromColumnID: transition.FromColumnID,
+		ToColumnID:   transition.ToColumnID,
+		Conditions:   fromTransitionConditions(transition.Conditions),
+		CreatedAt:    transition.CreatedAt.Format(time.RFC3339),
+	}
+}
+
+func NewTransitionErrorResponse(transitionErr *domain.TransitionError) TransitionErrorResponse {
+	return TransitionErrorResponse{
+		FromColumnID:     transitionErr.FromColumnID,
+		ToColumnID:       transitionErr.ToColumnID,
+		Allowed:          transitionErr.Allowed,
+		AllowedColumnIDs: transitionErr.AllowedColumnIDs,
+		UnmetConditions:  fromTransitionConditions(transitionErr.UnmetConditions),
+	}
+}
+
+func fromTransitionConditions(conditions []domain.TransitionCondition) []string {
+	values := make([]string, len(conditions))
+	for i, condition := range conditions {
+		values[i] = string(condition)
+	}
+	return values
+}
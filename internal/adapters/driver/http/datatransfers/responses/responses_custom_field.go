@@ -0,0 +1,46 @@
+package responses
+
+import (
+	"time"
+
+	"github.com/fatihsen-dev/kanban-backend/internal/core/domain"
+)
+
+type CustomFieldResponse struct {
+	ID        string   `json:"id"`
+	ProjectID string   `json:"project_id"`
+	Name      string   `json:"name"`
+	Type      string   `json:"type"`
+	Required  bool     `json:"required"`
+	Options   []string `json:"options"`
+	MinValue  *float64 `json:"min_value"`
+	MaxValue  *float64 `json:"max_value"`
+	MaxLength *int     `json:"max_length"`
+	Position  int      `json:"position"`
+	CreatedAt string   `json:"created_at"`
+}
+
+type CustomFieldDeleteResponse struct {
+	ID string `json:"id"`
+}
+
+func NewCustomFieldResponse(field *domain.CustomField) CustomFieldResponse {
+	options := field.Options
+	if options == nil {
+		options = []string{}
+	}
+
+	return CustomFieldResponse{
+		ID:        field.ID,
+		ProjectID: field.ProjectID,
+		Name:      field.Name,
+		Type:      string(field.Type),
+		Required:  field.Required,
+		Options:   options,
+		MinValue:  field.MinValue,
+		MaxValue:  field.MaxValue,
+		MaxLength: field.MaxLength,
+		Position:  field.Position,
+		CreatedAt: field.CreatedAt.Format(time.RFC3339),
+	}
+}
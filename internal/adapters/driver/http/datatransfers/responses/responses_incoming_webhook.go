@@ -0,0 +1,64 @@
+package responses
+
+import (
+	"time"
+
+	"github.com/fatihsen-dev/kanban-backend/internal/core/domain"
+)
+
+type IncomingWebhookResponse struct {
+	ProjectID      string  `json:"project_id"`
+	TargetColumnID *string `json:"target_column_id"`
+	Secret         string  `json:"secret,omitempty"`
+	CreatedAt      string  `json:"created_at"`
+}
+
+type IncomingWebhookDeleteResponse struct {
+	ProjectID string `json:"project_id"`
+}
+
+type PushReferenceResponse struct {
+	Ref      string  `json:"ref"`
+	CommitID string  `json:"commit_id"`
+	TaskID   *string `json:"task_id"`
+	TaskKey  *string `json:"task_key"`
+	Closes   bool    `json:"closes"`
+	Moved    bool    `json:"moved"`
+	Error    *string `json:"error"`
+}
+
+type PushResponse struct {
+	References []PushReferenceResponse `json:"references"`
+}
+
+func NewIncomingWebhookResponse(webhook *domain.IncomingWebhook) IncomingWebhookResponse {
+	return IncomingWebhookResponse{
+		ProjectID:      webhook.ProjectID,
+		TargetColumnID: webhook.TargetColumnID,
+		CreatedAt:      webhook.CreatedAt.Format(time.RFC3339),
+	}
+}
+
+func NewPushResponse(results []*domain.PushTaskResult) PushResponse {
+	references := make([]PushReferenceResponse, len(results))
+	for i, result := range results {
+		references[i] = PushReferenceResponse{
+			Ref:      result.Ref,
+			CommitID: result.CommitID,
+			Closes:   result.Closes,
+			Moved:    result.Moved,
+		}
+
+		if result.Task != nil {
+			taskKey := result.Task.Key()
+			references[i].TaskID = &result.Task.ID
+			references[i].TaskKey = &taskKey
+		}
+
+		if result.Error != nil {
+			message := result.Error.Error()
+			references[i].Error = &message
+		}
+	}
+	return PushResponse{References: references}
+}
@@ -1,5 +1,11 @@
 package responses
 
+import (
+	"time"
+
+	"github.com/fatihsen-dev/kanban-backend/internal/core/domain"
+)
+
 type InvitationResponse struct {
 	ID        string          `json:"id"`
 	Inviter   UserResponse    `json:"inviter"`
@@ -9,3 +15,27 @@ type InvitationResponse struct {
 	Status    string          `json:"status"`
 	CreatedAt string          `json:"created_at"`
 }
+
+func NewInvitationResponse(invitation *domain.Invitation, invitee *domain.User, inviter *domain.User, project *domain.Project) InvitationResponse {
+	return InvitationResponse{
+		ID: invitation.ID,
+		Inviter: UserResponse{
+			ID:        inviter.ID,
+			Name:      inviter.Name,
+			Email:     inviter.Email,
+			IsAdmin:   inviter.IsAdmin,
+			CreatedAt: inviter.CreatedAt.Format(time.RFC3339),
+		},
+		Invitee: UserResponse{
+			ID:        invitee.ID,
+			Name:      invitee.Name,
+			Email:     invitee.Email,
+			IsAdmin:   invitee.IsAdmin,
+			CreatedAt: invitee.CreatedAt.Format(time.RFC3339),
+		},
+		Project:   NewProjectResponse(project),
+		Message:   invitation.Message,
+		Status:    string(invitation.Status),
+		CreatedAt: invitation.CreatedAt.Format(time.RFC3339),
+	}
+}
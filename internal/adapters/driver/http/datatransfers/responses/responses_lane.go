@@ -0,0 +1,29 @@
+package responses
+
+import (
+	"time"
+
+	"github.com/fatihsen-dev/kanban-backend/internal/core/domain"
+)
+
+type LaneResponse struct {
+	ID        string `json:"id"`
+	Name      string `json:"name"`
+	ProjectID string `json:"project_id"`
+	Position  int    `json:"position"`
+	CreatedAt string `json:"created_at"`
+}
+
+type LaneDeleteResponse struct {
+	ID string `json:"id"`
+}
+
+func NewLaneResponse(lane *domain.Lane) LaneResponse {
+	return LaneResponse{
+		ID:        lane.ID,
+		Name:      lane.Name,
+		ProjectID: lane.ProjectID,
+		Position:  lane.Position,
+		CreatedAt: lane.CreatedAt.Format(time.RFC3339),
+	}
+}
@@ -0,0 +1,89 @@
+package responses
+
+import (
+	"time"
+
+	"github.com/fatihsen-dev/kanban-backend/internal/core/domain"
+)
+
+type NotificationResponse struct {
+	ID         string  `json:"id"`
+	Type       string  `json:"type"`
+	ActorID    *string `json:"actor_id"`
+	ProjectID  string  `json:"project_id"`
+	EntityType string  `json:"entity_type"`
+	EntityID   string  `json:"entity_id"`
+	Message    string  `json:"message"`
+	Read       bool    `json:"read"`
+	ReadAt     *string `json:"read_at"`
+	CreatedAt  string  `json:"created_at"`
+}
+
+type NotificationPageResponse struct {
+	Notifications []NotificationResponse `json:"notifications"`
+	UnreadCount   int                    `json:"unread_count"`
+	NextCursor    *string                `json:"next_cursor"`
+}
+
+type NotificationPushResponse struct {
+	Notification NotificationResponse `json:"notification"`
+	UnreadCount  int                  `json:"unread_count"`
+}
+
+type NotificationUnreadCountResponse struct {
+	UnreadCount int `json:"unread_count"`
+}
+
+type NotificationMarkReadResponse struct {
+	IDs         []string `json:"ids"`
+	UnreadCount int      `json:"unread_count"`
+}
+
+func NewNotificationResponse(notification *domain.Notification) NotificationResponse {
+	return NotificationResponse{
+		ID:         notification.ID,
+		Type:       string(notification.Type),
+		ActorID:    notification.ActorID,
+		ProjectID:  notification.ProjectID,
+		EntityType: string(notification.EntityType),
+		EntityID:   notification.EntityID,
+		Message:    notification.Message,
+		Read:       notification.IsRead(),
+		ReadAt:     formatOptionalTime(notification.ReadAt),
+		CreatedAt:  notification.CreatedAt.Format(time.RFC3339),
+	}
+}
+
+func NewNotificationPageResponse(page *domain.NotificationPage) NotificationPageResponse {
+	notificationResponses := make([]NotificationResponse, len(page.Notifications))
+	for i, notification := range page.Notifications {
+		notificationResponses[i] = NewNotificationResponse(notification)
+	}
+
+	var nextCursor *string
+	if page.NextCursor != "" {
+		nextCursor = &page.NextCursor
+	}
+
+	return NotificationPageResponse{
+		Notifications: notificationResponses,
+		UnreadCount:   page.UnreadCount,
+		NextCursor:    nextCursor,
+	}
+}
+
+type NotificationPreferencesResponse struct {
+	Preferences map[string]string `json:"preferences"`
+}
+
+type NotificationUnsubscribeResponse struct {
+	Type string `json:"type"`
+}
+
+func NewNotificationPreferencesResponse(preferences domain.NotificationPreferences) NotificationPreferencesResponse {
+	preferenceResponses := make(map[string]string, len(preferences))
+	for notificationType, delivery := range preferences {
+		preferenceResponses[string(notificationType)] = string(delivery)
+	}
+	return NotificationPreferencesResponse{Preferences: preferenceResponses}
+}
@@ -0,0 +1,47 @@
+package responses
+
+type OrganizationResponse struct {
+	ID        string `json:"id"`
+	Name      string `json:"name"`
+	OwnerID   string `json:"owner_id"`
+	CreatedAt string `json:"created_at"`
+}
+
+type OrganizationMemberResponse struct {
+	ID             string       `json:"id"`
+	OrganizationID string       `json:"organization_id"`
+	UserID         string       `json:"user_id"`
+	Role           string       `json:"role"`
+	CreatedAt      string       `json:"created_at"`
+	User           UserResponse `json:"user"`
+}
+
+type OrganizationTeamProjectResponse struct {
+	TeamID    string `json:"team_id"`
+	ProjectID string `json:"project_id"`
+	Role      string `json:"role"`
+	CreatedAt string `json:"created_at"`
+}
+
+type OrganizationTeamResponse struct {
+	ID             string                            `json:"id"`
+	Name           string                            `json:"name"`
+	OrganizationID string                            `json:"organization_id"`
+	MemberIDs      []string                          `json:"member_ids"`
+	Projects       []OrganizationTeamProjectResponse `json:"projects"`
+	CreatedAt      string                            `json:"created_at"`
+}
+
+type OrganizationWithDetailsResponse struct {
+	ID        string                       `json:"id"`
+	Name      string                       `json:"name"`
+	OwnerID   string                       `json:"owner_id"`
+	CreatedAt string                       `json:"created_at"`
+	Members   []OrganizationMemberResponse `json:"members"`
+	Teams     []OrganizationTeamResponse   `json:"teams"`
+}
+
+type AddOrganizationTeamMembersResponse struct {
+	TeamID  string   `json:"team_id"`
+	UserIDs []string `json:"user_ids"`
+}
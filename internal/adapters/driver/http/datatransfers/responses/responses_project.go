@@ -1,18 +1,52 @@
 package responses
 
+import (
+	"time"
+
+	"github.com/fatihsen-dev/kanban-backend/internal/core/domain"
+)
+
 type ProjectResponse struct {
-	ID        string `json:"id"`
-	Name      string `json:"name"`
-	OwnerID   string `json:"owner_id"`
-	CreatedAt string `json:"created_at"`
+	ID                 string  `json:"id"`
+	Name               string  `json:"name"`
+	Key                string  `json:"key"`
+	OwnerID            string  `json:"owner_id"`
+	OrganizationID     *string `json:"organization_id"`
+	WipEnforcement     string  `json:"wip_enforcement"`
+	LaneGrouping       string  `json:"lane_grouping"`
+	BlockerEnforcement string  `json:"blocker_enforcement"`
+	CreatedAt          string  `json:"created_at"`
 }
 
 type ProjectWithDetailsResponse struct {
-	ID        string                          `json:"id"`
-	Name      string                          `json:"name"`
-	OwnerID   string                          `json:"owner_id"`
-	CreatedAt string                          `json:"created_at"`
-	Columns   []ColumnWithDetailsResponse     `json:"columns"`
-	Teams     []TeamWithMembersResponse       `json:"teams"`
-	Members   []ProjectMemberWithUserResponse `json:"members"`
+	ID                 string                          `json:"id"`
+	Name               string                          `json:"name"`
+	Key                string                          `json:"key"`
+	OwnerID            string                          `json:"owner_id"`
+	OrganizationID     *string                         `json:"organization_id"`
+	WipEnforcement     string                          `json:"wip_enforcement"`
+	LaneGrouping       string                          `json:"lane_grouping"`
+	BlockerEnforcement string                          `json:"blocker_enforcement"`
+	Lanes              []LaneResponse                  `json:"lanes"`
+	CreatedAt          string                          `json:"created_at"`
+	Columns            []ColumnWithDetailsResponse     `json:"columns"`
+	Teams              []TeamWithMembersResponse       `json:"teams"`
+	Members            []ProjectMemberWithUserResponse `json:"members"`
+	Roles              []ProjectRoleResponse           `json:"roles"`
+	Transitions        []ColumnTransitionResponse      `json:"transitions"`
+	View               *SavedViewResponse              `json:"view,omitempty"`
+}
+
+func NewProjectResponse(project *domain.Project) ProjectResponse {
+	return ProjectResponse{
+		ID:                 project.ID,
+		Name:               project.Name,
+		Key:                project.Key,
+		OwnerID:            project.OwnerID,
+		OrganizationID:     project.OrganizationID,
+		WipEnforcement:     string(project.WipEnforcement),
+		LaneGrouping:       string(project.LaneGrouping),
+		BlockerEnforcement: string(project.BlockerEnforcement),
+		CreatedAt:          project.CreatedAt.Format(time.RFC3339),
+	}
 }
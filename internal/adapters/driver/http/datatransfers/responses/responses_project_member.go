@@ -5,6 +5,7 @@ type ProjectMemberResponse struct {
 	ProjectID string  `json:"project_id"`
 	UserID    string  `json:"user_id"`
 	Role      string  `json:"role"`
+	RoleID    *string `json:"role_id"`
 	TeamID    *string `json:"team_id"`
 	CreatedAt string  `json:"created_at"`
 }
@@ -14,6 +15,7 @@ type ProjectMemberWithUserResponse struct {
 	ProjectID string       `json:"project_id"`
 	UserID    string       `json:"user_id"`
 	Role      string       `json:"role"`
+	RoleID    *string      `json:"role_id"`
 	TeamID    *string      `json:"team_id"`
 	CreatedAt string       `json:"created_at"`
 	User      UserResponse `json:"user"`
@@ -24,9 +26,10 @@ type DeleteProjectMemberResponse struct {
 }
 
 type UpdateProjectMemberResponse struct {
-	ID     string `json:"id"`
-	Role   string `json:"role,omitempty"`
-	TeamID string `json:"team_id"`
+	ID     string  `json:"id"`
+	Role   string  `json:"role,omitempty"`
+	RoleID *string `json:"role_id,omitempty"`
+	TeamID string  `json:"team_id"`
 }
 
 type OnlineProjectMembersResponse struct {
@@ -0,0 +1,40 @@
+package responses
+
+import (
+	"time"
+
+	"github.com/fatihsen-dev/kanban-backend/internal/core/domain"
+)
+
+type ProjectRoleResponse struct {
+	ID          string   `json:"id"`
+	Name        string   `json:"name"`
+	ProjectID   string   `json:"project_id"`
+	Permissions []string `json:"permissions"`
+	CreatedAt   string   `json:"created_at"`
+}
+
+type UpdateProjectRoleResponse struct {
+	ID          string   `json:"id"`
+	Name        string   `json:"name,omitempty"`
+	Permissions []string `json:"permissions,omitempty"`
+}
+
+type DeleteProjectRoleResponse struct {
+	ID string `json:"id"`
+}
+
+func NewProjectRoleResponse(role *domain.ProjectRole) ProjectRoleResponse {
+	permissions := make([]string, len(role.Permissions))
+	for i, permission := range role.Permissions {
+		permissions[i] = string(permission)
+	}
+
+	return ProjectRoleResponse{
+		ID:          role.ID,
+		Name:        role.Name,
+		ProjectID:   role.ProjectID,
+		Permissions: permissions,
+		CreatedAt:   role.CreatedAt.Format(time.RFC3339),
+	}
+}
@@ -0,0 +1,95 @@
+package responses
+
+import (
+	"time"
+
+	"github.com/fatihsen-dev/kanban-backend/internal/core/domain"
+)
+
+type TaskFilterResponse struct {
+	State         string            `json:"state,omitempty"`
+	ColumnID      []string          `json:"column_id,omitempty"`
+	AssigneeID    []string          `json:"assignee_id,omitempty"`
+	Label         []string          `json:"label,omitempty"`
+	Priority      []string          `json:"priority,omitempty"`
+	MinEstimate   *float64          `json:"min_estimate,omitempty"`
+	MaxEstimate   *float64          `json:"max_estimate,omitempty"`
+	DueAfter      *string           `json:"due_after,omitempty"`
+	DueBefore     *string           `json:"due_before,omitempty"`
+	CreatedAfter  *string           `json:"created_after,omitempty"`
+	CreatedBefore *string           `json:"created_before,omitempty"`
+	Query         string            `json:"q,omitempty"`
+	CustomFields  map[string]string `json:"custom_fields,omitempty"`
+	Sort          string            `json:"sort,omitempty"`
+}
+
+type SavedViewResponse struct {
+	ID            string             `json:"id"`
+	ProjectID     string             `json:"project_id"`
+	OwnerID       string             `json:"owner_id"`
+	Name          string             `json:"name"`
+	Visibility    string             `json:"visibility"`
+	Filter        TaskFilterResponse `json:"filter"`
+	Grouping      string             `json:"grouping"`
+	VisibleFields []string           `json:"visible_fields"`
+	CreatedAt     string             `json:"created_at"`
+	UpdatedAt     string             `json:"updated_at"`
+}
+
+type SavedViewDeleteResponse struct {
+	ID string `json:"id"`
+}
+
+func NewTaskFilterResponse(filter *domain.TaskFilter) TaskFilterResponse {
+	response := TaskFilterResponse{
+		State:         string(filter.State),
+		ColumnID:      filter.ColumnIDs,
+		AssigneeID:    filter.AssigneeIDs,
+		Label:         filter.Labels,
+		MinEstimate:   filter.MinEstimate,
+		MaxEstimate:   filter.MaxEstimate,
+		DueAfter:      formatOptionalTime(filter.DueAfter),
+		DueBefore:     formatOptionalTime(filter.DueBefore),
+		CreatedAfter:  formatOptionalTime(filter.CreatedAfter),
+		CreatedBefore: formatOptionalTime(filter.CreatedBefore),
+		Query:         filter.Text,
+		CustomFields:  filter.CustomFields,
+	}
+
+	if filter.Unassigned {
+		response.AssigneeID = append(response.AssigneeID, "none")
+	}
+
+	for _, priority := range filter.Priorities {
+		response.Priority = append(response.Priority, string(priority))
+	}
+
+	if filter.SortBy != "" {
+		response.Sort = string(filter.SortBy)
+		if filter.SortDesc {
+			response.Sort = "-" + response.Sort
+		}
+	}
+
+	return response
+}
+
+func NewSavedViewResponse(view *domain.SavedView) SavedViewResponse {
+	visibleFields := view.VisibleFields
+	if visibleFields == nil {
+		visibleFields = []string{}
+	}
+
+	return SavedViewResponse{
+		ID:            view.ID,
+		ProjectID:     view.ProjectID,
+		OwnerID:       view.OwnerID,
+		Name:          view.Name,
+		Visibility:    string(view.Visibility),
+		Filter:        NewTaskFilterResponse(view.Filter),
+		Grouping:      string(view.Grouping),
+		VisibleFields: visibleFields,
+		CreatedAt:     view.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:     view.UpdatedAt.Format(time.RFC3339),
+	}
+}
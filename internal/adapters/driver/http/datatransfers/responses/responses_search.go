@@ -0,0 +1,25 @@
+package responses
+
+import "github.com/fatihsen-dev/kanban-backend/internal/core/domain"
+
+type SearchResultResponse struct {
+	Type      string  `json:"type"`
+	ID        string  `json:"id"`
+	ProjectID string  `json:"project_id"`
+	Key       string  `json:"key"`
+	Title     string  `json:"title"`
+	Snippet   string  `json:"snippet"`
+	Rank      float64 `json:"rank"`
+}
+
+func NewSearchResultResponse(result *domain.SearchResult) SearchResultResponse {
+	return SearchResultResponse{
+		Type:      string(result.Type),
+		ID:        result.ID,
+		ProjectID: result.ProjectID,
+		Key:       result.Key,
+		Title:     result.Title,
+		Snippet:   result.Snippet,
+		Rank:      result.Rank,
+	}
+}
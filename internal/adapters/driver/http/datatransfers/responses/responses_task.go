@@ -1,21 +1,147 @@
 package responses
 
+import (
+	"time"
+
+	"github.com/fatihsen-dev/kanban-backend/internal/core/domain"
+)
+
 type TaskResponse struct {
-	ID        string  `json:"id"`
-	Title     string  `json:"title"`
-	Content   *string `json:"content"`
-	ProjectID string  `json:"project_id"`
-	ColumnID  string  `json:"column_id"`
-	CreatedAt string  `json:"created_at"`
+	ID           string                    `json:"id"`
+	Key          string                    `json:"key"`
+	Number       int                       `json:"number"`
+	Title        string                    `json:"title"`
+	Content      *string                   `json:"content"`
+	ProjectID    string                    `json:"project_id"`
+	ColumnID     string                    `json:"column_id"`
+	LaneID       *string                   `json:"lane_id"`
+	AssigneeID   *string                   `json:"assignee_id"`
+	Labels       []string                  `json:"labels"`
+	Priority     string                    `json:"priority"`
+	Estimate     *float64                  `json:"estimate"`
+	CustomFields map[string]interface{}    `json:"custom_fields"`
+	DueDate      *string                   `json:"due_date"`
+	CompletedAt  *string                   `json:"completed_at"`
+	Checklist    ChecklistProgressResponse `json:"checklist"`
+	Blocked      bool                      `json:"blocked"`
+	OpenBlockers int                       `json:"open_blockers"`
+	Mentions     []MentionResponse         `json:"mentions"`
+	Watchers     []string                  `json:"watchers"`
+	ArchivedAt   *string                   `json:"archived_at,omitempty"`
+	DeletedAt    *string                   `json:"deleted_at,omitempty"`
+	CreatedAt    string                    `json:"created_at"`
+	ColumnLoad   *ColumnLoadResponse       `json:"column_load,omitempty"`
 }
 
 type TaskUpdateResponse struct {
-	ID       string  `json:"id"`
-	Title    string  `json:"title,omitempty"`
-	Content  *string `json:"content,omitempty"`
-	ColumnID string  `json:"column_id,omitempty"`
+	ID           string                 `json:"id"`
+	Title        string                 `json:"title,omitempty"`
+	Content      *string                `json:"content,omitempty"`
+	ColumnID     string                 `json:"column_id,omitempty"`
+	LaneID       *string                `json:"lane_id,omitempty"`
+	AssigneeID   *string                `json:"assignee_id,omitempty"`
+	Labels       []string               `json:"labels,omitempty"`
+	Priority     string                 `json:"priority,omitempty"`
+	Estimate     *float64               `json:"estimate,omitempty"`
+	CustomFields map[string]interface{} `json:"custom_fields,omitempty"`
+	DueDate      *string                `json:"due_date,omitempty"`
+	Mentions     *[]MentionResponse     `json:"mentions,omitempty"`
+	Watchers     []string               `json:"watchers,omitempty"`
+	ColumnLoad   *ColumnLoadResponse    `json:"column_load,omitempty"`
+}
+
+type TaskWatchersResponse struct {
+	TaskID   string   `json:"task_id"`
+	Watchers []string `json:"watchers"`
+}
+
+type MentionResponse struct {
+	Text   string `json:"text"`
+	UserID string `json:"user_id"`
+	Name   string `json:"name"`
+}
+
+type TaskPageResponse struct {
+	Tasks      []TaskResponse `json:"tasks"`
+	NextCursor *string        `json:"next_cursor"`
 }
 
 type TaskDeleteResponse struct {
 	ID string `json:"id"`
 }
+
+func NewTaskResponse(task *domain.Task) TaskResponse {
+	labels := task.Labels
+	if labels == nil {
+		labels = []string{}
+	}
+
+	customFields := task.CustomFields
+	if customFields == nil {
+		customFields = map[string]interface{}{}
+	}
+
+	watchers := task.Watchers
+	if watchers == nil {
+		watchers = []string{}
+	}
+
+	return TaskResponse{
+		ID:           task.ID,
+		Key:          task.Key(),
+		Number:       task.Number,
+		Title:        task.Title,
+		Content:      task.Content,
+		ProjectID:    task.ProjectID,
+		ColumnID:     task.ColumnID,
+		LaneID:       task.LaneID,
+		AssigneeID:   task.AssigneeID,
+		Labels:       labels,
+		Priority:     string(task.Priority),
+		Estimate:     task.Estimate,
+		CustomFields: customFields,
+		DueDate:      formatOptionalTime(task.DueDate),
+		CompletedAt:  formatOptionalTime(task.CompletedAt),
+		Checklist:    NewChecklistProgressResponse(task.Checklist),
+		Blocked:      task.IsBlocked(),
+		OpenBlockers: task.OpenBlockers,
+		Mentions:     NewMentionResponses(task.Mentions),
+		Watchers:     watchers,
+		ArchivedAt:   formatOptionalTime(task.ArchivedAt),
+		DeletedAt:    formatOptionalTime(task.DeletedAt),
+		CreatedAt:    task.CreatedAt.Format(time.RFC3339),
+	}
+}
+
+func NewMentionResponses(mentions []domain.Mention) []MentionResponse {
+	mentionResponses := make([]MentionResponse, len(mentions))
+	for i, mention := range mentions {
+		mentionResponses[i] = MentionResponse{Text: mention.Text, UserID: mention.UserID, Name: mention.Name}
+	}
+	return mentionResponses
+}
+
+func NewTaskPageResponse(page *domain.TaskPage) TaskPageResponse {
+	taskResponses := make([]TaskResponse, len(page.Tasks))
+	for i, task := range page.Tasks {
+		taskResponses[i] = NewTaskResponse(task)
+	}
+
+	var nextCursor *string
+	if page.NextCursor != "" {
+		nextCursor = &page.NextCursor
+	}
+
+	return TaskPageResponse{
+		Tasks:      taskResponses,
+		NextCursor: nextCursor,
+	}
+}
+
+func formatOptionalTime(t *time.Time) *string {
+	if t == nil {
+		return nil
+	}
+	formatted := t.Format(time.RFC3339)
+	return &formatted
+}
@@ -0,0 +1,40 @@
+package responses
+
+import (
+	"time"
+
+	"github.com/fatihsen-dev/kanban-backend/internal/core/domain"
+)
+
+type TaskLinkResponse struct {
+	ID           string `json:"id"`
+	SourceTaskID string `json:"source_task_id"`
+	TargetTaskID string `json:"target_task_id"`
+	Type         string `json:"type"`
+	Relation     string `json:"relation,omitempty"`
+	LinkedTaskID string `json:"linked_task_id,omitempty"`
+	CreatedAt    string `json:"created_at"`
+}
+
+type TaskLinkDeleteResponse struct {
+	ID           string `json:"id"`
+	SourceTaskID string `json:"source_task_id"`
+	TargetTaskID string `json:"target_task_id"`
+}
+
+func NewTaskLinkResponse(link *domain.TaskLink) TaskLinkResponse {
+	return TaskLinkResponse{
+		ID:           link.ID,
+		SourceTaskID: link.SourceTaskID,
+		TargetTaskID: link.TargetTaskID,
+		Type:         string(link.Type),
+		CreatedAt:    link.CreatedAt.Format(time.RFC3339),
+	}
+}
+
+func NewTaskLinkResponseFor(link *domain.TaskLink, taskID string) TaskLinkResponse {
+	response := NewTaskLinkResponse(link)
+	response.Relation = string(link.TypeFor(taskID))
+	response.LinkedTaskID = link.OtherTaskID(taskID)
+	return response
+}
@@ -0,0 +1,59 @@
+package responses
+
+import (
+	"time"
+
+	"github.com/fatihsen-dev/kanban-backend/internal/core/domain"
+)
+
+type TaskRevisionResponse struct {
+	ID        string                         `json:"id"`
+	TaskID    string                         `json:"task_id"`
+	Number    int                            `json:"number"`
+	ActorID   *string                        `json:"actor_id"`
+	State     map[string]interface{}         `json:"state"`
+	Changes   map[string]FieldChangeResponse `json:"changes"`
+	CreatedAt string                         `json:"created_at"`
+}
+
+type TaskRevisionDiffResponse struct {
+	From    int                            `json:"from"`
+	To      int                            `json:"to"`
+	Changes map[string]FieldChangeResponse `json:"changes"`
+}
+
+func NewTaskRevisionResponse(revision *domain.TaskRevision, previous *domain.TaskRevision) TaskRevisionResponse {
+	return TaskRevisionResponse{
+		ID:        revision.ID,
+		TaskID:    revision.TaskID,
+		Number:    revision.Number,
+		ActorID:   revision.ActorID,
+		State:     revision.State.Snapshot(),
+		Changes:   NewFieldChangesResponse(revision.Diff(previous)),
+		CreatedAt: revision.CreatedAt.Format(time.RFC3339),
+	}
+}
+
+func NewTaskUpdateResponse(task *domain.Task) TaskUpdateResponse {
+	response := TaskUpdateResponse{
+		ID:         task.ID,
+		Title:      task.Title,
+		Content:    task.Content,
+		ColumnID:   task.ColumnID,
+		LaneID:     task.LaneID,
+		AssigneeID: task.AssigneeID,
+		Labels:     task.Labels,
+		Priority:   string(task.Priority),
+		Estimate:   task.Estimate,
+	}
+
+	if task.DueDate != nil {
+		dueDate := ""
+		if !task.DueDate.IsZero() {
+			dueDate = task.DueDate.Format(time.RFC3339)
+		}
+		response.DueDate = &dueDate
+	}
+
+	return response
+}
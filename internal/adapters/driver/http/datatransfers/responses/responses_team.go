@@ -1,18 +1,20 @@
 package responses
 
 type TeamResponse struct {
-	ID        string `json:"id"`
-	Name      string `json:"name"`
-	Role      string `json:"role"`
-	ProjectID string `json:"project_id"`
-	CreatedAt string `json:"created_at"`
+	ID        string  `json:"id"`
+	Name      string  `json:"name"`
+	Role      string  `json:"role"`
+	RoleID    *string `json:"role_id"`
+	ProjectID string  `json:"project_id"`
+	CreatedAt string  `json:"created_at"`
 }
 
 type UpdateTeamResponse struct {
-	ID        string `json:"id"`
-	Name      string `json:"name,omitempty"`
-	Role      string `json:"role,omitempty"`
-	ProjectID string `json:"project_id,omitempty"`
+	ID        string  `json:"id"`
+	Name      string  `json:"name,omitempty"`
+	Role      string  `json:"role,omitempty"`
+	RoleID    *string `json:"role_id,omitempty"`
+	ProjectID string  `json:"project_id,omitempty"`
 }
 
 type DeleteTeamResponse struct {
@@ -23,6 +25,7 @@ type TeamWithMembersResponse struct {
 	ID        string                  `json:"id"`
 	Name      string                  `json:"name"`
 	Role      string                  `json:"role"`
+	RoleID    *string                 `json:"role_id"`
 	ProjectID string                  `json:"project_id"`
 	CreatedAt string                  `json:"created_at"`
 	Members   []ProjectMemberResponse `json:"members"`
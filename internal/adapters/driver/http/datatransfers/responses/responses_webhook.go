@@ -0,0 +1,91 @@
+package responses
+
+import (
+	"time"
+
+	"github.com/fatihsen-dev/kanban-backend/internal/core/domain"
+)
+
+type WebhookResponse struct {
+	ID        string   `json:"id"`
+	ProjectID string   `json:"project_id"`
+	URL       string   `json:"url"`
+	Events    []string `json:"events"`
+	Active    bool     `json:"active"`
+	Secret    string   `json:"secret,omitempty"`
+	CreatedAt string   `json:"created_at"`
+}
+
+type WebhookDeleteResponse struct {
+	ID string `json:"id"`
+}
+
+type WebhookDeliveryResponse struct {
+	ID             string  `json:"id"`
+	WebhookID      string  `json:"webhook_id"`
+	Event          string  `json:"event"`
+	Payload        string  `json:"payload"`
+	Status         string  `json:"status"`
+	Attempts       int     `json:"attempts"`
+	ResponseStatus *int    `json:"response_status"`
+	ResponseBody   *string `json:"response_body"`
+	Error          *string `json:"error"`
+	NextAttemptAt  *string `json:"next_attempt_at"`
+	DeliveredAt    *string `json:"delivered_at"`
+	CreatedAt      string  `json:"created_at"`
+}
+
+type WebhookDeliveryPageResponse struct {
+	Deliveries []WebhookDeliveryResponse `json:"deliveries"`
+	NextCursor *string                   `json:"next_cursor"`
+}
+
+func NewWebhookResponse(webhook *domain.Webhook) WebhookResponse {
+	events := webhook.Events
+	if events == nil {
+		events = []string{}
+	}
+
+	return WebhookResponse{
+		ID:        webhook.ID,
+		ProjectID: webhook.ProjectID,
+		URL:       webhook.URL,
+		Events:    events,
+		Active:    webhook.Active,
+		CreatedAt: webhook.CreatedAt.Format(time.RFC3339),
+	}
+}
+
+func NewWebhookDeliveryResponse(delivery *domain.WebhookDelivery) WebhookDeliveryResponse {
+	return WebhookDeliveryResponse{
+		ID:             delivery.ID,
+		WebhookID:      delivery.WebhookID,
+		Event:          delivery.Event,
+		Payload:        string(delivery.Payload),
+		Status:         string(delivery.Status),
+		Attempts:       delivery.Attempts,
+		ResponseStatus: delivery.ResponseStatus,
+		ResponseBody:   delivery.ResponseBody,
+		Error:          delivery.Error,
+		NextAttemptAt:  formatOptionalTime(delivery.NextAttemptAt),
+		DeliveredAt:    formatOptionalTime(delivery.DeliveredAt),
+		CreatedAt:      delivery.CreatedAt.Format(time.RFC3339),
+	}
+}
+
+func NewWebhookDeliveryPageResponse(page *domain.WebhookDeliveryPage) WebhookDeliveryPageResponse {
+	deliveryResponses := make([]WebhookDeliveryResponse, len(page.Deliveries))
+	for i, delivery := range page.Deliveries {
+		deliveryResponses[i] = NewWebhookDeliveryResponse(delivery)
+	}
+
+	var nextCursor *string
+	if page.NextCursor != "" {
+		nextCursor = &page.NextCursor
+	}
+
+	return WebhookDeliveryPageResponse{
+		Deliveries: deliveryResponses,
+		NextCursor: nextCursor,
+	}
+}
@@ -0,0 +1,169 @@
+package http
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+
+	"github.com/fatihsen-dev/kanban-backend/internal/adapters/driver/http/datatransfers"
+	"github.com/fatihsen-dev/kanban-backend/internal/adapters/driver/http/datatransfers/requests"
+	"github.com/fatihsen-dev/kanban-backend/internal/adapters/driver/http/datatransfers/responses"
+	middlewares "github.com/fatihsen-dev/kanban-backend/internal/adapters/driver/http/middleware"
+	"github.com/fatihsen-dev/kanban-backend/internal/adapters/driver/validation"
+	"github.com/fatihsen-dev/kanban-backend/internal/core/domain"
+	ports "github.com/fatihsen-dev/kanban-backend/internal/core/ports/driver"
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+const maxPushPayloadSize = 5 << 20
+
+type incomingWebhookHandler struct {
+	incomingWebhookService ports.IncomingWebhookService
+	authMiddleware         *middlewares.AuthnMiddleware
+	projectAuthzMiddleware *middlewares.ProjectAuthzMiddleware
+}
+
+func NewIncomingWebhookHandler(incomingWebhookService ports.IncomingWebhookService, authMiddleware *middlewares.AuthnMiddleware, projectAuthzMiddleware *middlewares.ProjectAuthzMiddleware) *incomingWebhookHandler {
+	return &incomingWebhookHandler{incomingWebhookService: incomingWebhookService, authMiddleware: authMiddleware, projectAuthzMiddleware: projectAuthzMiddleware}
+}
+
+func (h *incomingWebhookHandler) RegisterIncomingWebhookRouter(r *gin.Engine) {
+	incomingWebhookGroup := r.Group("/projects/:project_id/incoming-webhook")
+
+	incomingWebhookGroup.POST("/push", h.PushHandler)
+
+	incomingWebhookGroup.Use(h.authMiddleware.Handle(false), h.projectAuthzMiddleware.Handle(domain.PermissionProjectManage))
+
+	incomingWebhookGroup.GET("", h.GetIncomingWebhookHandler)
+	incomingWebhookGroup.PUT("", h.UpdateIncomingWebhookHandler)
+	incomingWebhookGroup.DELETE("", h.DeleteIncomingWebhookHandler)
+}
+
+func (h *incomingWebhookHandler) GetIncomingWebhookHandler(c *gin.Context) {
+	webhook, err := h.incomingWebhookService.GetIncomingWebhook(c.Request.Context(), c.Param("project_id"))
+	if err != nil {
+		c.JSON(http.StatusNotFound, datatransfers.ResponseError("Incoming webhook not found"))
+		return
+	}
+
+	c.JSON(http.StatusOK, datatransfers.ResponseSuccess("Incoming webhook fetched successfully", responses.NewIncomingWebhookResponse(webhook)))
+}
+
+func (h *incomingWebhookHandler) UpdateIncomingWebhookHandler(c *gin.Context) {
+	projectID := c.Param("project_id")
+
+	var requestData requests.UpdateIncomingWebhookRequest
+	if err := c.ShouldBindJSON(&requestData); err != nil {
+		c.JSON(http.StatusBadRequest, datatransfers.ResponseError("Invalid request data"))
+		return
+	}
+
+	if err := validation.Validate(requestData); err != nil {
+		c.JSON(http.StatusBadRequest, datatransfers.ResponseError(err.Error()))
+		return
+	}
+
+	webhook, err := h.incomingWebhookService.GetIncomingWebhook(c.Request.Context(), projectID)
+	if err != nil {
+		webhook = &domain.IncomingWebhook{ProjectID: projectID}
+	}
+	created := webhook.Secret == ""
+
+	if requestData.TargetColumnID != nil {
+		webhook.TargetColumnID = requestData.TargetColumnID
+	}
+
+	if requestData.ClearColumn {
+		webhook.TargetColumnID = nil
+	}
+
+	err = h.incomingWebhookService.SaveIncomingWebhook(c.Request.Context(), webhook, requestData.RotateSecret)
+	if errors.Is(err, domain.ErrInvalidIncomingWebhook) {
+		c.JSON(http.StatusBadRequest, datatransfers.ResponseError(err.Error()))
+		return
+	}
+
+	if err != nil {
+		zap.L().Error("Failed to save incoming webhook", zap.Error(err))
+		c.JSON(http.StatusInternalServerError, datatransfers.ResponseError("Failed to save incoming webhook"))
+		return
+	}
+
+	responseData := responses.NewIncomingWebhookResponse(webhook)
+	if created || requestData.RotateSecret {
+		responseData.Secret = webhook.Secret
+	}
+
+	c.JSON(http.StatusOK, datatransfers.ResponseSuccess("Incoming webhook saved successfully", responseData))
+}
+
+func (h *incomingWebhookHandler) DeleteIncomingWebhookHandler(c *gin.Context) {
+	projectID := c.Param("project_id")
+
+	err := h.incomingWebhookService.DeleteIncomingWebhook(c.Request.Context(), projectID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, datatransfers.ResponseError("Failed to delete incoming webhook"))
+		return
+	}
+
+	c.JSON(http.StatusOK, datatransfers.ResponseSuccess("Incoming webhook deleted successfully", responses.IncomingWebhookDeleteResponse{ProjectID: projectID}))
+}
+
+func (h *incomingWebhookHandler) PushHandler(c *gin.Context) {
+	projectID := c.Param("project_id")
+
+	err := validation.ValidateUUID(projectID)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, datatransfers.ResponseError("Invalid project ID"))
+		return
+	}
+
+	payload, err := io.ReadAll(http.MaxBytesReader(c.Writer, c.Request.Body, maxPushPayloadSize))
+	if err != nil {
+		c.JSON(http.StatusRequestEntityTooLarge, datatransfers.ResponseError("Payload is too large"))
+		return
+	}
+
+	webhook, err := h.incomingWebhookService.VerifyPush(c.Request.Context(), projectID, c.GetHeader(domain.PushSignatureHeader), payload)
+	if errors.Is(err, domain.ErrInvalidPushSignature) {
+		c.JSON(http.StatusUnauthorized, datatransfers.ResponseError(err.Error()))
+		return
+	}
+
+	if err != nil {
+		c.JSON(http.StatusNotFound, datatransfers.ResponseError("Incoming webhook not found"))
+		return
+	}
+
+	var requestData requests.PushEventRequest
+	if err := json.Unmarshal(payload, &requestData); err != nil {
+		c.JSON(http.StatusBadRequest, datatransfers.ResponseError("Invalid request data"))
+		return
+	}
+
+	if err := validation.Validate(requestData); err != nil {
+		c.JSON(http.StatusBadRequest, datatransfers.ResponseError(err.Error()))
+		return
+	}
+
+	event := &domain.PushEvent{
+		Repository: requestData.Repository.FullName,
+		Ref:        requestData.Ref,
+		Commits:    make([]domain.PushCommit, len(requestData.Commits)),
+	}
+
+	for i, commit := range requestData.Commits {
+		event.Commits[i] = domain.PushCommit{
+			ID:         commit.ID,
+			Message:    commit.Message,
+			URL:        commit.URL,
+			AuthorName: commit.Author.Name,
+		}
+	}
+
+	results := h.incomingWebhookService.HandlePush(c.Request.Context(), webhook, event)
+
+	c.JSON(http.StatusOK, datatransfers.ResponseSuccess("Push processed successfully", responses.NewPushResponse(results)))
+}
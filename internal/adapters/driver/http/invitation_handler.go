@@ -9,7 +9,6 @@ import (
 	"github.com/fatihsen-dev/kanban-backend/internal/adapters/driver/http/datatransfers/responses"
 	middlewares "github.com/fatihsen-dev/kanban-backend/internal/adapters/driver/http/middleware"
 	"github.com/fatihsen-dev/kanban-backend/internal/adapters/driver/validation"
-	"github.com/fatihsen-dev/kanban-backend/internal/adapters/driver/ws"
 	"github.com/fatihsen-dev/kanban-backend/internal/core/domain"
 	ports "github.com/fatihsen-dev/kanban-backend/internal/core/ports/driver"
 	"github.com/fatihsen-dev/kanban-backend/pkg/jwt"
@@ -20,11 +19,10 @@ type invitationHandler struct {
 	invitationService      ports.InvitationService
 	authMiddleware         *middlewares.AuthnMiddleware
 	projectAuthzMiddleware *middlewares.ProjectAuthzMiddleware
-	hub                    *ws.Hub
 }
 
-func NewInvitationHandler(invitationService ports.InvitationService, authMiddleware *middlewares.AuthnMiddleware, projectAuthzMiddleware *middlewares.ProjectAuthzMiddleware, hub *ws.Hub) *invitationHandler {
-	return &invitationHandler{invitationService: invitationService, authMiddleware: authMiddleware, projectAuthzMiddleware: projectAuthzMiddleware, hub: hub}
+func NewInvitationHandler(invitationService ports.InvitationService, authMiddleware *middlewares.AuthnMiddleware, projectAuthzMiddleware *middlewares.ProjectAuthzMiddleware) *invitationHandler {
+	return &invitationHandler{invitationService: invitationService, authMiddleware: authMiddleware, projectAuthzMiddleware: projectAuthzMiddleware}
 }
 
 func (h *invitationHandler) RegisterInvitationRouter(r *gin.Engine) {
@@ -34,7 +32,7 @@ func (h *invitationHandler) RegisterInvitationRouter(r *gin.Engine) {
 
 	invitationGroup.GET("", h.GetInvitationsHandler)
 	invitationGroup.PUT("/:invitation_id", h.UpdateInvitationStatusHandler)
-	invitationGroup.POST("/:project_id", h.projectAuthzMiddleware.Handle(middlewares.Admin), h.CreateInvitationHandler)
+	invitationGroup.POST("/:project_id", h.projectAuthzMiddleware.Handle(domain.PermissionMemberInvite), h.CreateInvitationHandler)
 }
 
 func (h *invitationHandler) CreateInvitationHandler(c *gin.Context) {
@@ -72,16 +70,7 @@ func (h *invitationHandler) CreateInvitationHandler(c *gin.Context) {
 		return
 	}
 
-	responseData := make([]responses.InvitationResponse, len(successInvitations))
-
-	for _, invitation := range successInvitations {
-		h.hub.SendMessageToUser(invitation.Invitee.ID, ws.BaseResponse{
-			Name: ws.EventNameInvitationCreated,
-			Data: invitation,
-		})
-	}
-
-	c.JSON(http.StatusCreated, datatransfers.ResponseSuccess("Invitation created successfully", responseData))
+	c.JSON(http.StatusCreated, datatransfers.ResponseSuccess("Invitation created successfully", successInvitations))
 }
 
 func (h *invitationHandler) GetInvitationsHandler(c *gin.Context) {
@@ -122,6 +111,7 @@ func (h *invitationHandler) UpdateInvitationStatusHandler(c *gin.Context) {
 			ID:        projectMember.ID,
 			UserID:    projectMember.UserID,
 			Role:      string(projectMember.Role),
+			RoleID:    projectMember.RoleID,
 			TeamID:    projectMember.TeamID,
 			ProjectID: projectMember.ProjectID,
 			CreatedAt: projectMember.CreatedAt.Format(time.RFC3339),
@@ -134,11 +124,6 @@ func (h *invitationHandler) UpdateInvitationStatusHandler(c *gin.Context) {
 			},
 		}
 
-		h.hub.SendMessageToProject(projectMember.ProjectID, ws.BaseResponse{
-			Name: ws.EventNameProjectMemberCreated,
-			Data: responseData,
-		})
-
 		c.JSON(http.StatusOK, datatransfers.ResponseSuccess("Invitation accepted successfully", responseData))
 		return
 	}
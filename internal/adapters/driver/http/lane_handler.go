@@ -0,0 +1,162 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/fatihsen-dev/kanban-backend/internal/adapters/driver/http/datatransfers"
+	"github.com/fatihsen-dev/kanban-backend/internal/adapters/driver/http/datatransfers/requests"
+	"github.com/fatihsen-dev/kanban-backend/internal/adapters/driver/http/datatransfers/responses"
+	middlewares "github.com/fatihsen-dev/kanban-backend/internal/adapters/driver/http/middleware"
+	"github.com/fatihsen-dev/kanban-backend/internal/adapters/driver/validation"
+	"github.com/fatihsen-dev/kanban-backend/internal/core/domain"
+	ports "github.com/fatihsen-dev/kanban-backend/internal/core/ports/driver"
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+type laneHandler struct {
+	laneService            ports.LaneService
+	authMiddleware         *middlewares.AuthnMiddleware
+	projectAuthzMiddleware *middlewares.ProjectAuthzMiddleware
+}
+
+func NewLaneHandler(laneService ports.LaneService, authMiddleware *middlewares.AuthnMiddleware, projectAuthzMiddleware *middlewares.ProjectAuthzMiddleware) *laneHandler {
+	return &laneHandler{laneService: laneService, authMiddleware: authMiddleware, projectAuthzMiddleware: projectAuthzMiddleware}
+}
+
+func (h *laneHandler) RegisterLaneRouter(r *gin.Engine) {
+	laneGroup := r.Group("/projects/:project_id/lanes")
+
+	laneGroup.Use(h.authMiddleware.Handle(false))
+
+	laneGroup.POST("", h.projectAuthzMiddleware.Handle(domain.PermissionColumnManage), h.CreateLaneHandler)
+	laneGroup.GET("", h.projectAuthzMiddleware.Handle(domain.PermissionProjectView), h.GetLanesHandler)
+	laneGroup.PUT("/:lane_id", h.projectAuthzMiddleware.Handle(domain.PermissionColumnManage), h.UpdateLaneHandler)
+	laneGroup.DELETE("/:lane_id", h.projectAuthzMiddleware.Handle(domain.PermissionColumnManage), h.DeleteLaneHandler)
+}
+
+func (h *laneHandler) CreateLaneHandler(c *gin.Context) {
+	var requestData requests.CreateLaneRequest
+	if err := c.ShouldBindJSON(&requestData); err != nil {
+		c.JSON(http.StatusBadRequest, datatransfers.ResponseError("Invalid request data"))
+		return
+	}
+
+	requestData.ProjectID = c.Param("project_id")
+	if err := validation.Validate(requestData); err != nil {
+		c.JSON(http.StatusBadRequest, datatransfers.ResponseError(err.Error()))
+		return
+	}
+
+	lane := &domain.Lane{
+		Name:      requestData.Name,
+		ProjectID: requestData.ProjectID,
+	}
+
+	if requestData.Position != nil {
+		lane.Position = *requestData.Position
+	}
+
+	err := h.laneService.CreateLane(c.Request.Context(), lane)
+	if err != nil {
+		zap.L().Error("Failed to create lane", zap.Error(err))
+		c.JSON(http.StatusInternalServerError, datatransfers.ResponseError("Failed to create lane"))
+		return
+	}
+
+	responseData := responses.NewLaneResponse(lane)
+
+	c.JSON(http.StatusCreated, datatransfers.ResponseSuccess("Lane created successfully", responseData))
+}
+
+func (h *laneHandler) GetLanesHandler(c *gin.Context) {
+	projectID := c.Param("project_id")
+
+	lanes, err := h.laneService.GetLanesByProjectID(c.Request.Context(), projectID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, datatransfers.ResponseError("Failed to get lanes"))
+		return
+	}
+
+	responseData := make([]responses.LaneResponse, len(lanes))
+	for i, lane := range lanes {
+		responseData[i] = responses.NewLaneResponse(lane)
+	}
+
+	c.JSON(http.StatusOK, datatransfers.ResponseSuccess("Lanes fetched successfully", responseData))
+}
+
+func (h *laneHandler) UpdateLaneHandler(c *gin.Context) {
+	laneID := c.Param("lane_id")
+	projectID := c.Param("project_id")
+
+	err := validation.ValidateUUID(laneID)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, datatransfers.ResponseError("Invalid lane ID"))
+		return
+	}
+
+	var requestData requests.UpdateLaneRequest
+	if err := c.ShouldBindJSON(&requestData); err != nil {
+		c.JSON(http.StatusBadRequest, datatransfers.ResponseError("Invalid request data"))
+		return
+	}
+
+	if err := validation.Validate(requestData); err != nil {
+		c.JSON(http.StatusBadRequest, datatransfers.ResponseError(err.Error()))
+		return
+	}
+
+	lane, err := h.laneService.GetLaneByID(c.Request.Context(), laneID)
+	if err != nil || lane.ProjectID != projectID {
+		c.JSON(http.StatusNotFound, datatransfers.ResponseError("Lane not found"))
+		return
+	}
+
+	if requestData.Name != nil {
+		lane.Name = *requestData.Name
+	}
+
+	if requestData.Position != nil {
+		lane.Position = *requestData.Position
+	}
+
+	err = h.laneService.UpdateLane(c.Request.Context(), lane)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, datatransfers.ResponseError("Failed to update lane"))
+		return
+	}
+
+	responseData := responses.NewLaneResponse(lane)
+
+	c.JSON(http.StatusOK, datatransfers.ResponseSuccess("Lane updated successfully", responseData))
+}
+
+func (h *laneHandler) DeleteLaneHandler(c *gin.Context) {
+	laneID := c.Param("lane_id")
+	projectID := c.Param("project_id")
+
+	err := validation.ValidateUUID(laneID)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, datatransfers.ResponseError("Invalid lane ID"))
+		return
+	}
+
+	lane, err := h.laneService.GetLaneByID(c.Request.Context(), laneID)
+	if err != nil || lane.ProjectID != projectID {
+		c.JSON(http.StatusNotFound, datatransfers.ResponseError("Lane not found"))
+		return
+	}
+
+	err = h.laneService.DeleteLaneByID(c.Request.Context(), laneID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, datatransfers.ResponseError("Failed to delete lane"))
+		return
+	}
+
+	responseData := responses.LaneDeleteResponse{
+		ID: laneID,
+	}
+
+	c.JSON(http.StatusOK, datatransfers.ResponseSuccess("Lane deleted successfully", responseData))
+}
@@ -5,6 +5,7 @@ import (
 	"strings"
 
 	"github.com/fatihsen-dev/kanban-backend/internal/adapters/driver/http/datatransfers"
+	"github.com/fatihsen-dev/kanban-backend/internal/core/domain"
 	"github.com/fatihsen-dev/kanban-backend/pkg/jwt"
 	"github.com/gin-gonic/gin"
 )
@@ -49,6 +50,7 @@ func (m *AuthnMiddleware) Handle(isAdmin bool) gin.HandlerFunc {
 		}
 
 		ctx.Set("user", user)
+		ctx.Request = ctx.Request.WithContext(domain.ContextWithActor(ctx.Request.Context(), user.ID))
 		ctx.Next()
 	}
 }
@@ -0,0 +1,50 @@
+package middlewares
+
+import (
+	"net/http"
+
+	"github.com/fatihsen-dev/kanban-backend/internal/adapters/driver/http/datatransfers"
+	"github.com/fatihsen-dev/kanban-backend/internal/adapters/driver/validation"
+	"github.com/fatihsen-dev/kanban-backend/internal/core/domain"
+	ports "github.com/fatihsen-dev/kanban-backend/internal/core/ports/driver"
+	"github.com/fatihsen-dev/kanban-backend/pkg/jwt"
+	"github.com/gin-gonic/gin"
+)
+
+type OrganizationAuthzMiddleware struct {
+	organizationService ports.OrganizationService
+}
+
+func NewOrganizationAuthzMiddleware(organizationService ports.OrganizationService) *OrganizationAuthzMiddleware {
+	return &OrganizationAuthzMiddleware{
+		organizationService: organizationService,
+	}
+}
+
+func (m *OrganizationAuthzMiddleware) Handle(role domain.OrganizationRole) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		user := ctx.MustGet("user").(*jwt.UserClaims)
+		organizationID := ctx.Param("organization_id")
+
+		err := validation.ValidateUUID(organizationID)
+		if err != nil {
+			ctx.AbortWithStatusJSON(http.StatusBadRequest, datatransfers.ResponseAbort("Invalid organization ID"))
+			return
+		}
+
+		member, err := m.organizationService.GetMembership(ctx.Request.Context(), user.ID, organizationID)
+		if err != nil {
+			ctx.AbortWithStatusJSON(http.StatusForbidden, datatransfers.ResponseAbort("You are not a member of this organization"))
+			return
+		}
+
+		ctx.Set("organization_member", *member)
+
+		if !member.Role.AtLeast(role) {
+			ctx.AbortWithStatusJSON(http.StatusForbidden, datatransfers.ResponseAbort("You are not authorized to access this organization"))
+			return
+		}
+
+		ctx.Next()
+	}
+}
@@ -10,30 +10,19 @@ import (
 	ports "github.com/fatihsen-dev/kanban-backend/internal/core/ports/driver"
 	"github.com/fatihsen-dev/kanban-backend/pkg/jwt"
 	"github.com/gin-gonic/gin"
-	"go.uber.org/zap"
-)
-
-type MemberType string
-
-const (
-	Admin  MemberType = "admin"
-	Owner  MemberType = "owner"
-	Member MemberType = "member"
 )
 
 type ProjectAuthzMiddleware struct {
-	projectMemberService ports.ProjectMemberService
-	teamService          ports.TeamService
+	projectAccessService ports.ProjectAccessService
 }
 
-func NewProjectAuthzMiddleware(projectMemberService ports.ProjectMemberService, teamService ports.TeamService) *ProjectAuthzMiddleware {
+func NewProjectAuthzMiddleware(projectAccessService ports.ProjectAccessService) *ProjectAuthzMiddleware {
 	return &ProjectAuthzMiddleware{
-		projectMemberService: projectMemberService,
-		teamService:          teamService,
+		projectAccessService: projectAccessService,
 	}
 }
 
-func (m *ProjectAuthzMiddleware) Handle(memberType MemberType) gin.HandlerFunc {
+func (m *ProjectAuthzMiddleware) Handle(permission domain.Permission) gin.HandlerFunc {
 	return func(ctx *gin.Context) {
 		user := ctx.MustGet("user").(*jwt.UserClaims)
 		projectID := ctx.Param("project_id")
@@ -44,35 +33,19 @@ func (m *ProjectAuthzMiddleware) Handle(memberType MemberType) gin.HandlerFunc {
 			return
 		}
 
-		projectMember, err := CheckAccess(user.ID, projectID, ctx.Request.Context(), m.projectMemberService)
+		access, err := CheckAccess(user.ID, projectID, ctx.Request.Context(), m.projectAccessService)
 		if err != nil {
 			ctx.AbortWithStatusJSON(http.StatusForbidden, datatransfers.ResponseAbort("You are not a member of this project"))
 			return
 		}
 
-		ctx.Set("project_member", projectMember)
-
-		if !CheckRole(projectMember.Role, memberType, ctx) {
-			if projectMember.TeamID != nil {
-				team, err := m.teamService.GetTeamByID(ctx.Request.Context(), *projectMember.TeamID)
-				if err != nil {
-					zap.L().Error("failed to get team by id", zap.Error(err))
-					ctx.AbortWithStatusJSON(http.StatusForbidden, datatransfers.ResponseAbort("You are not authorized to access this project"))
-					return
-				}
-
-				ctx.Set("team", team)
-
-				if !CheckRole(team.Role, memberType, ctx) {
-					ctx.AbortWithStatusJSON(http.StatusForbidden, datatransfers.ResponseAbort("You are not authorized to access this project"))
-					return
-				}
-				ctx.Next()
-				return
-			}
+		ctx.Set("project_access", access)
+		if access.Member != nil {
+			ctx.Set("project_member", *access.Member)
+		}
 
+		if !access.Can(permission) {
 			ctx.AbortWithStatusJSON(http.StatusForbidden, datatransfers.ResponseAbort("You are not authorized to access this project"))
-
 			return
 		}
 
@@ -80,37 +53,6 @@ func (m *ProjectAuthzMiddleware) Handle(memberType MemberType) gin.HandlerFunc {
 	}
 }
 
-func CheckAccess(userID string, projectID string, ctx context.Context, projectMemberService ports.ProjectMemberService) (domain.ProjectMember, error) {
-	result, err := projectMemberService.GetByUserIDAndProjectID(ctx, userID, projectID)
-	if err != nil {
-		return domain.ProjectMember{}, err
-	}
-
-	return *result, nil
-}
-
-func CheckRole(role domain.AccessRole, memberType MemberType, ctx *gin.Context) bool {
-	switch {
-	case role == domain.AccessOwnerRole:
-		return true
-
-	case role == domain.AccessAdminRole && memberType != Owner:
-		return true
-
-	case memberType == Member:
-		isReadMethod := ctx.Request.Method == "GET"
-		isWriteMethod := ctx.Request.Method == "POST" ||
-			ctx.Request.Method == "PUT" ||
-			ctx.Request.Method == "PATCH" ||
-			ctx.Request.Method == "DELETE"
-
-		switch {
-		case (role == domain.AccessReadRole || role == domain.AccessWriteRole) && isReadMethod:
-			return true
-		case role == domain.AccessWriteRole && isWriteMethod:
-			return true
-		}
-	}
-
-	return false
+func CheckAccess(userID string, projectID string, ctx context.Context, projectAccessService ports.ProjectAccessService) (*domain.ProjectAccess, error) {
+	return projectAccessService.GetProjectAccess(ctx, userID, projectID)
 }
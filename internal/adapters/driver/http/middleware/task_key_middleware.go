@@ -0,0 +1,57 @@
+package middlewares
+
+import (
+	"net/http"
+
+	"github.com/fatihsen-dev/kanban-backend/internal/adapters/driver/http/datatransfers"
+	"github.com/fatihsen-dev/kanban-backend/internal/adapters/driver/validation"
+	ports "github.com/fatihsen-dev/kanban-backend/internal/core/ports/driver"
+	"github.com/gin-gonic/gin"
+)
+
+type TaskKeyMiddleware struct {
+	taskService ports.TaskService
+}
+
+func NewTaskKeyMiddleware(taskService ports.TaskService) *TaskKeyMiddleware {
+	return &TaskKeyMiddleware{
+		taskService: taskService,
+	}
+}
+
+func (m *TaskKeyMiddleware) Handle(param string) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		taskKey := ctx.Param(param)
+		if taskKey == "" || validation.ValidateUUID(taskKey) == nil {
+			ctx.Next()
+			return
+		}
+
+		task, err := m.taskService.GetTaskByKey(ctx.Request.Context(), taskKey)
+		if err != nil {
+			ctx.AbortWithStatusJSON(http.StatusNotFound, datatransfers.ResponseAbort("Task not found"))
+			return
+		}
+
+		projectID := ctx.Param("project_id")
+		if projectID != "" && projectID != task.ProjectID {
+			ctx.AbortWithStatusJSON(http.StatusNotFound, datatransfers.ResponseAbort("Task not found"))
+			return
+		}
+
+		setParam(ctx, "project_id", task.ProjectID)
+		setParam(ctx, "task_id", task.ID)
+
+		ctx.Next()
+	}
+}
+
+func setParam(ctx *gin.Context, key, value string) {
+	for i, param := range ctx.Params {
+		if param.Key == key {
+			ctx.Params[i].Value = value
+			return
+		}
+	}
+	ctx.Params = append(ctx.Params, gin.Param{Key: key, Value: value})
+}
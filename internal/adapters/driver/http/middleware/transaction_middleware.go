@@ -0,0 +1,100 @@
+package middlewares
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/fatihsen-dev/kanban-backend/internal/adapters/driver/http/datatransfers"
+	ports "github.com/fatihsen-dev/kanban-backend/internal/core/ports/driver"
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+var errRequestFailed = errors.New("request failed")
+
+type bufferedResponseWriter struct {
+	gin.ResponseWriter
+	status int
+	body   bytes.Buffer
+}
+
+func (w *bufferedResponseWriter) WriteHeader(code int) {
+	if code > 0 {
+		w.status = code
+	}
+}
+
+func (w *bufferedResponseWriter) WriteHeaderNow() {}
+
+func (w *bufferedResponseWriter) Write(data []byte) (int, error) {
+	return w.body.Write(data)
+}
+
+func (w *bufferedResponseWriter) WriteString(s string) (int, error) {
+	return w.body.WriteString(s)
+}
+
+func (w *bufferedResponseWriter) Status() int {
+	return w.status
+}
+
+func (w *bufferedResponseWriter) Size() int {
+	return w.body.Len()
+}
+
+func (w *bufferedResponseWriter) Written() bool {
+	return w.body.Len() > 0
+}
+
+func (w *bufferedResponseWriter) flush() {
+	w.ResponseWriter.WriteHeader(w.status)
+	w.ResponseWriter.Write(w.body.Bytes())
+}
+
+type TransactionMiddleware struct {
+	outboxService ports.OutboxService
+}
+
+func NewTransactionMiddleware(outboxService ports.OutboxService) *TransactionMiddleware {
+	return &TransactionMiddleware{
+		outboxService: outboxService,
+	}
+}
+
+func (m *TransactionMiddleware) Handle() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		switch ctx.Request.Method {
+		case http.MethodGet, http.MethodHead, http.MethodOptions:
+			ctx.Next()
+			return
+		}
+
+		writer := &bufferedResponseWriter{ResponseWriter: ctx.Writer, status: http.StatusOK}
+		ctx.Writer = writer
+		defer func() {
+			ctx.Writer = writer.ResponseWriter
+		}()
+
+		err := m.outboxService.WithinTransaction(ctx.Request.Context(), func(txCtx context.Context) error {
+			ctx.Request = ctx.Request.WithContext(txCtx)
+			ctx.Next()
+
+			if writer.status >= http.StatusBadRequest {
+				return errRequestFailed
+			}
+			return nil
+		})
+
+		ctx.Writer = writer.ResponseWriter
+
+		if err != nil && !errors.Is(err, errRequestFailed) {
+			zap.L().Error("Failed to commit request", zap.String("method", ctx.Request.Method), zap.String("path", ctx.FullPath()), zap.Error(err))
+			ctx.JSON(http.StatusInternalServerError, datatransfers.ResponseError("Failed to save changes"))
+			return
+		}
+
+		writer.flush()
+	}
+}
@@ -0,0 +1,208 @@
+package http
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/fatihsen-dev/kanban-backend/internal/adapters/driver/http/datatransfers"
+	"github.com/fatihsen-dev/kanban-backend/internal/adapters/driver/http/datatransfers/requests"
+	"github.com/fatihsen-dev/kanban-backend/internal/adapters/driver/http/datatransfers/responses"
+	middlewares "github.com/fatihsen-dev/kanban-backend/internal/adapters/driver/http/middleware"
+	"github.com/fatihsen-dev/kanban-backend/internal/adapters/driver/validation"
+	"github.com/fatihsen-dev/kanban-backend/internal/core/domain"
+	ports "github.com/fatihsen-dev/kanban-backend/internal/core/ports/driver"
+	"github.com/fatihsen-dev/kanban-backend/pkg/jwt"
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+type notificationHandler struct {
+	notificationService ports.NotificationService
+	emailService        ports.EmailService
+	authMiddleware      *middlewares.AuthnMiddleware
+}
+
+func NewNotificationHandler(notificationService ports.NotificationService, emailService ports.EmailService, authMiddleware *middlewares.AuthnMiddleware) *notificationHandler {
+	return &notificationHandler{notificationService: notificationService, emailService: emailService, authMiddleware: authMiddleware}
+}
+
+func (h *notificationHandler) RegisterNotificationRouter(r *gin.Engine) {
+	notificationGroup := r.Group("/notifications")
+
+	notificationGroup.GET("/unsubscribe", h.UnsubscribeHandler)
+
+	notificationGroup.Use(h.authMiddleware.Handle(false))
+
+	notificationGroup.GET("", h.GetNotificationsHandler)
+	notificationGroup.GET("/unread-count", h.GetUnreadCountHandler)
+	notificationGroup.POST("/read", h.MarkReadHandler)
+	notificationGroup.POST("/read-all", h.MarkAllReadHandler)
+	notificationGroup.GET("/preferences", h.GetPreferencesHandler)
+	notificationGroup.PUT("/preferences", h.UpdatePreferencesHandler)
+}
+
+func (h *notificationHandler) GetNotificationsHandler(c *gin.Context) {
+	user := c.MustGet("user").(*jwt.UserClaims)
+
+	var requestData requests.NotificationListRequest
+	if err := c.ShouldBindQuery(&requestData); err != nil {
+		c.JSON(http.StatusBadRequest, datatransfers.ResponseError("Invalid query parameters"))
+		return
+	}
+
+	if err := validation.Validate(requestData); err != nil {
+		c.JSON(http.StatusBadRequest, datatransfers.ResponseError(err.Error()))
+		return
+	}
+
+	page, err := h.notificationService.GetNotifications(c.Request.Context(), &domain.NotificationFilter{
+		RecipientID: user.ID,
+		UnreadOnly:  requestData.Unread,
+		Limit:       requestData.Limit,
+		Cursor:      requestData.Cursor,
+	})
+	if errors.Is(err, domain.ErrInvalidPageCursor) {
+		c.JSON(http.StatusBadRequest, datatransfers.ResponseError(err.Error()))
+		return
+	}
+	if err != nil {
+		zap.L().Error("Failed to get notifications", zap.Error(err))
+		c.JSON(http.StatusInternalServerError, datatransfers.ResponseError("Failed to get notifications"))
+		return
+	}
+
+	c.JSON(http.StatusOK, datatransfers.ResponseSuccess("Notifications fetched successfully", responses.NewNotificationPageResponse(page)))
+}
+
+func (h *notificationHandler) GetUnreadCountHandler(c *gin.Context) {
+	user := c.MustGet("user").(*jwt.UserClaims)
+
+	count, err := h.notificationService.CountUnread(c.Request.Context(), user.ID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, datatransfers.ResponseError("Failed to count unread notifications"))
+		return
+	}
+
+	c.JSON(http.StatusOK, datatransfers.ResponseSuccess("Unread notifications counted successfully", responses.NotificationUnreadCountResponse{UnreadCount: count}))
+}
+
+func (h *notificationHandler) MarkReadHandler(c *gin.Context) {
+	user := c.MustGet("user").(*jwt.UserClaims)
+
+	var requestData requests.NotificationMarkReadRequest
+	if err := c.ShouldBindJSON(&requestData); err != nil {
+		c.JSON(http.StatusBadRequest, datatransfers.ResponseError("Invalid request data"))
+		return
+	}
+
+	if err := validation.Validate(requestData); err != nil {
+		c.JSON(http.StatusBadRequest, datatransfers.ResponseError(err.Error()))
+		return
+	}
+
+	markedIDs, err := h.notificationService.MarkRead(c.Request.Context(), user.ID, requestData.IDs)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, datatransfers.ResponseError("Failed to mark notifications as read"))
+		return
+	}
+
+	h.respondWithReadState(c, user.ID, markedIDs)
+}
+
+func (h *notificationHandler) MarkAllReadHandler(c *gin.Context) {
+	user := c.MustGet("user").(*jwt.UserClaims)
+
+	_, err := h.notificationService.MarkAllRead(c.Request.Context(), user.ID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, datatransfers.ResponseError("Failed to mark notifications as read"))
+		return
+	}
+
+	h.respondWithReadState(c, user.ID, []string{})
+}
+
+func (h *notificationHandler) respondWithReadState(c *gin.Context, userID string, markedIDs []string) {
+	count, err := h.notificationService.CountUnread(c.Request.Context(), userID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, datatransfers.ResponseError("Failed to count unread notifications"))
+		return
+	}
+
+	responseData := responses.NotificationMarkReadResponse{
+		IDs:         markedIDs,
+		UnreadCount: count,
+	}
+
+	c.JSON(http.StatusOK, datatransfers.ResponseSuccess("Notifications marked as read", responseData))
+}
+
+func (h *notificationHandler) GetPreferencesHandler(c *gin.Context) {
+	user := c.MustGet("user").(*jwt.UserClaims)
+
+	preferences, err := h.emailService.GetPreferences(c.Request.Context(), user.ID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, datatransfers.ResponseError("Failed to get notification preferences"))
+		return
+	}
+
+	c.JSON(http.StatusOK, datatransfers.ResponseSuccess("Notification preferences fetched successfully", responses.NewNotificationPreferencesResponse(preferences)))
+}
+
+func (h *notificationHandler) UpdatePreferencesHandler(c *gin.Context) {
+	user := c.MustGet("user").(*jwt.UserClaims)
+
+	var requestData requests.NotificationPreferencesUpdateRequest
+	if err := c.ShouldBindJSON(&requestData); err != nil {
+		c.JSON(http.StatusBadRequest, datatransfers.ResponseError("Invalid request data"))
+		return
+	}
+
+	if err := validation.Validate(requestData); err != nil {
+		c.JSON(http.StatusBadRequest, datatransfers.ResponseError(err.Error()))
+		return
+	}
+
+	preferences := make(domain.NotificationPreferences, len(requestData.Preferences))
+	for notificationType, delivery := range requestData.Preferences {
+		preferences[domain.NotificationType(notificationType)] = domain.EmailDelivery(delivery)
+	}
+
+	updatedPreferences, err := h.emailService.UpdatePreferences(c.Request.Context(), user.ID, preferences)
+	if errors.Is(err, domain.ErrInvalidEmailDelivery) {
+		c.JSON(http.StatusBadRequest, datatransfers.ResponseError(err.Error()))
+		return
+	}
+	if err != nil {
+		zap.L().Error("Failed to update notification preferences", zap.Error(err))
+		c.JSON(http.StatusInternalServerError, datatransfers.ResponseError("Failed to update notification preferences"))
+		return
+	}
+
+	c.JSON(http.StatusOK, datatransfers.ResponseSuccess("Notification preferences updated successfully", responses.NewNotificationPreferencesResponse(updatedPreferences)))
+}
+
+func (h *notificationHandler) UnsubscribeHandler(c *gin.Context) {
+	var requestData requests.NotificationUnsubscribeRequest
+	if err := c.ShouldBindQuery(&requestData); err != nil {
+		c.JSON(http.StatusBadRequest, datatransfers.ResponseError("Invalid query parameters"))
+		return
+	}
+
+	if err := validation.Validate(requestData); err != nil {
+		c.JSON(http.StatusBadRequest, datatransfers.ResponseError(err.Error()))
+		return
+	}
+
+	notificationType, err := h.emailService.Unsubscribe(c.Request.Context(), requestData.Token)
+	if errors.Is(err, domain.ErrInvalidUnsubscribeToken) {
+		c.JSON(http.StatusBadRequest, datatransfers.ResponseError(err.Error()))
+		return
+	}
+	if err != nil {
+		zap.L().Error("Failed to unsubscribe", zap.Error(err))
+		c.JSON(http.StatusInternalServerError, datatransfers.ResponseError("Failed to unsubscribe"))
+		return
+	}
+
+	c.JSON(http.StatusOK, datatransfers.ResponseSuccess("Unsubscribed successfully", responses.NotificationUnsubscribeResponse{Type: string(notificationType)}))
+}
@@ -0,0 +1,516 @@
+package http
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/fatihsen-dev/kanban-backend/internal/adapters/driver/http/datatransfers"
+	"github.com/fatihsen-dev/kanban-backend/internal/adapters/driver/http/datatransfers/requests"
+	"github.com/fatihsen-dev/kanban-backend/internal/adapters/driver/http/datatransfers/responses"
+	middlewares "github.com/fatihsen-dev/kanban-backend/internal/adapters/driver/http/middleware"
+	"github.com/fatihsen-dev/kanban-backend/internal/adapters/driver/validation"
+	"github.com/fatihsen-dev/kanban-backend/internal/core/domain"
+	ports "github.com/fatihsen-dev/kanban-backend/internal/core/ports/driver"
+	"github.com/fatihsen-dev/kanban-backend/pkg/jwt"
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+type organizationHandler struct {
+	organizationService         ports.OrganizationService
+	authMiddleware              *middlewares.AuthnMiddleware
+	organizationAuthzMiddleware *middlewares.OrganizationAuthzMiddleware
+}
+
+func NewOrganizationHandler(organizationService ports.OrganizationService, authMiddleware *middlewares.AuthnMiddleware, organizationAuthzMiddleware *middlewares.OrganizationAuthzMiddleware) *organizationHandler {
+	return &organizationHandler{organizationService: organizationService, authMiddleware: authMiddleware, organizationAuthzMiddleware: organizationAuthzMiddleware}
+}
+
+func (h *organizationHandler) RegisterOrganizationRouter(r *gin.Engine) {
+	organizationGroup := r.Group("/organizations")
+
+	organizationGroup.Use(h.authMiddleware.Handle(false))
+
+	organizationGroup.POST("", h.CreateOrganizationHandler)
+	organizationGroup.GET("", h.GetOrganizationsHandler)
+	organizationGroup.GET("/:organization_id", h.organizationAuthzMiddleware.Handle(domain.OrganizationMemberRole), h.GetOrganizationHandler)
+	organizationGroup.PUT("/:organization_id", h.organizationAuthzMiddleware.Handle(domain.OrganizationAdminRole), h.UpdateOrganizationHandler)
+	organizationGroup.DELETE("/:organization_id", h.organizationAuthzMiddleware.Handle(domain.OrganizationOwnerRole), h.DeleteOrganizationHandler)
+	organizationGroup.GET("/:organization_id/projects", h.organizationAuthzMiddleware.Handle(domain.OrganizationMemberRole), h.GetOrganizationProjectsHandler)
+
+	organizationGroup.POST("/:organization_id/members", h.organizationAuthzMiddleware.Handle(domain.OrganizationAdminRole), h.AddOrganizationMemberHandler)
+	organizationGroup.PUT("/:organization_id/members/:member_id", h.organizationAuthzMiddleware.Handle(domain.OrganizationOwnerRole), h.UpdateOrganizationMemberHandler)
+	organizationGroup.DELETE("/:organization_id/members/:member_id", h.organizationAuthzMiddleware.Handle(domain.OrganizationAdminRole), h.RemoveOrganizationMemberHandler)
+
+	organizationGroup.POST("/:organization_id/teams", h.organizationAuthzMiddleware.Handle(domain.OrganizationAdminRole), h.CreateOrganizationTeamHandler)
+	organizationGroup.DELETE("/:organization_id/teams/:team_id", h.organizationAuthzMiddleware.Handle(domain.OrganizationAdminRole), h.DeleteOrganizationTeamHandler)
+	organizationGroup.PUT("/:organization_id/teams/:team_id/members", h.organizationAuthzMiddleware.Handle(domain.OrganizationAdminRole), h.AddOrganizationTeamMembersHandler)
+	organizationGroup.DELETE("/:organization_id/teams/:team_id/members/:user_id", h.organizationAuthzMiddleware.Handle(domain.OrganizationAdminRole), h.RemoveOrganizationTeamMemberHandler)
+	organizationGroup.PUT("/:organization_id/teams/:team_id/projects", h.organizationAuthzMiddleware.Handle(domain.OrganizationAdminRole), h.GrantOrganizationTeamProjectsHandler)
+	organizationGroup.DELETE("/:organization_id/teams/:team_id/projects/:project_id", h.organizationAuthzMiddleware.Handle(domain.OrganizationAdminRole), h.RevokeOrganizationTeamProjectHandler)
+}
+
+func (h *organizationHandler) CreateOrganizationHandler(c *gin.Context) {
+	userClaims := c.MustGet("user").(*jwt.UserClaims)
+
+	var requestData requests.CreateOrganizationRequest
+	if err := c.ShouldBindJSON(&requestData); err != nil {
+		c.JSON(http.StatusBadRequest, datatransfers.ResponseError("Invalid request data"))
+		return
+	}
+
+	if err := validation.Validate(requestData); err != nil {
+		c.JSON(http.StatusBadRequest, datatransfers.ResponseError(err.Error()))
+		return
+	}
+
+	organization := &domain.Organization{
+		Name:    requestData.Name,
+		OwnerID: userClaims.ID,
+	}
+
+	err := h.organizationService.CreateOrganization(c.Request.Context(), organization)
+	if err != nil {
+		zap.L().Error("Failed to create organization", zap.Error(err))
+		c.JSON(http.StatusInternalServerError, datatransfers.ResponseError("Failed to create organization"))
+		return
+	}
+
+	c.JSON(http.StatusCreated, datatransfers.ResponseSuccess("Organization created successfully", buildOrganizationResponse(organization)))
+}
+
+func (h *organizationHandler) GetOrganizationsHandler(c *gin.Context) {
+	userClaims := c.MustGet("user").(*jwt.UserClaims)
+
+	organizations, err := h.organizationService.GetUserOrganizations(c.Request.Context(), userClaims.ID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, datatransfers.ResponseError("Failed to get organizations"))
+		return
+	}
+
+	responseData := make([]responses.OrganizationResponse, len(organizations))
+	for i, organization := range organizations {
+		responseData[i] = buildOrganizationResponse(organization)
+	}
+
+	c.JSON(http.StatusOK, datatransfers.ResponseSuccess("Organizations fetched successfully", responseData))
+}
+
+func (h *organizationHandler) GetOrganizationHandler(c *gin.Context) {
+	organizationID := c.Param("organization_id")
+
+	details, err := h.organizationService.GetOrganizationWithDetails(c.Request.Context(), organizationID)
+	if err != nil {
+		c.JSON(http.StatusNotFound, datatransfers.ResponseError("Organization not found"))
+		return
+	}
+
+	userMap := make(map[string]responses.UserResponse)
+	for _, user := range details.Users {
+		userMap[user.ID] = responses.UserResponse{
+			ID:        user.ID,
+			Name:      user.Name,
+			Email:     user.Email,
+			IsAdmin:   user.IsAdmin,
+			CreatedAt: user.CreatedAt.Format(time.RFC3339),
+		}
+	}
+
+	memberResponses := make([]responses.OrganizationMemberResponse, len(details.Members))
+	for i, member := range details.Members {
+		memberResponses[i] = buildOrganizationMemberResponse(member)
+		memberResponses[i].User = userMap[member.UserID]
+	}
+
+	projectsByTeam := make(map[string][]responses.OrganizationTeamProjectResponse)
+	for _, grant := range details.TeamProjects {
+		projectsByTeam[grant.TeamID] = append(projectsByTeam[grant.TeamID], buildOrganizationTeamProjectResponse(grant))
+	}
+
+	teamResponses := make([]responses.OrganizationTeamResponse, len(details.Teams))
+	for i, team := range details.Teams {
+		teamResponses[i] = buildOrganizationTeamResponse(team)
+		if projects, ok := projectsByTeam[team.ID]; ok {
+			teamResponses[i].Projects = projects
+		}
+	}
+
+	organization := details.Organization
+	responseData := responses.OrganizationWithDetailsResponse{
+		ID:        organization.ID,
+		Name:      organization.Name,
+		OwnerID:   organization.OwnerID,
+		CreatedAt: organization.CreatedAt.Format(time.RFC3339),
+		Members:   memberResponses,
+		Teams:     teamResponses,
+	}
+
+	c.JSON(http.StatusOK, datatransfers.ResponseSuccess("Organization details fetched successfully", responseData))
+}
+
+func (h *organizationHandler) UpdateOrganizationHandler(c *gin.Context) {
+	organizationID := c.Param("organization_id")
+
+	var requestData requests.UpdateOrganizationRequest
+	if err := c.ShouldBindJSON(&requestData); err != nil {
+		c.JSON(http.StatusBadRequest, datatransfers.ResponseError("Invalid request data"))
+		return
+	}
+
+	if err := validation.Validate(requestData); err != nil {
+		c.JSON(http.StatusBadRequest, datatransfers.ResponseError(err.Error()))
+		return
+	}
+
+	organization := &domain.Organization{
+		ID: organizationID,
+	}
+
+	if requestData.Name != nil {
+		organization.Name = *requestData.Name
+	}
+
+	err := h.organizationService.UpdateOrganization(c.Request.Context(), organization)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, datatransfers.ResponseError("Failed to update organization"))
+		return
+	}
+
+	updatedOrganization, err := h.organizationService.GetOrganizationByID(c.Request.Context(), organizationID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, datatransfers.ResponseError("Failed to get organization"))
+		return
+	}
+
+	c.JSON(http.StatusOK, datatransfers.ResponseSuccess("Organization updated successfully", buildOrganizationResponse(updatedOrganization)))
+}
+
+func (h *organizationHandler) DeleteOrganizationHandler(c *gin.Context) {
+	organizationID := c.Param("organization_id")
+
+	err := h.organizationService.DeleteOrganization(c.Request.Context(), organizationID)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, datatransfers.ResponseError(err.Error()))
+		return
+	}
+
+	c.JSON(http.StatusOK, datatransfers.ResponseSuccess("Organization deleted successfully", nil))
+}
+
+func (h *organizationHandler) GetOrganizationProjectsHandler(c *gin.Context) {
+	organizationID := c.Param("organization_id")
+
+	projects, err := h.organizationService.GetOrganizationProjects(c.Request.Context(), organizationID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, datatransfers.ResponseError("Failed to get projects"))
+		return
+	}
+
+	responseData := make([]responses.ProjectResponse, len(projects))
+	for i, project := range projects {
+		responseData[i] = responses.NewProjectResponse(project)
+	}
+
+	c.JSON(http.StatusOK, datatransfers.ResponseSuccess("Projects fetched successfully", responseData))
+}
+
+func (h *organizationHandler) AddOrganizationMemberHandler(c *gin.Context) {
+	organizationID := c.Param("organization_id")
+
+	var requestData requests.AddOrganizationMemberRequest
+	if err := c.ShouldBindJSON(&requestData); err != nil {
+		c.JSON(http.StatusBadRequest, datatransfers.ResponseError("Invalid request data"))
+		return
+	}
+
+	if err := validation.Validate(requestData); err != nil {
+		c.JSON(http.StatusBadRequest, datatransfers.ResponseError(err.Error()))
+		return
+	}
+
+	member := &domain.OrganizationMember{
+		OrganizationID: organizationID,
+		UserID:         requestData.UserID,
+		Role:           domain.OrganizationRole(requestData.Role),
+	}
+
+	actor := c.MustGet("organization_member").(domain.OrganizationMember)
+	err := h.organizationService.AddOrganizationMember(c.Request.Context(), member, &actor)
+	if errors.Is(err, domain.ErrOrganizationAdminGrant) {
+		c.JSON(http.StatusForbidden, datatransfers.ResponseError(err.Error()))
+		return
+	}
+	if err != nil {
+		c.JSON(http.StatusBadRequest, datatransfers.ResponseError(err.Error()))
+		return
+	}
+
+	c.JSON(http.StatusCreated, datatransfers.ResponseSuccess("Organization member added successfully", buildOrganizationMemberResponse(member)))
+}
+
+func (h *organizationHandler) UpdateOrganizationMemberHandler(c *gin.Context) {
+	organizationID := c.Param("organization_id")
+	memberID := c.Param("member_id")
+
+	err := validation.ValidateUUID(memberID)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, datatransfers.ResponseError("Invalid member ID"))
+		return
+	}
+
+	var requestData requests.UpdateOrganizationMemberRequest
+	if err := c.ShouldBindJSON(&requestData); err != nil {
+		c.JSON(http.StatusBadRequest, datatransfers.ResponseError("Invalid request data"))
+		return
+	}
+
+	if err := validation.Validate(requestData); err != nil {
+		c.JSON(http.StatusBadRequest, datatransfers.ResponseError(err.Error()))
+		return
+	}
+
+	member := &domain.OrganizationMember{
+		ID:             memberID,
+		OrganizationID: organizationID,
+		Role:           domain.OrganizationRole(requestData.Role),
+	}
+
+	err = h.organizationService.UpdateOrganizationMember(c.Request.Context(), member)
+	if errors.Is(err, domain.ErrLastOrganizationAdmin) {
+		c.JSON(http.StatusConflict, datatransfers.ResponseError(err.Error()))
+		return
+	}
+	if err != nil {
+		c.JSON(http.StatusBadRequest, datatransfers.ResponseError(err.Error()))
+		return
+	}
+
+	c.JSON(http.StatusOK, datatransfers.ResponseSuccess("Organization member updated successfully", gin.H{
+		"id":   memberID,
+		"role": requestData.Role,
+	}))
+}
+
+func (h *organizationHandler) RemoveOrganizationMemberHandler(c *gin.Context) {
+	organizationID := c.Param("organization_id")
+	memberID := c.Param("member_id")
+
+	err := validation.ValidateUUID(memberID)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, datatransfers.ResponseError("Invalid member ID"))
+		return
+	}
+
+	actor := c.MustGet("organization_member").(domain.OrganizationMember)
+	err = h.organizationService.RemoveOrganizationMember(c.Request.Context(), organizationID, memberID, &actor)
+	if errors.Is(err, domain.ErrOrganizationAdminGrant) {
+		c.JSON(http.StatusForbidden, datatransfers.ResponseError(err.Error()))
+		return
+	}
+	if errors.Is(err, domain.ErrLastOrganizationAdmin) {
+		c.JSON(http.StatusConflict, datatransfers.ResponseError(err.Error()))
+		return
+	}
+	if err != nil {
+		c.JSON(http.StatusBadRequest, datatransfers.ResponseError(err.Error()))
+		return
+	}
+
+	c.JSON(http.StatusOK, datatransfers.ResponseSuccess("Organization member removed successfully", nil))
+}
+
+func (h *organizationHandler) CreateOrganizationTeamHandler(c *gin.Context) {
+	organizationID := c.Param("organization_id")
+
+	var requestData requests.CreateOrganizationTeamRequest
+	if err := c.ShouldBindJSON(&requestData); err != nil {
+		c.JSON(http.StatusBadRequest, datatransfers.ResponseError("Invalid request data"))
+		return
+	}
+
+	if err := validation.Validate(requestData); err != nil {
+		c.JSON(http.StatusBadRequest, datatransfers.ResponseError(err.Error()))
+		return
+	}
+
+	team := &domain.OrganizationTeam{
+		Name:           requestData.Name,
+		OrganizationID: organizationID,
+	}
+
+	err := h.organizationService.CreateTeam(c.Request.Context(), team)
+	if err != nil {
+		zap.L().Error("Failed to create organization team", zap.Error(err))
+		c.JSON(http.StatusInternalServerError, datatransfers.ResponseError("Failed to create team"))
+		return
+	}
+
+	c.JSON(http.StatusCreated, datatransfers.ResponseSuccess("Team created successfully", buildOrganizationTeamResponse(team)))
+}
+
+func (h *organizationHandler) DeleteOrganizationTeamHandler(c *gin.Context) {
+	organizationID := c.Param("organization_id")
+	teamID := c.Param("team_id")
+
+	err := validation.ValidateUUID(teamID)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, datatransfers.ResponseError("Invalid team ID"))
+		return
+	}
+
+	err = h.organizationService.DeleteTeam(c.Request.Context(), organizationID, teamID)
+	if err != nil {
+		c.JSON(http.StatusNotFound, datatransfers.ResponseError(err.Error()))
+		return
+	}
+
+	c.JSON(http.StatusOK, datatransfers.ResponseSuccess("Team deleted successfully", nil))
+}
+
+func (h *organizationHandler) AddOrganizationTeamMembersHandler(c *gin.Context) {
+	organizationID := c.Param("organization_id")
+	teamID := c.Param("team_id")
+
+	err := validation.ValidateUUID(teamID)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, datatransfers.ResponseError("Invalid team ID"))
+		return
+	}
+
+	var requestData requests.AddOrganizationTeamMembersRequest
+	if err := c.ShouldBindJSON(&requestData); err != nil {
+		c.JSON(http.StatusBadRequest, datatransfers.ResponseError("Invalid request data"))
+		return
+	}
+
+	if err := validation.Validate(requestData); err != nil {
+		c.JSON(http.StatusBadRequest, datatransfers.ResponseError(err.Error()))
+		return
+	}
+
+	addedUserIDs, err := h.organizationService.AddTeamMembers(c.Request.Context(), organizationID, teamID, requestData.UserIDs)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, datatransfers.ResponseError(err.Error()))
+		return
+	}
+
+	responseData := responses.AddOrganizationTeamMembersResponse{
+		TeamID:  teamID,
+		UserIDs: addedUserIDs,
+	}
+
+	c.JSON(http.StatusOK, datatransfers.ResponseSuccess("Team members added successfully", responseData))
+}
+
+func (h *organizationHandler) RemoveOrganizationTeamMemberHandler(c *gin.Context) {
+	organizationID := c.Param("organization_id")
+	teamID := c.Param("team_id")
+	userID := c.Param("user_id")
+
+	if validation.ValidateUUID(teamID) != nil || validation.ValidateUUID(userID) != nil {
+		c.JSON(http.StatusBadRequest, datatransfers.ResponseError("Invalid team or user ID"))
+		return
+	}
+
+	err := h.organizationService.RemoveTeamMember(c.Request.Context(), organizationID, teamID, userID)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, datatransfers.ResponseError(err.Error()))
+		return
+	}
+
+	c.JSON(http.StatusOK, datatransfers.ResponseSuccess("Team member removed successfully", nil))
+}
+
+func (h *organizationHandler) GrantOrganizationTeamProjectsHandler(c *gin.Context) {
+	organizationID := c.Param("organization_id")
+	teamID := c.Param("team_id")
+
+	err := validation.ValidateUUID(teamID)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, datatransfers.ResponseError("Invalid team ID"))
+		return
+	}
+
+	var requestData requests.GrantOrganizationTeamProjectsRequest
+	if err := c.ShouldBindJSON(&requestData); err != nil {
+		c.JSON(http.StatusBadRequest, datatransfers.ResponseError("Invalid request data"))
+		return
+	}
+
+	if err := validation.Validate(requestData); err != nil {
+		c.JSON(http.StatusBadRequest, datatransfers.ResponseError(err.Error()))
+		return
+	}
+
+	grants, err := h.organizationService.GrantTeamProjects(c.Request.Context(), organizationID, teamID, requestData.ProjectIDs, domain.AccessRole(requestData.Role))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, datatransfers.ResponseError(err.Error()))
+		return
+	}
+
+	responseData := make([]responses.OrganizationTeamProjectResponse, len(grants))
+	for i, grant := range grants {
+		responseData[i] = buildOrganizationTeamProjectResponse(grant)
+	}
+
+	c.JSON(http.StatusOK, datatransfers.ResponseSuccess("Team project access granted successfully", responseData))
+}
+
+func (h *organizationHandler) RevokeOrganizationTeamProjectHandler(c *gin.Context) {
+	organizationID := c.Param("organization_id")
+	teamID := c.Param("team_id")
+	projectID := c.Param("project_id")
+
+	if validation.ValidateUUID(teamID) != nil || validation.ValidateUUID(projectID) != nil {
+		c.JSON(http.StatusBadRequest, datatransfers.ResponseError("Invalid team or project ID"))
+		return
+	}
+
+	err := h.organizationService.RevokeTeamProject(c.Request.Context(), organizationID, teamID, projectID)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, datatransfers.ResponseError(err.Error()))
+		return
+	}
+
+	c.JSON(http.StatusOK, datatransfers.ResponseSuccess("Team project access revoked successfully", nil))
+}
+
+func buildOrganizationResponse(organization *domain.Organization) responses.OrganizationResponse {
+	return responses.OrganizationResponse{
+		ID:        organization.ID,
+		Name:      organization.Name,
+		OwnerID:   organization.OwnerID,
+		CreatedAt: organization.CreatedAt.Format(time.RFC3339),
+	}
+}
+
+func buildOrganizationMemberResponse(member *domain.OrganizationMember) responses.OrganizationMemberResponse {
+	return responses.OrganizationMemberResponse{
+		ID:             member.ID,
+		OrganizationID: member.OrganizationID,
+		UserID:         member.UserID,
+		Role:           string(member.Role),
+		CreatedAt:      member.CreatedAt.Format(time.RFC3339),
+	}
+}
+
+func buildOrganizationTeamResponse(team *domain.OrganizationTeam) responses.OrganizationTeamResponse {
+	return responses.OrganizationTeamResponse{
+		ID:             team.ID,
+		Name:           team.Name,
+		OrganizationID: team.OrganizationID,
+		MemberIDs:      team.MemberIDs,
+		Projects:       make([]responses.OrganizationTeamProjectResponse, 0),
+		CreatedAt:      team.CreatedAt.Format(time.RFC3339),
+	}
+}
+
+func buildOrganizationTeamProjectResponse(grant *domain.OrganizationTeamProject) responses.OrganizationTeamProjectResponse {
+	return responses.OrganizationTeamProjectResponse{
+		TeamID:    grant.TeamID,
+		ProjectID: grant.ProjectID,
+		Role:      string(grant.Role),
+		CreatedAt: grant.CreatedAt.Format(time.RFC3339),
+	}
+}
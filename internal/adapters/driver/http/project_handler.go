@@ -1,6 +1,7 @@
 package http
 
 import (
+	"errors"
 	"net/http"
 	"time"
 
@@ -9,7 +10,6 @@ import (
 	"github.com/fatihsen-dev/kanban-backend/internal/adapters/driver/http/datatransfers/responses"
 	middlewares "github.com/fatihsen-dev/kanban-backend/internal/adapters/driver/http/middleware"
 	"github.com/fatihsen-dev/kanban-backend/internal/adapters/driver/validation"
-	"github.com/fatihsen-dev/kanban-backend/internal/adapters/driver/ws"
 	"github.com/fatihsen-dev/kanban-backend/internal/core/domain"
 	ports "github.com/fatihsen-dev/kanban-backend/internal/core/ports/driver"
 	"github.com/fatihsen-dev/kanban-backend/pkg/jwt"
@@ -21,11 +21,10 @@ type projectHandler struct {
 	projectService         ports.ProjectService
 	authMiddleware         *middlewares.AuthnMiddleware
 	projectAuthzMiddleware *middlewares.ProjectAuthzMiddleware
-	hub                    *ws.Hub
 }
 
-func NewProjectHandler(projectService ports.ProjectService, authMiddleware *middlewares.AuthnMiddleware, projectAuthzMiddleware *middlewares.ProjectAuthzMiddleware, hub *ws.Hub) *projectHandler {
-	return &projectHandler{projectService: projectService, authMiddleware: authMiddleware, projectAuthzMiddleware: projectAuthzMiddleware, hub: hub}
+func NewProjectHandler(projectService ports.ProjectService, authMiddleware *middlewares.AuthnMiddleware, projectAuthzMiddleware *middlewares.ProjectAuthzMiddleware) *projectHandler {
+	return &projectHandler{projectService: projectService, authMiddleware: authMiddleware, projectAuthzMiddleware: projectAuthzMiddleware}
 }
 
 func (h *projectHandler) RegisterProjectRouter(r *gin.Engine) {
@@ -36,11 +35,15 @@ func (h *projectHandler) RegisterProjectRouter(r *gin.Engine) {
 	projectGroup.POST("", h.CreateProjectHandler)
 	projectGroup.GET("", h.GetProjectsHandler)
 	projectGroup.GET("/:project_id",
-		h.projectAuthzMiddleware.Handle(middlewares.Member),
+		h.projectAuthzMiddleware.Handle(domain.PermissionProjectView),
 		h.GetProjectHandler,
 	)
+	projectGroup.PUT("/:project_id",
+		h.projectAuthzMiddleware.Handle(domain.PermissionProjectManage),
+		h.UpdateProjectHandler,
+	)
 	projectGroup.DELETE("/:project_id",
-		h.projectAuthzMiddleware.Handle(middlewares.Owner),
+		h.projectAuthzMiddleware.Handle(domain.PermissionProjectDelete),
 		h.DeleteProjectHandler,
 	)
 }
@@ -61,24 +64,34 @@ func (h *projectHandler) CreateProjectHandler(c *gin.Context) {
 	}
 
 	project := &domain.Project{
-		Name:    requestData.Name,
-		OwnerID: userClaims.ID,
+		Name:           requestData.Name,
+		OwnerID:        userClaims.ID,
+		OrganizationID: requestData.OrganizationID,
+	}
+
+	if requestData.Key != nil {
+		project.Key = *requestData.Key
 	}
 
 	err := h.projectService.CreateProject(c.Request.Context(), project)
 
+	if errors.Is(err, domain.ErrOrganizationAccessDenied) {
+		c.JSON(http.StatusForbidden, datatransfers.ResponseError(err.Error()))
+		return
+	}
+
+	if errors.Is(err, domain.ErrProjectKeyTaken) {
+		c.JSON(http.StatusConflict, datatransfers.ResponseError(err.Error()))
+		return
+	}
+
 	if err != nil {
 		zap.L().Error("Failed to create project", zap.Error(err))
 		c.JSON(http.StatusInternalServerError, datatransfers.ResponseError("Failed to create project"))
 		return
 	}
 
-	responseData := responses.ProjectResponse{
-		ID:        project.ID,
-		Name:      project.Name,
-		OwnerID:   project.OwnerID,
-		CreatedAt: project.CreatedAt.Format(time.RFC3339),
-	}
+	responseData := responses.NewProjectResponse(project)
 
 	c.JSON(http.StatusCreated, datatransfers.ResponseSuccess("Project created successfully", responseData))
 }
@@ -92,19 +105,26 @@ func (h *projectHandler) GetProjectHandler(c *gin.Context) {
 		return
 	}
 
-	project, columns, tasksByColumn, teams, projectMembers, users, err := h.projectService.GetProjectWithDetails(c.Request.Context(), projectID)
+	details, err := h.projectService.GetProjectWithDetails(c.Request.Context(), projectID)
 	if err != nil {
 		zap.L().Error("Failed to get project with details", zap.Error(err))
 		c.JSON(http.StatusNotFound, datatransfers.ResponseError(err.Error()))
 		return
 	}
 
-	teamResponses := make([]responses.TeamWithMembersResponse, len(teams))
-	for i, team := range teams {
+	c.JSON(http.StatusOK, datatransfers.ResponseSuccess("Project details fetched successfully", newProjectDetailsResponse(details)))
+}
+
+func newProjectDetailsResponse(details *domain.ProjectDetails) responses.ProjectWithDetailsResponse {
+	project := details.Project
+
+	teamResponses := make([]responses.TeamWithMembersResponse, len(details.Teams))
+	for i, team := range details.Teams {
 		teamResponses[i] = responses.TeamWithMembersResponse{
 			ID:        team.ID,
 			Name:      team.Name,
 			Role:      string(team.Role),
+			RoleID:    team.RoleID,
 			ProjectID: team.ProjectID,
 			Members:   make([]responses.ProjectMemberResponse, 0),
 			CreatedAt: team.CreatedAt.Format(time.RFC3339),
@@ -112,7 +132,7 @@ func (h *projectHandler) GetProjectHandler(c *gin.Context) {
 	}
 
 	userMap := make(map[string]responses.UserResponse)
-	for _, user := range users {
+	for _, user := range details.Users {
 		userMap[user.ID] = responses.UserResponse{
 			ID:        user.ID,
 			Name:      user.Name,
@@ -122,12 +142,13 @@ func (h *projectHandler) GetProjectHandler(c *gin.Context) {
 		}
 	}
 
-	memberResponses := make([]responses.ProjectMemberWithUserResponse, len(projectMembers))
-	for i, member := range projectMembers {
+	memberResponses := make([]responses.ProjectMemberWithUserResponse, len(details.Members))
+	for i, member := range details.Members {
 		memberResponses[i] = responses.ProjectMemberWithUserResponse{
 			ID:        member.ID,
 			UserID:    member.UserID,
 			Role:      string(member.Role),
+			RoleID:    member.RoleID,
 			TeamID:    member.TeamID,
 			ProjectID: member.ProjectID,
 			CreatedAt: member.CreatedAt.Format(time.RFC3339),
@@ -139,41 +160,51 @@ func (h *projectHandler) GetProjectHandler(c *gin.Context) {
 		}
 	}
 
-	columnResponses := make([]responses.ColumnWithDetailsResponse, len(columns))
-	for i, column := range columns {
-		tasks := tasksByColumn[column.ID]
-		taskResponses := make([]responses.TaskResponse, len(tasks))
-		for j, task := range tasks {
-			taskResponses[j] = responses.TaskResponse{
-				ID:        task.ID,
-				Title:     task.Title,
-				Content:   task.Content,
-				ProjectID: task.ProjectID,
-				ColumnID:  task.ColumnID,
-				CreatedAt: task.CreatedAt.Format(time.RFC3339),
+	columnResponses := make([]responses.ColumnWithDetailsResponse, len(details.Columns))
+	for i, column := range details.Columns {
+		columnResponses[i] = responses.NewColumnWithDetailsResponse(column, details.TasksByColumn[column.ID])
+		columnResponses[i].Lanes = make(map[string][]responses.TaskResponse)
+		for laneKey, tasks := range details.TasksByColumnAndLane[column.ID] {
+			taskResponses := make([]responses.TaskResponse, len(tasks))
+			for j, task := range tasks {
+				taskResponses[j] = responses.NewTaskResponse(task)
 			}
+			columnResponses[i].Lanes[laneKey] = taskResponses
 		}
+	}
 
-		columnResponses[i] = responses.ColumnWithDetailsResponse{
-			ID:        column.ID,
-			Name:      column.Name,
-			Color:     column.Color,
-			CreatedAt: column.CreatedAt.Format(time.RFC3339),
-			Tasks:     taskResponses,
-		}
+	transitionResponses := make([]responses.ColumnTransitionResponse, len(details.Transitions))
+	for i, transition := range details.Transitions {
+		transitionResponses[i] = responses.NewColumnTransitionResponse(transition)
 	}
 
-	response := responses.ProjectWithDetailsResponse{
-		ID:        project.ID,
-		Name:      project.Name,
-		OwnerID:   project.OwnerID,
-		CreatedAt: project.CreatedAt.Format(time.RFC3339),
-		Columns:   columnResponses,
-		Teams:     teamResponses,
-		Members:   memberResponses,
+	laneResponses := make([]responses.LaneResponse, len(details.Lanes))
+	for i, lane := range details.Lanes {
+		laneResponses[i] = responses.NewLaneResponse(lane)
 	}
 
-	c.JSON(http.StatusOK, datatransfers.ResponseSuccess("Project details fetched successfully", response))
+	roleResponses := make([]responses.ProjectRoleResponse, len(details.Roles))
+	for i, role := range details.Roles {
+		roleResponses[i] = responses.NewProjectRoleResponse(role)
+	}
+
+	return responses.ProjectWithDetailsResponse{
+		ID:                 project.ID,
+		Name:               project.Name,
+		Key:                project.Key,
+		OwnerID:            project.OwnerID,
+		OrganizationID:     project.OrganizationID,
+		WipEnforcement:     string(project.WipEnforcement),
+		LaneGrouping:       string(project.LaneGrouping),
+		BlockerEnforcement: string(project.BlockerEnforcement),
+		CreatedAt:          project.CreatedAt.Format(time.RFC3339),
+		Columns:            columnResponses,
+		Lanes:              laneResponses,
+		Teams:              teamResponses,
+		Members:            memberResponses,
+		Roles:              roleResponses,
+		Transitions:        transitionResponses,
+	}
 }
 
 func (h *projectHandler) GetProjectsHandler(c *gin.Context) {
@@ -186,17 +217,72 @@ func (h *projectHandler) GetProjectsHandler(c *gin.Context) {
 
 	projectResponses := make([]responses.ProjectResponse, len(projects))
 	for i, project := range projects {
-		projectResponses[i] = responses.ProjectResponse{
-			ID:        project.ID,
-			Name:      project.Name,
-			OwnerID:   project.OwnerID,
-			CreatedAt: project.CreatedAt.Format(time.RFC3339),
-		}
+		projectResponses[i] = responses.NewProjectResponse(project)
 	}
 
 	c.JSON(http.StatusOK, datatransfers.ResponseSuccess("Projects fetched successfully", projectResponses))
 }
 
+func (h *projectHandler) UpdateProjectHandler(c *gin.Context) {
+	projectID := c.Param("project_id")
+
+	var requestData requests.ProjectUpdateRequest
+	if err := c.ShouldBindJSON(&requestData); err != nil {
+		c.JSON(http.StatusBadRequest, datatransfers.ResponseError("Invalid request data"))
+		return
+	}
+
+	if err := validation.Validate(requestData); err != nil {
+		c.JSON(http.StatusBadRequest, datatransfers.ResponseError(err.Error()))
+		return
+	}
+
+	project := &domain.Project{
+		ID: projectID,
+	}
+
+	if requestData.Name != nil {
+		project.Name = *requestData.Name
+	}
+
+	if requestData.Key != nil {
+		project.Key = *requestData.Key
+	}
+
+	if requestData.WipEnforcement != nil {
+		project.WipEnforcement = domain.WipEnforcement(*requestData.WipEnforcement)
+	}
+
+	if requestData.LaneGrouping != nil {
+		project.LaneGrouping = domain.LaneGrouping(*requestData.LaneGrouping)
+	}
+
+	if requestData.BlockerEnforcement != nil {
+		project.BlockerEnforcement = domain.BlockerEnforcement(*requestData.BlockerEnforcement)
+	}
+
+	err := h.projectService.UpdateProject(c.Request.Context(), project)
+	if errors.Is(err, domain.ErrProjectKeyTaken) {
+		c.JSON(http.StatusConflict, datatransfers.ResponseError(err.Error()))
+		return
+	}
+
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, datatransfers.ResponseError("Failed to update project"))
+		return
+	}
+
+	updatedProject, err := h.projectService.GetProjectByID(c.Request.Context(), projectID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, datatransfers.ResponseError("Failed to get project"))
+		return
+	}
+
+	responseData := responses.NewProjectResponse(updatedProject)
+
+	c.JSON(http.StatusOK, datatransfers.ResponseSuccess("Project updated successfully", responseData))
+}
+
 func (h *projectHandler) DeleteProjectHandler(c *gin.Context) {
 	projectID := c.Param("project_id")
 
@@ -206,12 +292,5 @@ func (h *projectHandler) DeleteProjectHandler(c *gin.Context) {
 		return
 	}
 
-	h.hub.SendMessageToProject(projectID, ws.BaseResponse{
-		Name: ws.EventNameProjectDeleted,
-		Data: map[string]interface{}{
-			"id": projectID,
-		},
-	})
-
 	c.JSON(http.StatusOK, datatransfers.ResponseSuccess("Project deleted successfully", nil))
 }
@@ -1,6 +1,7 @@
 package http
 
 import (
+	"errors"
 	"net/http"
 
 	"github.com/fatihsen-dev/kanban-backend/internal/adapters/driver/http/datatransfers"
@@ -8,7 +9,6 @@ import (
 	"github.com/fatihsen-dev/kanban-backend/internal/adapters/driver/http/datatransfers/responses"
 	middlewares "github.com/fatihsen-dev/kanban-backend/internal/adapters/driver/http/middleware"
 	"github.com/fatihsen-dev/kanban-backend/internal/adapters/driver/validation"
-	"github.com/fatihsen-dev/kanban-backend/internal/adapters/driver/ws"
 	"github.com/fatihsen-dev/kanban-backend/internal/core/domain"
 	ports "github.com/fatihsen-dev/kanban-backend/internal/core/ports/driver"
 	"github.com/gin-gonic/gin"
@@ -18,11 +18,11 @@ type projectMemberHandler struct {
 	projectMemberService   ports.ProjectMemberService
 	authMiddleware         *middlewares.AuthnMiddleware
 	projectAuthzMiddleware *middlewares.ProjectAuthzMiddleware
-	hub                    *ws.Hub
+	presenceTracker        ports.PresenceTracker
 }
 
-func NewProjectMemberHandler(projectMemberService ports.ProjectMemberService, authMiddleware *middlewares.AuthnMiddleware, projectAuthzMiddleware *middlewares.ProjectAuthzMiddleware, hub *ws.Hub) *projectMemberHandler {
-	return &projectMemberHandler{projectMemberService: projectMemberService, authMiddleware: authMiddleware, projectAuthzMiddleware: projectAuthzMiddleware, hub: hub}
+func NewProjectMemberHandler(projectMemberService ports.ProjectMemberService, authMiddleware *middlewares.AuthnMiddleware, projectAuthzMiddleware *middlewares.ProjectAuthzMiddleware, presenceTracker ports.PresenceTracker) *projectMemberHandler {
+	return &projectMemberHandler{projectMemberService: projectMemberService, authMiddleware: authMiddleware, projectAuthzMiddleware: projectAuthzMiddleware, presenceTracker: presenceTracker}
 }
 
 func (h *projectMemberHandler) RegisterProjectMemberRouter(r *gin.Engine) {
@@ -30,9 +30,9 @@ func (h *projectMemberHandler) RegisterProjectMemberRouter(r *gin.Engine) {
 	projectMemberGroup := r.Group("/projects/:project_id/members")
 
 	projectMemberGroup.Use(h.authMiddleware.Handle(false))
-	projectMemberGroup.GET("/", h.projectAuthzMiddleware.Handle(middlewares.Member), h.GetProjectMembersHandler)
-	projectMemberGroup.PUT("/:member_id", h.projectAuthzMiddleware.Handle(middlewares.Owner), h.UpdateProjectMemberHandler)
-	projectMemberGroup.GET("/online", h.projectAuthzMiddleware.Handle(middlewares.Member), h.GetOnlineProjectMembersHandler)
+	projectMemberGroup.GET("/", h.projectAuthzMiddleware.Handle(domain.PermissionProjectView), h.GetProjectMembersHandler)
+	projectMemberGroup.PUT("/:member_id", h.projectAuthzMiddleware.Handle(domain.PermissionMemberManage), h.UpdateProjectMemberHandler)
+	projectMemberGroup.GET("/online", h.projectAuthzMiddleware.Handle(domain.PermissionProjectView), h.GetOnlineProjectMembersHandler)
 }
 
 func (h *projectMemberHandler) GetProjectMembersHandler(c *gin.Context) {
@@ -58,6 +58,7 @@ func (h *projectMemberHandler) GetProjectMembersHandler(c *gin.Context) {
 			ProjectID: member.ProjectID,
 			UserID:    member.UserID,
 			Role:      string(member.Role),
+			RoleID:    member.RoleID,
 			TeamID:    member.TeamID,
 			CreatedAt: member.CreatedAt.String(),
 			User: responses.UserResponse{
@@ -88,7 +89,8 @@ func (h *projectMemberHandler) UpdateProjectMemberHandler(c *gin.Context) {
 	}
 
 	member := &domain.ProjectMember{
-		ID: memberID,
+		ID:        memberID,
+		ProjectID: projectID,
 	}
 
 	response := responses.UpdateProjectMemberResponse{
@@ -105,23 +107,32 @@ func (h *projectMemberHandler) UpdateProjectMemberHandler(c *gin.Context) {
 		response.TeamID = *requestData.TeamID
 	}
 
-	err := h.projectMemberService.UpdateProjectMember(c.Request.Context(), member)
+	if requestData.RoleID != nil {
+		member.RoleID = requestData.RoleID
+		response.RoleID = requestData.RoleID
+	}
+
+	access := c.MustGet("project_access").(*domain.ProjectAccess)
+	err := h.projectMemberService.UpdateProjectMember(c.Request.Context(), member, access)
+	if errors.Is(err, domain.ErrProjectMemberNotFound) {
+		c.JSON(http.StatusNotFound, datatransfers.ResponseError(err.Error()))
+		return
+	}
+	if errors.Is(err, domain.ErrRoleExceedsPermissions) || errors.Is(err, domain.ErrProjectOwnerMember) {
+		c.JSON(http.StatusForbidden, datatransfers.ResponseError(err.Error()))
+		return
+	}
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, datatransfers.ResponseError(err.Error()))
 		return
 	}
 
-	h.hub.SendMessageToProject(projectID, ws.BaseResponse{
-		Name: ws.EventNameProjectMemberUpdated,
-		Data: response,
-	})
-
 	c.JSON(http.StatusOK, datatransfers.ResponseSuccess("Project member updated successfully", response))
 }
 
 func (h *projectMemberHandler) GetOnlineProjectMembersHandler(c *gin.Context) {
 	projectID := c.Param("project_id")
-	onlineUsers := h.hub.GetOnlineUsers(projectID)
+	onlineUsers := h.presenceTracker.GetOnlineUsers(projectID)
 
 	c.JSON(http.StatusOK, datatransfers.ResponseSuccess("Online project members fetched successfully", onlineUsers))
 }
@@ -0,0 +1,187 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/fatihsen-dev/kanban-backend/internal/adapters/driver/http/datatransfers"
+	"github.com/fatihsen-dev/kanban-backend/internal/adapters/driver/http/datatransfers/requests"
+	"github.com/fatihsen-dev/kanban-backend/internal/adapters/driver/http/datatransfers/responses"
+	middlewares "github.com/fatihsen-dev/kanban-backend/internal/adapters/driver/http/middleware"
+	"github.com/fatihsen-dev/kanban-backend/internal/adapters/driver/validation"
+	"github.com/fatihsen-dev/kanban-backend/internal/core/domain"
+	ports "github.com/fatihsen-dev/kanban-backend/internal/core/ports/driver"
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+type projectRoleHandler struct {
+	projectRoleService     ports.ProjectRoleService
+	authMiddleware         *middlewares.AuthnMiddleware
+	projectAuthzMiddleware *middlewares.ProjectAuthzMiddleware
+}
+
+func NewProjectRoleHandler(projectRoleService ports.ProjectRoleService, authMiddleware *middlewares.AuthnMiddleware, projectAuthzMiddleware *middlewares.ProjectAuthzMiddleware) *projectRoleHandler {
+	return &projectRoleHandler{projectRoleService: projectRoleService, authMiddleware: authMiddleware, projectAuthzMiddleware: projectAuthzMiddleware}
+}
+
+func (h *projectRoleHandler) RegisterProjectRoleRouter(r *gin.Engine) {
+	roleGroup := r.Group("/projects/:project_id/roles")
+
+	roleGroup.Use(h.authMiddleware.Handle(false))
+
+	roleGroup.POST("", h.projectAuthzMiddleware.Handle(domain.PermissionRoleManage), h.CreateProjectRoleHandler)
+	roleGroup.GET("", h.projectAuthzMiddleware.Handle(domain.PermissionProjectView), h.GetProjectRolesHandler)
+	roleGroup.PUT("/:role_id", h.projectAuthzMiddleware.Handle(domain.PermissionRoleManage), h.UpdateProjectRoleHandler)
+	roleGroup.DELETE("/:role_id", h.projectAuthzMiddleware.Handle(domain.PermissionRoleManage), h.DeleteProjectRoleHandler)
+}
+
+func (h *projectRoleHandler) CreateProjectRoleHandler(c *gin.Context) {
+	var requestData requests.CreateProjectRoleRequest
+	if err := c.ShouldBindJSON(&requestData); err != nil {
+		c.JSON(http.StatusBadRequest, datatransfers.ResponseError("Invalid request data"))
+		return
+	}
+
+	requestData.ProjectID = c.Param("project_id")
+	if err := validation.Validate(requestData); err != nil {
+		c.JSON(http.StatusBadRequest, datatransfers.ResponseError(err.Error()))
+		return
+	}
+
+	role := &domain.ProjectRole{
+		Name:        requestData.Name,
+		ProjectID:   requestData.ProjectID,
+		Permissions: toDomainPermissions(requestData.Permissions),
+	}
+
+	access := c.MustGet("project_access").(*domain.ProjectAccess)
+	if !access.CanGrant(role.Permissions) {
+		c.JSON(http.StatusForbidden, datatransfers.ResponseError(domain.ErrRoleExceedsPermissions.Error()))
+		return
+	}
+
+	err := h.projectRoleService.CreateProjectRole(c.Request.Context(), role)
+	if err != nil {
+		zap.L().Error("Failed to create project role", zap.Error(err))
+		c.JSON(http.StatusInternalServerError, datatransfers.ResponseError("Failed to create role"))
+		return
+	}
+
+	responseData := responses.NewProjectRoleResponse(role)
+
+	c.JSON(http.StatusCreated, datatransfers.ResponseSuccess("Role created successfully", responseData))
+}
+
+func (h *projectRoleHandler) GetProjectRolesHandler(c *gin.Context) {
+	projectID := c.Param("project_id")
+
+	roles, err := h.projectRoleService.GetProjectRolesByProjectID(c.Request.Context(), projectID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, datatransfers.ResponseError("Failed to get roles"))
+		return
+	}
+
+	responseData := make([]responses.ProjectRoleResponse, len(roles))
+	for i, role := range roles {
+		responseData[i] = responses.NewProjectRoleResponse(role)
+	}
+
+	c.JSON(http.StatusOK, datatransfers.ResponseSuccess("Roles fetched successfully", responseData))
+}
+
+func (h *projectRoleHandler) UpdateProjectRoleHandler(c *gin.Context) {
+	roleID := c.Param("role_id")
+	projectID := c.Param("project_id")
+
+	err := validation.ValidateUUID(roleID)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, datatransfers.ResponseError("Invalid role ID"))
+		return
+	}
+
+	var requestData requests.UpdateProjectRoleRequest
+	if err := c.ShouldBindJSON(&requestData); err != nil {
+		c.JSON(http.StatusBadRequest, datatransfers.ResponseError("Invalid request data"))
+		return
+	}
+
+	if err := validation.Validate(requestData); err != nil {
+		c.JSON(http.StatusBadRequest, datatransfers.ResponseError(err.Error()))
+		return
+	}
+
+	existingRole, err := h.projectRoleService.GetProjectRoleByID(c.Request.Context(), roleID)
+	if err != nil || existingRole.ProjectID != projectID {
+		c.JSON(http.StatusNotFound, datatransfers.ResponseError("Role not found"))
+		return
+	}
+
+	role := &domain.ProjectRole{
+		ID: roleID,
+	}
+
+	responseData := responses.UpdateProjectRoleResponse{
+		ID: roleID,
+	}
+
+	if requestData.Name != nil {
+		role.Name = *requestData.Name
+		responseData.Name = role.Name
+	}
+
+	if requestData.Permissions != nil {
+		role.Permissions = toDomainPermissions(requestData.Permissions)
+		responseData.Permissions = requestData.Permissions
+
+		access := c.MustGet("project_access").(*domain.ProjectAccess)
+		if !access.CanGrant(role.Permissions) {
+			c.JSON(http.StatusForbidden, datatransfers.ResponseError(domain.ErrRoleExceedsPermissions.Error()))
+			return
+		}
+	}
+
+	err = h.projectRoleService.UpdateProjectRole(c.Request.Context(), role)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, datatransfers.ResponseError("Failed to update role"))
+		return
+	}
+
+	c.JSON(http.StatusOK, datatransfers.ResponseSuccess("Role updated successfully", responseData))
+}
+
+func (h *projectRoleHandler) DeleteProjectRoleHandler(c *gin.Context) {
+	roleID := c.Param("role_id")
+	projectID := c.Param("project_id")
+
+	err := validation.ValidateUUID(roleID)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, datatransfers.ResponseError("Invalid role ID"))
+		return
+	}
+
+	role, err := h.projectRoleService.GetProjectRoleByID(c.Request.Context(), roleID)
+	if err != nil || role.ProjectID != projectID {
+		c.JSON(http.StatusNotFound, datatransfers.ResponseError("Role not found"))
+		return
+	}
+
+	err = h.projectRoleService.DeleteProjectRoleByID(c.Request.Context(), roleID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, datatransfers.ResponseError("Failed to delete role"))
+		return
+	}
+
+	responseData := responses.DeleteProjectRoleResponse{
+		ID: roleID,
+	}
+
+	c.JSON(http.StatusOK, datatransfers.ResponseSuccess("Role deleted successfully", responseData))
+}
+
+func toDomainPermissions(values []string) []domain.Permission {
+	permissions := make([]domain.Permission, len(values))
+	for i, value := range values {
+		permissions[i] = domain.Permission(value)
+	}
+	return permissions
+}
@@ -0,0 +1,260 @@
+package http
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/fatihsen-dev/kanban-backend/internal/adapters/driver/http/datatransfers"
+	"github.com/fatihsen-dev/kanban-backend/internal/adapters/driver/http/datatransfers/requests"
+	"github.com/fatihsen-dev/kanban-backend/internal/adapters/driver/http/datatransfers/responses"
+	middlewares "github.com/fatihsen-dev/kanban-backend/internal/adapters/driver/http/middleware"
+	"github.com/fatihsen-dev/kanban-backend/internal/adapters/driver/validation"
+	"github.com/fatihsen-dev/kanban-backend/internal/core/domain"
+	ports "github.com/fatihsen-dev/kanban-backend/internal/core/ports/driver"
+	"github.com/fatihsen-dev/kanban-backend/pkg/jwt"
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+type savedViewHandler struct {
+	savedViewService       ports.SavedViewService
+	authMiddleware         *middlewares.AuthnMiddleware
+	projectAuthzMiddleware *middlewares.ProjectAuthzMiddleware
+}
+
+func NewSavedViewHandler(savedViewService ports.SavedViewService, authMiddleware *middlewares.AuthnMiddleware, projectAuthzMiddleware *middlewares.ProjectAuthzMiddleware) *savedViewHandler {
+	return &savedViewHandler{savedViewService: savedViewService, authMiddleware: authMiddleware, projectAuthzMiddleware: projectAuthzMiddleware}
+}
+
+func (h *savedViewHandler) RegisterSavedViewRouter(r *gin.Engine) {
+	viewGroup := r.Group("/projects/:project_id/views")
+
+	viewGroup.Use(h.authMiddleware.Handle(false), h.projectAuthzMiddleware.Handle(domain.PermissionProjectView))
+
+	viewGroup.POST("", h.CreateViewHandler)
+	viewGroup.GET("", h.GetViewsHandler)
+	viewGroup.GET("/:view_id", h.GetViewHandler)
+	viewGroup.GET("/:view_id/board", h.ExecuteViewHandler)
+	viewGroup.PUT("/:view_id", h.UpdateViewHandler)
+	viewGroup.DELETE("/:view_id", h.DeleteViewHandler)
+}
+
+func (h *savedViewHandler) CreateViewHandler(c *gin.Context) {
+	projectID := c.Param("project_id")
+
+	var requestData requests.CreateSavedViewRequest
+	if err := c.ShouldBindJSON(&requestData); err != nil {
+		c.JSON(http.StatusBadRequest, datatransfers.ResponseError("Invalid request data"))
+		return
+	}
+
+	if err := validation.Validate(requestData); err != nil {
+		c.JSON(http.StatusBadRequest, datatransfers.ResponseError(err.Error()))
+		return
+	}
+
+	userClaims := c.MustGet("user").(*jwt.UserClaims)
+	access := c.MustGet("project_access").(*domain.ProjectAccess)
+
+	view := &domain.SavedView{
+		ProjectID:     projectID,
+		OwnerID:       userClaims.ID,
+		Name:          requestData.Name,
+		Visibility:    domain.SavedViewVisibility(requestData.Visibility),
+		Filter:        newSavedViewFilter(projectID, &requestData.Filter),
+		Grouping:      domain.LaneGrouping(requestData.Grouping),
+		VisibleFields: requestData.VisibleFields,
+	}
+
+	if view.IsShared() && !access.Can(domain.PermissionProjectManage) {
+		c.JSON(http.StatusForbidden, datatransfers.ResponseError("You are not authorized to share views with the project"))
+		return
+	}
+
+	err := h.savedViewService.CreateView(c.Request.Context(), view)
+	if err != nil {
+		zap.L().Error("Failed to create view", zap.Error(err))
+		c.JSON(http.StatusInternalServerError, datatransfers.ResponseError("Failed to create view"))
+		return
+	}
+
+	responseData := responses.NewSavedViewResponse(view)
+
+	c.JSON(http.StatusCreated, datatransfers.ResponseSuccess("View created successfully", responseData))
+}
+
+func (h *savedViewHandler) GetViewsHandler(c *gin.Context) {
+	projectID := c.Param("project_id")
+	userClaims := c.MustGet("user").(*jwt.UserClaims)
+
+	views, err := h.savedViewService.GetVisibleViews(c.Request.Context(), projectID, userClaims.ID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, datatransfers.ResponseError("Failed to get views"))
+		return
+	}
+
+	responseData := make([]responses.SavedViewResponse, len(views))
+	for i, view := range views {
+		responseData[i] = responses.NewSavedViewResponse(view)
+	}
+
+	c.JSON(http.StatusOK, datatransfers.ResponseSuccess("Views fetched successfully", responseData))
+}
+
+func (h *savedViewHandler) GetViewHandler(c *gin.Context) {
+	view, ok := h.getVisibleView(c)
+	if !ok {
+		return
+	}
+
+	c.JSON(http.StatusOK, datatransfers.ResponseSuccess("View fetched successfully", responses.NewSavedViewResponse(view)))
+}
+
+func (h *savedViewHandler) ExecuteViewHandler(c *gin.Context) {
+	view, ok := h.getVisibleView(c)
+	if !ok {
+		return
+	}
+
+	userClaims := c.MustGet("user").(*jwt.UserClaims)
+
+	details, err := h.savedViewService.ExecuteView(c.Request.Context(), view, userClaims.ID)
+	if errors.Is(err, domain.ErrInvalidCustomFieldValue) {
+		c.JSON(http.StatusBadRequest, datatransfers.ResponseError(err.Error()))
+		return
+	}
+
+	if err != nil {
+		zap.L().Error("Failed to execute view", zap.Error(err))
+		c.JSON(http.StatusInternalServerError, datatransfers.ResponseError("Failed to execute view"))
+		return
+	}
+
+	viewResponse := responses.NewSavedViewResponse(view)
+
+	responseData := newProjectDetailsResponse(details)
+	responseData.View = &viewResponse
+
+	c.JSON(http.StatusOK, datatransfers.ResponseSuccess("View executed successfully", responseData))
+}
+
+func (h *savedViewHandler) UpdateViewHandler(c *gin.Context) {
+	projectID := c.Param("project_id")
+
+	var requestData requests.UpdateSavedViewRequest
+	if err := c.ShouldBindJSON(&requestData); err != nil {
+		c.JSON(http.StatusBadRequest, datatransfers.ResponseError("Invalid request data"))
+		return
+	}
+
+	if err := validation.Validate(requestData); err != nil {
+		c.JSON(http.StatusBadRequest, datatransfers.ResponseError(err.Error()))
+		return
+	}
+
+	view, ok := h.getEditableView(c)
+	if !ok {
+		return
+	}
+
+	wasShared := view.IsShared()
+
+	if requestData.Name != nil {
+		view.Name = *requestData.Name
+	}
+
+	if requestData.Visibility != nil {
+		view.Visibility = domain.SavedViewVisibility(*requestData.Visibility)
+	}
+
+	if requestData.Filter != nil {
+		view.Filter = newSavedViewFilter(projectID, requestData.Filter)
+	}
+
+	if requestData.Grouping != nil {
+		view.Grouping = domain.LaneGrouping(*requestData.Grouping)
+	}
+
+	if requestData.VisibleFields != nil {
+		view.VisibleFields = requestData.VisibleFields
+	}
+
+	access := c.MustGet("project_access").(*domain.ProjectAccess)
+	if view.IsShared() && !wasShared && !access.Can(domain.PermissionProjectManage) {
+		c.JSON(http.StatusForbidden, datatransfers.ResponseError("You are not authorized to share views with the project"))
+		return
+	}
+
+	err := h.savedViewService.UpdateView(c.Request.Context(), view)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, datatransfers.ResponseError("Failed to update view"))
+		return
+	}
+
+	responseData := responses.NewSavedViewResponse(view)
+
+	c.JSON(http.StatusOK, datatransfers.ResponseSuccess("View updated successfully", responseData))
+}
+
+func (h *savedViewHandler) DeleteViewHandler(c *gin.Context) {
+	view, ok := h.getEditableView(c)
+	if !ok {
+		return
+	}
+
+	err := h.savedViewService.DeleteViewByID(c.Request.Context(), view.ID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, datatransfers.ResponseError("Failed to delete view"))
+		return
+	}
+
+	responseData := responses.SavedViewDeleteResponse{
+		ID: view.ID,
+	}
+
+	c.JSON(http.StatusOK, datatransfers.ResponseSuccess("View deleted successfully", responseData))
+}
+
+func (h *savedViewHandler) getVisibleView(c *gin.Context) (*domain.SavedView, bool) {
+	viewID := c.Param("view_id")
+
+	err := validation.ValidateUUID(viewID)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, datatransfers.ResponseError("Invalid view ID"))
+		return nil, false
+	}
+
+	userClaims := c.MustGet("user").(*jwt.UserClaims)
+
+	view, err := h.savedViewService.GetViewByID(c.Request.Context(), viewID)
+	if err != nil || view.ProjectID != c.Param("project_id") || !view.CanView(userClaims.ID) {
+		c.JSON(http.StatusNotFound, datatransfers.ResponseError("View not found"))
+		return nil, false
+	}
+
+	return view, true
+}
+
+func (h *savedViewHandler) getEditableView(c *gin.Context) (*domain.SavedView, bool) {
+	view, ok := h.getVisibleView(c)
+	if !ok {
+		return nil, false
+	}
+
+	userClaims := c.MustGet("user").(*jwt.UserClaims)
+	access := c.MustGet("project_access").(*domain.ProjectAccess)
+
+	if !view.CanEdit(userClaims.ID, access) {
+		c.JSON(http.StatusForbidden, datatransfers.ResponseError("You are not authorized to change this view"))
+		return nil, false
+	}
+
+	return view, true
+}
+
+func newSavedViewFilter(projectID string, requestData *requests.TaskListRequest) *domain.TaskFilter {
+	filter := newTaskFilter(projectID, requestData)
+	filter.Limit = 0
+	filter.Cursor = ""
+	return filter
+}
@@ -0,0 +1,67 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/fatihsen-dev/kanban-backend/internal/adapters/driver/http/datatransfers"
+	"github.com/fatihsen-dev/kanban-backend/internal/adapters/driver/http/datatransfers/requests"
+	"github.com/fatihsen-dev/kanban-backend/internal/adapters/driver/http/datatransfers/responses"
+	middlewares "github.com/fatihsen-dev/kanban-backend/internal/adapters/driver/http/middleware"
+	"github.com/fatihsen-dev/kanban-backend/internal/adapters/driver/validation"
+	"github.com/fatihsen-dev/kanban-backend/internal/core/domain"
+	ports "github.com/fatihsen-dev/kanban-backend/internal/core/ports/driver"
+	"github.com/fatihsen-dev/kanban-backend/pkg/jwt"
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+type searchHandler struct {
+	searchService  ports.SearchService
+	authMiddleware *middlewares.AuthnMiddleware
+}
+
+func NewSearchHandler(searchService ports.SearchService, authMiddleware *middlewares.AuthnMiddleware) *searchHandler {
+	return &searchHandler{searchService: searchService, authMiddleware: authMiddleware}
+}
+
+func (h *searchHandler) RegisterSearchRouter(r *gin.Engine) {
+	r.GET("/search", h.authMiddleware.Handle(false), h.SearchHandler)
+}
+
+func (h *searchHandler) SearchHandler(c *gin.Context) {
+	var requestData requests.SearchRequest
+	if err := c.ShouldBindQuery(&requestData); err != nil {
+		c.JSON(http.StatusBadRequest, datatransfers.ResponseError("Invalid query parameters"))
+		return
+	}
+
+	if err := validation.Validate(requestData); err != nil {
+		c.JSON(http.StatusBadRequest, datatransfers.ResponseError(err.Error()))
+		return
+	}
+
+	userClaims := c.MustGet("user").(*jwt.UserClaims)
+
+	query := &domain.SearchQuery{
+		Text:  requestData.Query,
+		Limit: requestData.Limit,
+	}
+
+	for _, resultType := range requestData.Type {
+		query.Types = append(query.Types, domain.SearchResultType(resultType))
+	}
+
+	results, err := h.searchService.Search(c.Request.Context(), userClaims.ID, query)
+	if err != nil {
+		zap.L().Error("Failed to search", zap.Error(err))
+		c.JSON(http.StatusInternalServerError, datatransfers.ResponseError("Failed to search"))
+		return
+	}
+
+	responseData := make([]responses.SearchResultResponse, len(results))
+	for i, result := range results {
+		responseData[i] = responses.NewSearchResultResponse(result)
+	}
+
+	c.JSON(http.StatusOK, datatransfers.ResponseSuccess("Search results fetched successfully", responseData))
+}
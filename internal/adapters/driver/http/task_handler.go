@@ -1,8 +1,10 @@
 package http
 
 import (
+	"errors"
 	"fmt"
 	"net/http"
+	"strings"
 	"time"
 
 	"github.com/fatihsen-dev/kanban-backend/internal/adapters/driver/http/datatransfers"
@@ -10,9 +12,9 @@ import (
 	"github.com/fatihsen-dev/kanban-backend/internal/adapters/driver/http/datatransfers/responses"
 	middlewares "github.com/fatihsen-dev/kanban-backend/internal/adapters/driver/http/middleware"
 	"github.com/fatihsen-dev/kanban-backend/internal/adapters/driver/validation"
-	"github.com/fatihsen-dev/kanban-backend/internal/adapters/driver/ws"
 	"github.com/fatihsen-dev/kanban-backend/internal/core/domain"
 	ports "github.com/fatihsen-dev/kanban-backend/internal/core/ports/driver"
+	"github.com/fatihsen-dev/kanban-backend/pkg/jwt"
 	"github.com/gin-gonic/gin"
 )
 
@@ -20,24 +22,35 @@ type taskHandler struct {
 	taskService            ports.TaskService
 	authMiddleware         *middlewares.AuthnMiddleware
 	projectAuthzMiddleware *middlewares.ProjectAuthzMiddleware
-	hub                    *ws.Hub
+	taskKeyMiddleware      *middlewares.TaskKeyMiddleware
 }
 
-func NewTaskHandler(taskService ports.TaskService, authMiddleware *middlewares.AuthnMiddleware, projectAuthzMiddleware *middlewares.ProjectAuthzMiddleware, hub *ws.Hub) *taskHandler {
-	return &taskHandler{taskService: taskService, authMiddleware: authMiddleware, projectAuthzMiddleware: projectAuthzMiddleware, hub: hub}
+func NewTaskHandler(taskService ports.TaskService, authMiddleware *middlewares.AuthnMiddleware, projectAuthzMiddleware *middlewares.ProjectAuthzMiddleware, taskKeyMiddleware *middlewares.TaskKeyMiddleware) *taskHandler {
+	return &taskHandler{taskService: taskService, authMiddleware: authMiddleware, projectAuthzMiddleware: projectAuthzMiddleware, taskKeyMiddleware: taskKeyMiddleware}
 }
 
 func (h *taskHandler) RegisterTaskRouter(r *gin.Engine) {
+	r.GET("/tasks/by-key/:key",
+		h.authMiddleware.Handle(false),
+		h.taskKeyMiddleware.Handle("key"),
+		h.projectAuthzMiddleware.Handle(domain.PermissionProjectView),
+		h.GetTaskHandler,
+	)
 
 	taskGroup := r.Group("/projects/:project_id/tasks")
 
-	taskGroup.Use(h.authMiddleware.Handle(false))
-
-	taskGroup.POST("", h.projectAuthzMiddleware.Handle(middlewares.Member), h.CreateTaskHandler)
-	taskGroup.GET("", h.projectAuthzMiddleware.Handle(middlewares.Member), h.GetTasksHandler)
-	taskGroup.GET("/:task_id", h.projectAuthzMiddleware.Handle(middlewares.Member), h.GetTaskHandler)
-	taskGroup.PUT("/:task_id", h.projectAuthzMiddleware.Handle(middlewares.Member), h.UpdateTaskHandler)
-	taskGroup.DELETE("/:task_id", h.projectAuthzMiddleware.Handle(middlewares.Member), h.DeleteTaskHandler)
+	taskGroup.Use(h.authMiddleware.Handle(false), h.taskKeyMiddleware.Handle("task_id"))
+
+	taskGroup.POST("", h.projectAuthzMiddleware.Handle(domain.PermissionTaskCreate), h.CreateTaskHandler)
+	taskGroup.GET("", h.projectAuthzMiddleware.Handle(domain.PermissionProjectView), h.GetTasksHandler)
+	taskGroup.GET("/archived", h.projectAuthzMiddleware.Handle(domain.PermissionProjectView), h.GetArchivedTasksHandler)
+	taskGroup.GET("/:task_id", h.projectAuthzMiddleware.Handle(domain.PermissionProjectView), h.GetTaskHandler)
+	taskGroup.PUT("/:task_id", h.projectAuthzMiddleware.Handle(domain.PermissionTaskUpdate), h.UpdateTaskHandler)
+	taskGroup.DELETE("/:task_id", h.projectAuthzMiddleware.Handle(domain.PermissionTaskDelete), h.DeleteTaskHandler)
+	taskGroup.POST("/:task_id/archive", h.projectAuthzMiddleware.Handle(domain.PermissionTaskDelete), h.ArchiveTaskHandler)
+	taskGroup.POST("/:task_id/restore", h.projectAuthzMiddleware.Handle(domain.PermissionTaskDelete), h.RestoreTaskHandler)
+	taskGroup.POST("/:task_id/watch", h.projectAuthzMiddleware.Handle(domain.PermissionProjectView), h.WatchTaskHandler)
+	taskGroup.DELETE("/:task_id/watch", h.projectAuthzMiddleware.Handle(domain.PermissionProjectView), h.UnwatchTaskHandler)
 }
 
 func (h *taskHandler) CreateTaskHandler(c *gin.Context) {
@@ -45,26 +58,46 @@ func (h *taskHandler) CreateTaskHandler(c *gin.Context) {
 
 	var requestData requests.TaskCreateRequest
 
-	requestData.ProjectID = projectID
-
 	if err := c.ShouldBindJSON(&requestData); err != nil {
 		c.JSON(http.StatusBadRequest, datatransfers.ResponseError("Invalid request data"))
 		return
 	}
 
+	requestData.ProjectID = projectID
+
 	if err := validation.Validate(requestData); err != nil {
 		c.JSON(http.StatusBadRequest, datatransfers.ResponseError(err.Error()))
 		return
 	}
 
 	task := &domain.Task{
-		Title:     requestData.Title,
-		Content:   requestData.Content,
-		ProjectID: requestData.ProjectID,
-		ColumnID:  requestData.ColumnID,
+		Title:      requestData.Title,
+		Content:    requestData.Content,
+		ProjectID:  requestData.ProjectID,
+		ColumnID:   requestData.ColumnID,
+		LaneID:     requestData.LaneID,
+		AssigneeID: requestData.AssigneeID,
+		Labels:     requestData.Labels,
 	}
 
-	err := h.taskService.CreateTask(c.Request.Context(), task)
+	if requestData.Priority != nil {
+		task.Priority = domain.TaskPriority(*requestData.Priority)
+	}
+
+	task.Estimate = requestData.Estimate
+	task.CustomFields = requestData.CustomFields
+
+	if requestData.DueDate != nil {
+		dueDate, _ := time.Parse(time.RFC3339, *requestData.DueDate)
+		task.DueDate = &dueDate
+	}
+
+	result, err := h.taskService.CreateTask(c.Request.Context(), task)
+
+	if status := taskServiceErrorStatus(err); status != 0 {
+		c.JSON(status, datatransfers.ResponseError(err.Error()))
+		return
+	}
 
 	if err != nil {
 		fmt.Println(err)
@@ -72,19 +105,12 @@ func (h *taskHandler) CreateTaskHandler(c *gin.Context) {
 		return
 	}
 
-	responseData := responses.TaskResponse{
-		ID:        task.ID,
-		Title:     task.Title,
-		Content:   task.Content,
-		ProjectID: task.ProjectID,
-		ColumnID:  task.ColumnID,
-		CreatedAt: task.CreatedAt.Format(time.RFC3339),
+	if len(result.Automations) > 0 {
+		task = result.Automations[len(result.Automations)-1].Task
 	}
 
-	h.hub.SendMessageToProject(task.ProjectID, ws.BaseResponse{
-		Name: ws.EventNameTaskCreated,
-		Data: responseData,
-	})
+	responseData := responses.NewTaskResponse(task)
+	responseData.ColumnLoad = responses.NewColumnLoadResponse(result.Load)
 
 	c.JSON(http.StatusCreated, datatransfers.ResponseSuccess("Task created successfully", responseData))
 }
@@ -98,44 +124,90 @@ func (h *taskHandler) GetTaskHandler(c *gin.Context) {
 		return
 	}
 
-	task, err := h.taskService.GetTaskByID(c.Request.Context(), id)
+	task, err := h.taskService.GetTaskByID(c.Request.Context(), c.Param("project_id"), id)
 	if err != nil {
 		c.JSON(http.StatusNotFound, datatransfers.ResponseError("Task not found"))
 		return
 	}
 
-	responseData := responses.TaskResponse{
-		ID:        task.ID,
-		Title:     task.Title,
-		Content:   task.Content,
-		ProjectID: task.ProjectID,
-		ColumnID:  task.ColumnID,
-		CreatedAt: task.CreatedAt.Format(time.RFC3339),
-	}
+	responseData := responses.NewTaskResponse(task)
 
 	c.JSON(http.StatusOK, datatransfers.ResponseSuccess("Task fetched successfully", responseData))
 }
 
 func (h *taskHandler) GetTasksHandler(c *gin.Context) {
-	tasks, err := h.taskService.GetTasks(c.Request.Context())
+	var requestData requests.TaskListRequest
+	if err := c.ShouldBindQuery(&requestData); err != nil {
+		c.JSON(http.StatusBadRequest, datatransfers.ResponseError("Invalid query parameters"))
+		return
+	}
+
+	requestData.CustomFields = c.QueryMap("cf")
+
+	if err := validation.Validate(requestData); err != nil {
+		c.JSON(http.StatusBadRequest, datatransfers.ResponseError(err.Error()))
+		return
+	}
+
+	userClaims := c.MustGet("user").(*jwt.UserClaims)
+
+	filter := newTaskFilter(c.Param("project_id"), &requestData).ForUser(userClaims.ID)
+
+	page, err := h.taskService.QueryTasks(c.Request.Context(), filter)
+	if errors.Is(err, domain.ErrInvalidCustomFieldValue) || errors.Is(err, domain.ErrInvalidTaskCursor) {
+		c.JSON(http.StatusBadRequest, datatransfers.ResponseError(err.Error()))
+		return
+	}
+
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, datatransfers.ResponseError("Failed to get tasks"))
 		return
 	}
 
-	responseData := make([]responses.TaskResponse, len(tasks))
-	for i, task := range tasks {
-		responseData[i] = responses.TaskResponse{
-			ID:        task.ID,
-			Title:     task.Title,
-			Content:   task.Content,
-			ProjectID: task.ProjectID,
-			ColumnID:  task.ColumnID,
-			CreatedAt: task.CreatedAt.Format(time.RFC3339),
+	c.JSON(http.StatusOK, datatransfers.ResponseSuccess("Tasks fetched successfully", responses.NewTaskPageResponse(page)))
+}
+
+func newTaskFilter(projectID string, requestData *requests.TaskListRequest) *domain.TaskFilter {
+	filter := &domain.TaskFilter{
+		ProjectID:     projectID,
+		State:         domain.ArchiveState(requestData.State),
+		ColumnIDs:     requestData.ColumnID,
+		Labels:        requestData.Label,
+		MinEstimate:   requestData.MinEstimate,
+		MaxEstimate:   requestData.MaxEstimate,
+		DueAfter:      parseOptionalTime(requestData.DueAfter),
+		DueBefore:     parseOptionalTime(requestData.DueBefore),
+		CreatedAfter:  parseOptionalTime(requestData.CreatedAfter),
+		CreatedBefore: parseOptionalTime(requestData.CreatedBefore),
+		Text:          strings.TrimSpace(requestData.Query),
+		CustomFields:  requestData.CustomFields,
+		SortBy:        domain.TaskSortField(strings.TrimPrefix(requestData.Sort, "-")),
+		SortDesc:      strings.HasPrefix(requestData.Sort, "-"),
+		Limit:         requestData.Limit,
+		Cursor:        requestData.Cursor,
+	}
+
+	for _, assigneeID := range requestData.AssigneeID {
+		if assigneeID == "none" {
+			filter.Unassigned = true
+			continue
 		}
+		filter.AssigneeIDs = append(filter.AssigneeIDs, assigneeID)
 	}
 
-	c.JSON(http.StatusOK, datatransfers.ResponseSuccess("Tasks fetched successfully", responseData))
+	for _, priority := range requestData.Priority {
+		filter.Priorities = append(filter.Priorities, domain.TaskPriority(priority))
+	}
+
+	return filter
+}
+
+func parseOptionalTime(value *string) *time.Time {
+	if value == nil {
+		return nil
+	}
+	parsed, _ := time.Parse(time.RFC3339, *value)
+	return &parsed
 }
 
 func (h *taskHandler) UpdateTaskHandler(c *gin.Context) {
@@ -165,8 +237,16 @@ func (h *taskHandler) UpdateTaskHandler(c *gin.Context) {
 		return
 	}
 
+	access := c.MustGet("project_access").(*domain.ProjectAccess)
+	isMove := requestData.ColumnID != nil || requestData.LaneID != nil
+	if isMove && !access.Can(domain.PermissionTaskMove) {
+		c.JSON(http.StatusForbidden, datatransfers.ResponseError("You are not authorized to move tasks"))
+		return
+	}
+
 	task := &domain.Task{
-		ID: id,
+		ID:        id,
+		ProjectID: projectID,
 	}
 
 	responseData := responses.TaskUpdateResponse{
@@ -183,28 +263,75 @@ func (h *taskHandler) UpdateTaskHandler(c *gin.Context) {
 		responseData.ColumnID = task.ColumnID
 	}
 
+	if requestData.LaneID != nil {
+		task.LaneID = requestData.LaneID
+		responseData.LaneID = task.LaneID
+	}
+
+	if requestData.AssigneeID != nil {
+		task.AssigneeID = requestData.AssigneeID
+		responseData.AssigneeID = task.AssigneeID
+	}
+
+	if requestData.Labels != nil {
+		task.Labels = requestData.Labels
+		responseData.Labels = task.Labels
+	}
+
+	if requestData.Priority != nil {
+		task.Priority = domain.TaskPriority(*requestData.Priority)
+		responseData.Priority = *requestData.Priority
+	}
+
+	if requestData.Estimate != nil {
+		task.Estimate = requestData.Estimate
+		responseData.Estimate = requestData.Estimate
+	}
+
+	if requestData.CustomFields != nil {
+		task.CustomFields = requestData.CustomFields
+	}
+
 	if requestData.Content != nil {
 		task.Content = requestData.Content
 		responseData.Content = task.Content
 	}
 
-	err = h.taskService.UpdateTask(c.Request.Context(), task)
+	if requestData.DueDate != nil {
+		dueDate, _ := time.Parse(time.RFC3339, *requestData.DueDate)
+		task.DueDate = &dueDate
+		responseData.DueDate = requestData.DueDate
+	}
+
+	result, err := h.taskService.UpdateTask(c.Request.Context(), task)
+
+	respondWithTaskUpdate(c, task, result, err, responseData)
+}
+
+func respondWithTaskUpdate(c *gin.Context, task *domain.Task, result *domain.TaskResult, err error, responseData responses.TaskUpdateResponse) {
+	var transitionErr *domain.TransitionError
+	if errors.As(err, &transitionErr) {
+		c.JSON(http.StatusUnprocessableEntity, datatransfers.ResponseErrorWithData(transitionErr.Error(), responses.NewTransitionErrorResponse(transitionErr)))
+		return
+	}
+
+	if status := taskServiceErrorStatus(err); status != 0 {
+		c.JSON(status, datatransfers.ResponseError(err.Error()))
+		return
+	}
+
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, datatransfers.ResponseError("Failed to update task"))
 		return
 	}
 
-	if requestData.ColumnID != nil {
-		h.hub.SendMessageToProject(projectID, ws.BaseResponse{
-			Name: ws.EventNameTaskMoved,
-			Data: responseData,
-		})
-	} else {
-		h.hub.SendMessageToProject(projectID, ws.BaseResponse{
-			Name: ws.EventNameTaskUpdated,
-			Data: responseData,
-		})
+	responseData.CustomFields = task.CustomFields
+	responseData.Watchers = task.Watchers
+	if task.Mentions != nil {
+		mentions := responses.NewMentionResponses(task.Mentions)
+		responseData.Mentions = &mentions
 	}
+	responseData.ColumnLoad = responses.NewColumnLoadResponse(result.Load)
 
 	c.JSON(http.StatusOK, datatransfers.ResponseSuccess("Task updated successfully", responseData))
 }
@@ -224,7 +351,12 @@ func (h *taskHandler) DeleteTaskHandler(c *gin.Context) {
 		return
 	}
 
-	err = h.taskService.DeleteTask(c.Request.Context(), id)
+	err = h.taskService.DeleteTask(c.Request.Context(), projectID, id)
+	if errors.Is(err, domain.ErrTaskNotFound) {
+		c.JSON(http.StatusNotFound, datatransfers.ResponseError(err.Error()))
+		return
+	}
+
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, datatransfers.ResponseError("Failed to delete task"))
 		return
@@ -234,10 +366,137 @@ func (h *taskHandler) DeleteTaskHandler(c *gin.Context) {
 		ID: id,
 	}
 
-	h.hub.SendMessageToProject(projectID, ws.BaseResponse{
-		Name: ws.EventNameTaskDeleted,
-		Data: responseData,
-	})
-
 	c.JSON(http.StatusOK, datatransfers.ResponseSuccess("Task deleted successfully", responseData))
 }
+
+func (h *taskHandler) GetArchivedTasksHandler(c *gin.Context) {
+	projectID := c.Param("project_id")
+
+	state := c.DefaultQuery("state", string(domain.ArchiveStateArchived))
+	if !domain.IsValidArchiveState(state) {
+		c.JSON(http.StatusBadRequest, datatransfers.ResponseError("Invalid state"))
+		return
+	}
+
+	tasks, err := h.taskService.GetTasksByState(c.Request.Context(), projectID, domain.ArchiveState(state))
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, datatransfers.ResponseError("Failed to get tasks"))
+		return
+	}
+
+	responseData := make([]responses.TaskResponse, len(tasks))
+	for i, task := range tasks {
+		responseData[i] = responses.NewTaskResponse(task)
+	}
+
+	c.JSON(http.StatusOK, datatransfers.ResponseSuccess("Tasks fetched successfully", responseData))
+}
+
+func (h *taskHandler) ArchiveTaskHandler(c *gin.Context) {
+	id := c.Param("task_id")
+	projectID := c.Param("project_id")
+
+	err := validation.ValidateUUID(id)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, datatransfers.ResponseError("Invalid task ID"))
+		return
+	}
+
+	err = h.taskService.ArchiveTask(c.Request.Context(), projectID, id)
+	if err != nil {
+		c.JSON(http.StatusNotFound, datatransfers.ResponseError("Task not found or already archived"))
+		return
+	}
+
+	responseData := responses.TaskDeleteResponse{
+		ID: id,
+	}
+
+	c.JSON(http.StatusOK, datatransfers.ResponseSuccess("Task archived successfully", responseData))
+}
+
+func (h *taskHandler) RestoreTaskHandler(c *gin.Context) {
+	id := c.Param("task_id")
+	projectID := c.Param("project_id")
+
+	err := validation.ValidateUUID(id)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, datatransfers.ResponseError("Invalid task ID"))
+		return
+	}
+
+	task, err := h.taskService.RestoreTask(c.Request.Context(), projectID, id)
+	if errors.Is(err, domain.ErrColumnNotActive) {
+		c.JSON(http.StatusConflict, datatransfers.ResponseError("Restore the task's column first"))
+		return
+	}
+
+	if errors.Is(err, domain.ErrWipLimitExceeded) {
+		c.JSON(http.StatusConflict, datatransfers.ResponseError(err.Error()))
+		return
+	}
+
+	if err != nil {
+		c.JSON(http.StatusNotFound, datatransfers.ResponseError("Task not found or not archived"))
+		return
+	}
+
+	responseData := responses.NewTaskResponse(task)
+
+	c.JSON(http.StatusOK, datatransfers.ResponseSuccess("Task restored successfully", responseData))
+}
+
+func taskServiceErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, domain.ErrTaskNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, domain.ErrColumnNotActive), errors.Is(err, domain.ErrWipLimitExceeded), errors.Is(err, domain.ErrTaskBlocked):
+		return http.StatusConflict
+	case errors.Is(err, domain.ErrInvalidTargetColumn), errors.Is(err, domain.ErrInvalidLane), errors.Is(err, domain.ErrInvalidAssignee), errors.Is(err, domain.ErrInvalidCustomFieldValue):
+		return http.StatusBadRequest
+	}
+	return 0
+}
+
+func (h *taskHandler) WatchTaskHandler(c *gin.Context) {
+	h.setWatching(c, true)
+}
+
+func (h *taskHandler) UnwatchTaskHandler(c *gin.Context) {
+	h.setWatching(c, false)
+}
+
+func (h *taskHandler) setWatching(c *gin.Context, watching bool) {
+	user := c.MustGet("user").(*jwt.UserClaims)
+	id := c.Param("task_id")
+	projectID := c.Param("project_id")
+
+	err := validation.ValidateUUID(id)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, datatransfers.ResponseError("Invalid task ID"))
+		return
+	}
+
+	task, err := h.taskService.GetTaskByID(c.Request.Context(), projectID, id)
+	if err != nil || task.DeletedAt != nil {
+		c.JSON(http.StatusNotFound, datatransfers.ResponseError("Task not found"))
+		return
+	}
+
+	if watching {
+		task, err = h.taskService.WatchTask(c.Request.Context(), task.ID, user.ID)
+	} else {
+		task, err = h.taskService.UnwatchTask(c.Request.Context(), task.ID, user.ID)
+	}
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, datatransfers.ResponseError("Failed to update task watchers"))
+		return
+	}
+
+	responseData := responses.TaskWatchersResponse{
+		TaskID:   task.ID,
+		Watchers: task.Watchers,
+	}
+
+	c.JSON(http.StatusOK, datatransfers.ResponseSuccess("Task watchers updated successfully", responseData))
+}
@@ -0,0 +1,160 @@
+package http
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/fatihsen-dev/kanban-backend/internal/adapters/driver/http/datatransfers"
+	"github.com/fatihsen-dev/kanban-backend/internal/adapters/driver/http/datatransfers/requests"
+	"github.com/fatihsen-dev/kanban-backend/internal/adapters/driver/http/datatransfers/responses"
+	middlewares "github.com/fatihsen-dev/kanban-backend/internal/adapters/driver/http/middleware"
+	"github.com/fatihsen-dev/kanban-backend/internal/adapters/driver/validation"
+	"github.com/fatihsen-dev/kanban-backend/internal/core/domain"
+	ports "github.com/fatihsen-dev/kanban-backend/internal/core/ports/driver"
+	"github.com/fatihsen-dev/kanban-backend/pkg/jwt"
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+type taskLinkHandler struct {
+	taskLinkService        ports.TaskLinkService
+	taskService            ports.TaskService
+	authMiddleware         *middlewares.AuthnMiddleware
+	projectAuthzMiddleware *middlewares.ProjectAuthzMiddleware
+	taskKeyMiddleware      *middlewares.TaskKeyMiddleware
+}
+
+func NewTaskLinkHandler(taskLinkService ports.TaskLinkService, taskService ports.TaskService, authMiddleware *middlewares.AuthnMiddleware, projectAuthzMiddleware *middlewares.ProjectAuthzMiddleware, taskKeyMiddleware *middlewares.TaskKeyMiddleware) *taskLinkHandler {
+	return &taskLinkHandler{taskLinkService: taskLinkService, taskService: taskService, authMiddleware: authMiddleware, projectAuthzMiddleware: projectAuthzMiddleware, taskKeyMiddleware: taskKeyMiddleware}
+}
+
+func (h *taskLinkHandler) RegisterTaskLinkRouter(r *gin.Engine) {
+	linkGroup := r.Group("/projects/:project_id/tasks/:task_id/links")
+
+	linkGroup.Use(h.authMiddleware.Handle(false), h.taskKeyMiddleware.Handle("task_id"))
+
+	linkGroup.GET("", h.projectAuthzMiddleware.Handle(domain.PermissionProjectView), h.GetTaskLinksHandler)
+	linkGroup.POST("", h.projectAuthzMiddleware.Handle(domain.PermissionTaskUpdate), h.CreateTaskLinkHandler)
+	linkGroup.DELETE("/:link_id", h.projectAuthzMiddleware.Handle(domain.PermissionTaskUpdate), h.DeleteTaskLinkHandler)
+}
+
+func (h *taskLinkHandler) GetTaskLinksHandler(c *gin.Context) {
+	task, ok := h.getProjectTask(c)
+	if !ok {
+		return
+	}
+
+	links, err := h.taskLinkService.GetLinksByTaskID(c.Request.Context(), task.ID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, datatransfers.ResponseError("Failed to get task links"))
+		return
+	}
+
+	responseData := make([]responses.TaskLinkResponse, len(links))
+	for i, link := range links {
+		responseData[i] = responses.NewTaskLinkResponseFor(link, task.ID)
+	}
+
+	c.JSON(http.StatusOK, datatransfers.ResponseSuccess("Task links fetched successfully", responseData))
+}
+
+func (h *taskLinkHandler) CreateTaskLinkHandler(c *gin.Context) {
+	user := c.MustGet("user").(*jwt.UserClaims)
+
+	task, ok := h.getProjectTask(c)
+	if !ok {
+		return
+	}
+
+	var requestData requests.TaskLinkCreateRequest
+	if err := c.ShouldBindJSON(&requestData); err != nil {
+		c.JSON(http.StatusBadRequest, datatransfers.ResponseError("Invalid request data"))
+		return
+	}
+
+	if err := validation.Validate(requestData); err != nil {
+		c.JSON(http.StatusBadRequest, datatransfers.ResponseError(err.Error()))
+		return
+	}
+
+	link := domain.NewTaskLink(task.ID, requestData.TaskID, domain.TaskLinkType(requestData.Type))
+
+	_, err := h.taskLinkService.CreateLink(c.Request.Context(), user.ID, link)
+	if errors.Is(err, domain.ErrTaskLinkCycle) {
+		c.JSON(http.StatusConflict, datatransfers.ResponseError(err.Error()))
+		return
+	}
+
+	if errors.Is(err, domain.ErrInvalidTaskLink) {
+		c.JSON(http.StatusBadRequest, datatransfers.ResponseError(err.Error()))
+		return
+	}
+
+	if err != nil {
+		zap.L().Error("Failed to create task link", zap.Error(err))
+		c.JSON(http.StatusInternalServerError, datatransfers.ResponseError("Failed to create task link"))
+		return
+	}
+
+	c.JSON(http.StatusCreated, datatransfers.ResponseSuccess("Task link created successfully", responses.NewTaskLinkResponseFor(link, task.ID)))
+}
+
+func (h *taskLinkHandler) DeleteTaskLinkHandler(c *gin.Context) {
+	user := c.MustGet("user").(*jwt.UserClaims)
+
+	task, ok := h.getProjectTask(c)
+	if !ok {
+		return
+	}
+
+	linkID := c.Param("link_id")
+
+	err := validation.ValidateUUID(linkID)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, datatransfers.ResponseError("Invalid link ID"))
+		return
+	}
+
+	link, err := h.taskLinkService.GetLinkByID(c.Request.Context(), linkID)
+	if err != nil || (link.SourceTaskID != task.ID && link.TargetTaskID != task.ID) {
+		c.JSON(http.StatusNotFound, datatransfers.ResponseError("Task link not found"))
+		return
+	}
+
+	_, err = h.taskLinkService.DeleteLink(c.Request.Context(), user.ID, link)
+	if errors.Is(err, domain.ErrInvalidTaskLink) {
+		c.JSON(http.StatusForbidden, datatransfers.ResponseError("You are not authorized to change this link"))
+		return
+	}
+
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, datatransfers.ResponseError("Failed to delete task link"))
+		return
+	}
+
+	responseData := responses.TaskLinkDeleteResponse{
+		ID:           link.ID,
+		SourceTaskID: link.SourceTaskID,
+		TargetTaskID: link.TargetTaskID,
+	}
+
+	c.JSON(http.StatusOK, datatransfers.ResponseSuccess("Task link deleted successfully", responseData))
+}
+
+func (h *taskLinkHandler) getProjectTask(c *gin.Context) (*domain.Task, bool) {
+	taskID := c.Param("task_id")
+
+	err := validation.ValidateUUID(taskID)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, datatransfers.ResponseError("Invalid task ID"))
+		return nil, false
+	}
+
+	task, err := h.taskService.GetTaskByID(c.Request.Context(), c.Param("project_id"), taskID)
+	if err != nil || task.DeletedAt != nil {
+		c.JSON(http.StatusNotFound, datatransfers.ResponseError("Task not found"))
+		return nil, false
+	}
+
+	return task, true
+}
@@ -0,0 +1,183 @@
+package http
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/fatihsen-dev/kanban-backend/internal/adapters/driver/http/datatransfers"
+	"github.com/fatihsen-dev/kanban-backend/internal/adapters/driver/http/datatransfers/requests"
+	"github.com/fatihsen-dev/kanban-backend/internal/adapters/driver/http/datatransfers/responses"
+	middlewares "github.com/fatihsen-dev/kanban-backend/internal/adapters/driver/http/middleware"
+	"github.com/fatihsen-dev/kanban-backend/internal/adapters/driver/validation"
+	"github.com/fatihsen-dev/kanban-backend/internal/core/domain"
+	ports "github.com/fatihsen-dev/kanban-backend/internal/core/ports/driver"
+	"github.com/gin-gonic/gin"
+)
+
+type taskRevisionHandler struct {
+	taskService            ports.TaskService
+	authMiddleware         *middlewares.AuthnMiddleware
+	projectAuthzMiddleware *middlewares.ProjectAuthzMiddleware
+	taskKeyMiddleware      *middlewares.TaskKeyMiddleware
+}
+
+func NewTaskRevisionHandler(taskService ports.TaskService, authMiddleware *middlewares.AuthnMiddleware, projectAuthzMiddleware *middlewares.ProjectAuthzMiddleware, taskKeyMiddleware *middlewares.TaskKeyMiddleware) *taskRevisionHandler {
+	return &taskRevisionHandler{taskService: taskService, authMiddleware: authMiddleware, projectAuthzMiddleware: projectAuthzMiddleware, taskKeyMiddleware: taskKeyMiddleware}
+}
+
+func (h *taskRevisionHandler) RegisterTaskRevisionRouter(r *gin.Engine) {
+	revisionGroup := r.Group("/projects/:project_id/tasks/:task_id/revisions")
+
+	revisionGroup.Use(h.authMiddleware.Handle(false), h.taskKeyMiddleware.Handle("task_id"))
+
+	revisionGroup.GET("", h.projectAuthzMiddleware.Handle(domain.PermissionProjectView), h.GetTaskRevisionsHandler)
+	revisionGroup.GET("/compare", h.projectAuthzMiddleware.Handle(domain.PermissionProjectView), h.CompareTaskRevisionsHandler)
+	revisionGroup.GET("/:revision", h.projectAuthzMiddleware.Handle(domain.PermissionProjectView), h.GetTaskRevisionHandler)
+	revisionGroup.POST("/:revision/revert", h.projectAuthzMiddleware.Handle(domain.PermissionTaskUpdate), h.RevertTaskHandler)
+}
+
+func (h *taskRevisionHandler) GetTaskRevisionsHandler(c *gin.Context) {
+	task, ok := h.getProjectTask(c)
+	if !ok {
+		return
+	}
+
+	revisions, err := h.taskService.GetTaskRevisions(c.Request.Context(), task.ID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, datatransfers.ResponseError("Failed to get task revisions"))
+		return
+	}
+
+	responseData := make([]responses.TaskRevisionResponse, len(revisions))
+	for i, revision := range revisions {
+		var previous *domain.TaskRevision
+		if i+1 < len(revisions) {
+			previous = revisions[i+1]
+		}
+		responseData[i] = responses.NewTaskRevisionResponse(revision, previous)
+	}
+
+	c.JSON(http.StatusOK, datatransfers.ResponseSuccess("Task revisions fetched successfully", responseData))
+}
+
+func (h *taskRevisionHandler) GetTaskRevisionHandler(c *gin.Context) {
+	task, ok := h.getProjectTask(c)
+	if !ok {
+		return
+	}
+
+	number, ok := revisionNumber(c)
+	if !ok {
+		return
+	}
+
+	revision, err := h.taskService.GetTaskRevision(c.Request.Context(), task.ID, number)
+	if err != nil {
+		c.JSON(http.StatusNotFound, datatransfers.ResponseError("Task revision not found"))
+		return
+	}
+
+	previous, _ := h.taskService.GetTaskRevision(c.Request.Context(), task.ID, number-1)
+
+	c.JSON(http.StatusOK, datatransfers.ResponseSuccess("Task revision fetched successfully", responses.NewTaskRevisionResponse(revision, previous)))
+}
+
+func (h *taskRevisionHandler) CompareTaskRevisionsHandler(c *gin.Context) {
+	task, ok := h.getProjectTask(c)
+	if !ok {
+		return
+	}
+
+	var requestData requests.TaskRevisionCompareRequest
+	if err := c.ShouldBindQuery(&requestData); err != nil {
+		c.JSON(http.StatusBadRequest, datatransfers.ResponseError("Invalid query parameters"))
+		return
+	}
+
+	if err := validation.Validate(requestData); err != nil {
+		c.JSON(http.StatusBadRequest, datatransfers.ResponseError(err.Error()))
+		return
+	}
+
+	changes, err := h.taskService.CompareTaskRevisions(c.Request.Context(), task.ID, requestData.From, requestData.To)
+	if err != nil {
+		c.JSON(http.StatusNotFound, datatransfers.ResponseError("Task revision not found"))
+		return
+	}
+
+	responseData := responses.TaskRevisionDiffResponse{
+		From:    requestData.From,
+		To:      requestData.To,
+		Changes: responses.NewFieldChangesResponse(changes),
+	}
+
+	c.JSON(http.StatusOK, datatransfers.ResponseSuccess("Task revisions compared successfully", responseData))
+}
+
+func (h *taskRevisionHandler) RevertTaskHandler(c *gin.Context) {
+	task, ok := h.getProjectTask(c)
+	if !ok {
+		return
+	}
+
+	number, ok := revisionNumber(c)
+	if !ok {
+		return
+	}
+
+	_, err := h.taskService.GetTaskRevision(c.Request.Context(), task.ID, number)
+	if err != nil {
+		c.JSON(http.StatusNotFound, datatransfers.ResponseError("Task revision not found"))
+		return
+	}
+
+	changes, err := h.taskService.GetRevertChanges(c.Request.Context(), task.ID, number)
+	if errors.Is(err, domain.ErrTaskRevisionUnchanged) {
+		c.JSON(http.StatusConflict, datatransfers.ResponseError(err.Error()))
+		return
+	}
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, datatransfers.ResponseError("Failed to revert task"))
+		return
+	}
+
+	access := c.MustGet("project_access").(*domain.ProjectAccess)
+	isMove := changes.ColumnID != "" || changes.LaneID != nil
+	if isMove && !access.Can(domain.PermissionTaskMove) {
+		c.JSON(http.StatusForbidden, datatransfers.ResponseError("You are not authorized to move tasks"))
+		return
+	}
+
+	changes.ProjectID = task.ProjectID
+	result, err := h.taskService.UpdateTask(c.Request.Context(), changes)
+
+	respondWithTaskUpdate(c, changes, result, err, responses.NewTaskUpdateResponse(changes))
+}
+
+func (h *taskRevisionHandler) getProjectTask(c *gin.Context) (*domain.Task, bool) {
+	taskID := c.Param("task_id")
+
+	err := validation.ValidateUUID(taskID)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, datatransfers.ResponseError("Invalid task ID"))
+		return nil, false
+	}
+
+	task, err := h.taskService.GetTaskByID(c.Request.Context(), c.Param("project_id"), taskID)
+	if err != nil || task.DeletedAt != nil {
+		c.JSON(http.StatusNotFound, datatransfers.ResponseError("Task not found"))
+		return nil, false
+	}
+
+	return task, true
+}
+
+func revisionNumber(c *gin.Context) (int, bool) {
+	number, err := strconv.Atoi(c.Param("revision"))
+	if err != nil || number < 1 {
+		c.JSON(http.StatusBadRequest, datatransfers.ResponseError("Invalid revision number"))
+		return 0, false
+	}
+	return number, true
+}
@@ -1,6 +1,7 @@
 package http
 
 import (
+	"errors"
 	"net/http"
 	"time"
 
@@ -9,7 +10,6 @@ import (
 	"github.com/fatihsen-dev/kanban-backend/internal/adapters/driver/http/datatransfers/responses"
 	middlewares "github.com/fatihsen-dev/kanban-backend/internal/adapters/driver/http/middleware"
 	"github.com/fatihsen-dev/kanban-backend/internal/adapters/driver/validation"
-	"github.com/fatihsen-dev/kanban-backend/internal/adapters/driver/ws"
 	"github.com/fatihsen-dev/kanban-backend/internal/core/domain"
 	ports "github.com/fatihsen-dev/kanban-backend/internal/core/ports/driver"
 	"github.com/gin-gonic/gin"
@@ -20,11 +20,10 @@ type teamHandler struct {
 	teamService            ports.TeamService
 	authMiddleware         *middlewares.AuthnMiddleware
 	projectAuthzMiddleware *middlewares.ProjectAuthzMiddleware
-	hub                    *ws.Hub
 }
 
-func NewTeamHandler(teamService ports.TeamService, authMiddleware *middlewares.AuthnMiddleware, projectAuthzMiddleware *middlewares.ProjectAuthzMiddleware, hub *ws.Hub) *teamHandler {
-	return &teamHandler{teamService: teamService, authMiddleware: authMiddleware, projectAuthzMiddleware: projectAuthzMiddleware, hub: hub}
+func NewTeamHandler(teamService ports.TeamService, authMiddleware *middlewares.AuthnMiddleware, projectAuthzMiddleware *middlewares.ProjectAuthzMiddleware) *teamHandler {
+	return &teamHandler{teamService: teamService, authMiddleware: authMiddleware, projectAuthzMiddleware: projectAuthzMiddleware}
 }
 
 func (h *teamHandler) RegisterTeamRouter(r *gin.Engine) {
@@ -33,12 +32,12 @@ func (h *teamHandler) RegisterTeamRouter(r *gin.Engine) {
 
 	teamGroup.Use(h.authMiddleware.Handle(false))
 
-	teamGroup.POST("", h.projectAuthzMiddleware.Handle(middlewares.Owner), h.CreateTeamHandler)
-	teamGroup.GET("", h.projectAuthzMiddleware.Handle(middlewares.Member), h.GetTeamsHandler)
-	teamGroup.GET("/:team_id", h.projectAuthzMiddleware.Handle(middlewares.Member), h.GetTeamHandler)
-	teamGroup.PUT("/:team_id", h.projectAuthzMiddleware.Handle(middlewares.Owner), h.UpdateTeamHandler)
-	teamGroup.DELETE("/:team_id", h.projectAuthzMiddleware.Handle(middlewares.Owner), h.DeleteTeamHandler)
-	teamGroup.PUT("/:team_id/members", h.projectAuthzMiddleware.Handle(middlewares.Owner), h.AddTeamMemberHandler)
+	teamGroup.POST("", h.projectAuthzMiddleware.Handle(domain.PermissionTeamManage), h.CreateTeamHandler)
+	teamGroup.GET("", h.projectAuthzMiddleware.Handle(domain.PermissionProjectView), h.GetTeamsHandler)
+	teamGroup.GET("/:team_id", h.projectAuthzMiddleware.Handle(domain.PermissionProjectView), h.GetTeamHandler)
+	teamGroup.PUT("/:team_id", h.projectAuthzMiddleware.Handle(domain.PermissionTeamManage), h.UpdateTeamHandler)
+	teamGroup.DELETE("/:team_id", h.projectAuthzMiddleware.Handle(domain.PermissionTeamManage), h.DeleteTeamHandler)
+	teamGroup.PUT("/:team_id/members", h.projectAuthzMiddleware.Handle(domain.PermissionTeamManage), h.AddTeamMemberHandler)
 }
 
 func (h *teamHandler) CreateTeamHandler(c *gin.Context) {
@@ -54,24 +53,24 @@ func (h *teamHandler) CreateTeamHandler(c *gin.Context) {
 		return
 	}
 
-	projectMember, ok := c.MustGet("project_member").(domain.ProjectMember)
-	if !ok {
-		c.JSON(http.StatusInternalServerError, datatransfers.ResponseError("Project member not found"))
-		return
-	}
-
-	if projectMember.Role != domain.AccessOwnerRole && requestData.Role == string(domain.AccessAdminRole) {
-		c.JSON(http.StatusForbidden, datatransfers.ResponseError("You are not authorized to create a team"))
-		return
-	}
+	access := c.MustGet("project_access").(*domain.ProjectAccess)
 
 	team := &domain.Team{
 		Name:      requestData.Name,
 		Role:      domain.AccessRole(requestData.Role),
+		RoleID:    requestData.RoleID,
 		ProjectID: requestData.ProjectID,
 	}
 
-	err := h.teamService.CreateTeam(c.Request.Context(), team)
+	if team.Role == "" {
+		team.Role = domain.AccessReadRole
+	}
+
+	err := h.teamService.CreateTeam(c.Request.Context(), team, access)
+	if errors.Is(err, domain.ErrRoleExceedsPermissions) {
+		c.JSON(http.StatusForbidden, datatransfers.ResponseError(err.Error()))
+		return
+	}
 	if err != nil {
 		zap.L().Error("Failed to create team", zap.Error(err))
 		c.JSON(http.StatusInternalServerError, datatransfers.ResponseError(err.Error()))
@@ -82,16 +81,12 @@ func (h *teamHandler) CreateTeamHandler(c *gin.Context) {
 		ID:        team.ID,
 		Name:      team.Name,
 		Role:      string(team.Role),
+		RoleID:    team.RoleID,
 		ProjectID: team.ProjectID,
 		Members:   make([]responses.ProjectMemberResponse, 0),
 		CreatedAt: team.CreatedAt.Format(time.RFC3339),
 	}
 
-	h.hub.SendMessageToProject(team.ProjectID, ws.BaseResponse{
-		Name: ws.EventNameTeamCreated,
-		Data: responseData,
-	})
-
 	c.JSON(http.StatusCreated, datatransfers.ResponseSuccess("Team created successfully", responseData))
 }
 
@@ -115,6 +110,8 @@ func (h *teamHandler) GetTeamsHandler(c *gin.Context) {
 		responseData[i] = responses.TeamResponse{
 			ID:        team.ID,
 			Name:      team.Name,
+			Role:      string(team.Role),
+			RoleID:    team.RoleID,
 			ProjectID: team.ProjectID,
 			CreatedAt: team.CreatedAt.Format(time.RFC3339),
 		}
@@ -132,7 +129,11 @@ func (h *teamHandler) GetTeamHandler(c *gin.Context) {
 		return
 	}
 
-	team, err := h.teamService.GetTeamByID(c.Request.Context(), teamID)
+	team, err := h.teamService.GetTeamByID(c.Request.Context(), c.Param("project_id"), teamID)
+	if errors.Is(err, domain.ErrTeamNotFound) {
+		c.JSON(http.StatusNotFound, datatransfers.ResponseError(err.Error()))
+		return
+	}
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, datatransfers.ResponseError("Failed to get team"))
 		return
@@ -141,6 +142,8 @@ func (h *teamHandler) GetTeamHandler(c *gin.Context) {
 	responseData := responses.TeamResponse{
 		ID:        team.ID,
 		Name:      team.Name,
+		Role:      string(team.Role),
+		RoleID:    team.RoleID,
 		ProjectID: team.ProjectID,
 		CreatedAt: team.CreatedAt.Format(time.RFC3339),
 	}
@@ -170,7 +173,9 @@ func (h *teamHandler) UpdateTeamHandler(c *gin.Context) {
 	}
 
 	team := &domain.Team{
-		ID: teamID,
+		ID:        teamID,
+		ProjectID: projectID,
+		RoleID:    requestData.RoleID,
 	}
 
 	if requestData.Name != nil {
@@ -181,7 +186,16 @@ func (h *teamHandler) UpdateTeamHandler(c *gin.Context) {
 		team.Role = domain.AccessRole(*requestData.Role)
 	}
 
-	err = h.teamService.UpdateTeam(c.Request.Context(), team)
+	access := c.MustGet("project_access").(*domain.ProjectAccess)
+	err = h.teamService.UpdateTeam(c.Request.Context(), team, access)
+	if errors.Is(err, domain.ErrTeamNotFound) {
+		c.JSON(http.StatusNotFound, datatransfers.ResponseError(err.Error()))
+		return
+	}
+	if errors.Is(err, domain.ErrRoleExceedsPermissions) {
+		c.JSON(http.StatusForbidden, datatransfers.ResponseError(err.Error()))
+		return
+	}
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, datatransfers.ResponseError("Failed to update team"))
 		return
@@ -191,20 +205,15 @@ func (h *teamHandler) UpdateTeamHandler(c *gin.Context) {
 		ID:        team.ID,
 		Name:      team.Name,
 		Role:      string(team.Role),
+		RoleID:    team.RoleID,
 		ProjectID: projectID,
 	}
 
-	h.hub.SendMessageToProject(projectID, ws.BaseResponse{
-		Name: ws.EventNameTeamUpdated,
-		Data: responseData,
-	})
-
 	c.JSON(http.StatusOK, datatransfers.ResponseSuccess("Team updated successfully", responseData))
 }
 
 func (h *teamHandler) DeleteTeamHandler(c *gin.Context) {
 	teamID := c.Param("team_id")
-	projectID := c.Param("project_id")
 
 	err := validation.ValidateUUID(teamID)
 	if err != nil {
@@ -212,7 +221,11 @@ func (h *teamHandler) DeleteTeamHandler(c *gin.Context) {
 		return
 	}
 
-	err = h.teamService.DeleteTeamByID(c.Request.Context(), teamID)
+	err = h.teamService.DeleteTeamByID(c.Request.Context(), c.Param("project_id"), teamID)
+	if errors.Is(err, domain.ErrTeamNotFound) {
+		c.JSON(http.StatusNotFound, datatransfers.ResponseError(err.Error()))
+		return
+	}
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, datatransfers.ResponseError("Failed to delete team"))
 		return
@@ -222,17 +235,11 @@ func (h *teamHandler) DeleteTeamHandler(c *gin.Context) {
 		ID: teamID,
 	}
 
-	h.hub.SendMessageToProject(projectID, ws.BaseResponse{
-		Name: ws.EventNameTeamDeleted,
-		Data: responseData,
-	})
-
 	c.JSON(http.StatusOK, datatransfers.ResponseSuccess("Team deleted successfully", responseData))
 }
 
 func (h *teamHandler) AddTeamMemberHandler(c *gin.Context) {
 	teamID := c.Param("team_id")
-	projectID := c.Param("project_id")
 
 	err := validation.ValidateUUID(teamID)
 	if err != nil {
@@ -251,7 +258,11 @@ func (h *teamHandler) AddTeamMemberHandler(c *gin.Context) {
 		return
 	}
 
-	updatedMemberIDs, err := h.teamService.AddTeamMembers(c.Request.Context(), teamID, requestData.MemberIDs)
+	updatedMemberIDs, err := h.teamService.AddTeamMembers(c.Request.Context(), c.Param("project_id"), teamID, requestData.MemberIDs)
+	if errors.Is(err, domain.ErrTeamNotFound) {
+		c.JSON(http.StatusNotFound, datatransfers.ResponseError(err.Error()))
+		return
+	}
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, datatransfers.ResponseError("Failed to add team members"))
 		return
@@ -262,10 +273,5 @@ func (h *teamHandler) AddTeamMemberHandler(c *gin.Context) {
 		MemberIDs: updatedMemberIDs,
 	}
 
-	h.hub.SendMessageToProject(projectID, ws.BaseResponse{
-		Name: ws.EventNameTeamMembersAdded,
-		Data: responseData,
-	})
-
 	c.JSON(http.StatusOK, datatransfers.ResponseSuccess("Team members added successfully", responseData))
 }
@@ -0,0 +1,270 @@
+package http
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/fatihsen-dev/kanban-backend/internal/adapters/driver/http/datatransfers"
+	"github.com/fatihsen-dev/kanban-backend/internal/adapters/driver/http/datatransfers/requests"
+	"github.com/fatihsen-dev/kanban-backend/internal/adapters/driver/http/datatransfers/responses"
+	middlewares "github.com/fatihsen-dev/kanban-backend/internal/adapters/driver/http/middleware"
+	"github.com/fatihsen-dev/kanban-backend/internal/adapters/driver/validation"
+	"github.com/fatihsen-dev/kanban-backend/internal/core/domain"
+	ports "github.com/fatihsen-dev/kanban-backend/internal/core/ports/driver"
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+type webhookHandler struct {
+	webhookService         ports.WebhookService
+	authMiddleware         *middlewares.AuthnMiddleware
+	projectAuthzMiddleware *middlewares.ProjectAuthzMiddleware
+}
+
+func NewWebhookHandler(webhookService ports.WebhookService, authMiddleware *middlewares.AuthnMiddleware, projectAuthzMiddleware *middlewares.ProjectAuthzMiddleware) *webhookHandler {
+	return &webhookHandler{webhookService: webhookService, authMiddleware: authMiddleware, projectAuthzMiddleware: projectAuthzMiddleware}
+}
+
+func (h *webhookHandler) RegisterWebhookRouter(r *gin.Engine) {
+	webhookGroup := r.Group("/projects/:project_id/webhooks")
+
+	webhookGroup.Use(h.authMiddleware.Handle(false), h.projectAuthzMiddleware.Handle(domain.PermissionProjectManage))
+
+	webhookGroup.POST("", h.CreateWebhookHandler)
+	webhookGroup.GET("", h.GetWebhooksHandler)
+	webhookGroup.GET("/:webhook_id", h.GetWebhookHandler)
+	webhookGroup.PUT("/:webhook_id", h.UpdateWebhookHandler)
+	webhookGroup.DELETE("/:webhook_id", h.DeleteWebhookHandler)
+	webhookGroup.GET("/:webhook_id/deliveries", h.GetDeliveriesHandler)
+	webhookGroup.POST("/:webhook_id/deliveries/:delivery_id/redeliver", h.RedeliverHandler)
+}
+
+func (h *webhookHandler) CreateWebhookHandler(c *gin.Context) {
+	var requestData requests.CreateWebhookRequest
+	if err := c.ShouldBindJSON(&requestData); err != nil {
+		c.JSON(http.StatusBadRequest, datatransfers.ResponseError("Invalid request data"))
+		return
+	}
+
+	if err := validation.Validate(requestData); err != nil {
+		c.JSON(http.StatusBadRequest, datatransfers.ResponseError(err.Error()))
+		return
+	}
+
+	if !validWebhookEvents(requestData.Events) {
+		c.JSON(http.StatusBadRequest, datatransfers.ResponseError("Unknown webhook event"))
+		return
+	}
+
+	webhook := &domain.Webhook{
+		ProjectID: c.Param("project_id"),
+		URL:       requestData.URL,
+		Secret:    requestData.Secret,
+		Events:    requestData.Events,
+		Active:    true,
+	}
+
+	if requestData.Active != nil {
+		webhook.Active = *requestData.Active
+	}
+
+	err := h.webhookService.CreateWebhook(c.Request.Context(), webhook)
+	if errors.Is(err, domain.ErrInvalidWebhook) {
+		c.JSON(http.StatusBadRequest, datatransfers.ResponseError(err.Error()))
+		return
+	}
+
+	if err != nil {
+		zap.L().Error("Failed to create webhook", zap.Error(err))
+		c.JSON(http.StatusInternalServerError, datatransfers.ResponseError("Failed to create webhook"))
+		return
+	}
+
+	responseData := responses.NewWebhookResponse(webhook)
+	responseData.Secret = webhook.Secret
+
+	c.JSON(http.StatusCreated, datatransfers.ResponseSuccess("Webhook created successfully", responseData))
+}
+
+func (h *webhookHandler) GetWebhooksHandler(c *gin.Context) {
+	webhooks, err := h.webhookService.GetWebhooksByProjectID(c.Request.Context(), c.Param("project_id"))
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, datatransfers.ResponseError("Failed to get webhooks"))
+		return
+	}
+
+	responseData := make([]responses.WebhookResponse, len(webhooks))
+	for i, webhook := range webhooks {
+		responseData[i] = responses.NewWebhookResponse(webhook)
+	}
+
+	c.JSON(http.StatusOK, datatransfers.ResponseSuccess("Webhooks fetched successfully", responseData))
+}
+
+func (h *webhookHandler) GetWebhookHandler(c *gin.Context) {
+	webhook, ok := h.getProjectWebhook(c)
+	if !ok {
+		return
+	}
+
+	c.JSON(http.StatusOK, datatransfers.ResponseSuccess("Webhook fetched successfully", responses.NewWebhookResponse(webhook)))
+}
+
+func (h *webhookHandler) UpdateWebhookHandler(c *gin.Context) {
+	var requestData requests.UpdateWebhookRequest
+	if err := c.ShouldBindJSON(&requestData); err != nil {
+		c.JSON(http.StatusBadRequest, datatransfers.ResponseError("Invalid request data"))
+		return
+	}
+
+	if err := validation.Validate(requestData); err != nil {
+		c.JSON(http.StatusBadRequest, datatransfers.ResponseError(err.Error()))
+		return
+	}
+
+	if requestData.Events != nil && !validWebhookEvents(requestData.Events) {
+		c.JSON(http.StatusBadRequest, datatransfers.ResponseError("Unknown webhook event"))
+		return
+	}
+
+	webhook, ok := h.getProjectWebhook(c)
+	if !ok {
+		return
+	}
+
+	if requestData.URL != nil {
+		webhook.URL = *requestData.URL
+	}
+
+	if requestData.Secret != nil {
+		webhook.Secret = *requestData.Secret
+	}
+
+	if requestData.Events != nil {
+		webhook.Events = requestData.Events
+	}
+
+	if requestData.Active != nil {
+		webhook.Active = *requestData.Active
+	}
+
+	err := h.webhookService.UpdateWebhook(c.Request.Context(), webhook)
+	if errors.Is(err, domain.ErrInvalidWebhook) {
+		c.JSON(http.StatusBadRequest, datatransfers.ResponseError(err.Error()))
+		return
+	}
+
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, datatransfers.ResponseError("Failed to update webhook"))
+		return
+	}
+
+	c.JSON(http.StatusOK, datatransfers.ResponseSuccess("Webhook updated successfully", responses.NewWebhookResponse(webhook)))
+}
+
+func (h *webhookHandler) DeleteWebhookHandler(c *gin.Context) {
+	webhook, ok := h.getProjectWebhook(c)
+	if !ok {
+		return
+	}
+
+	err := h.webhookService.DeleteWebhookByID(c.Request.Context(), webhook.ID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, datatransfers.ResponseError("Failed to delete webhook"))
+		return
+	}
+
+	c.JSON(http.StatusOK, datatransfers.ResponseSuccess("Webhook deleted successfully", responses.WebhookDeleteResponse{ID: webhook.ID}))
+}
+
+func (h *webhookHandler) GetDeliveriesHandler(c *gin.Context) {
+	var requestData requests.WebhookDeliveryListRequest
+	if err := c.ShouldBindQuery(&requestData); err != nil {
+		c.JSON(http.StatusBadRequest, datatransfers.ResponseError("Invalid query parameters"))
+		return
+	}
+
+	if err := validation.Validate(requestData); err != nil {
+		c.JSON(http.StatusBadRequest, datatransfers.ResponseError(err.Error()))
+		return
+	}
+
+	webhook, ok := h.getProjectWebhook(c)
+	if !ok {
+		return
+	}
+
+	page, err := h.webhookService.GetDeliveries(c.Request.Context(), &domain.WebhookDeliveryFilter{
+		WebhookID: webhook.ID,
+		Limit:     requestData.Limit,
+		Cursor:    requestData.Cursor,
+	})
+	if errors.Is(err, domain.ErrInvalidPageCursor) {
+		c.JSON(http.StatusBadRequest, datatransfers.ResponseError(err.Error()))
+		return
+	}
+	if err != nil {
+		zap.L().Error("Failed to get webhook deliveries", zap.Error(err))
+		c.JSON(http.StatusInternalServerError, datatransfers.ResponseError("Failed to get webhook deliveries"))
+		return
+	}
+
+	c.JSON(http.StatusOK, datatransfers.ResponseSuccess("Webhook deliveries fetched successfully", responses.NewWebhookDeliveryPageResponse(page)))
+}
+
+func (h *webhookHandler) RedeliverHandler(c *gin.Context) {
+	deliveryID := c.Param("delivery_id")
+
+	err := validation.ValidateUUID(deliveryID)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, datatransfers.ResponseError("Invalid delivery ID"))
+		return
+	}
+
+	webhook, ok := h.getProjectWebhook(c)
+	if !ok {
+		return
+	}
+
+	delivery, err := h.webhookService.GetDeliveryByID(c.Request.Context(), deliveryID)
+	if err != nil || delivery.WebhookID != webhook.ID {
+		c.JSON(http.StatusNotFound, datatransfers.ResponseError("Delivery not found"))
+		return
+	}
+
+	redelivery, err := h.webhookService.Redeliver(c.Request.Context(), delivery)
+	if err != nil {
+		zap.L().Error("Failed to redeliver webhook", zap.Error(err))
+		c.JSON(http.StatusInternalServerError, datatransfers.ResponseError("Failed to redeliver webhook"))
+		return
+	}
+
+	c.JSON(http.StatusOK, datatransfers.ResponseSuccess("Webhook redelivered", responses.NewWebhookDeliveryResponse(redelivery)))
+}
+
+func (h *webhookHandler) getProjectWebhook(c *gin.Context) (*domain.Webhook, bool) {
+	webhookID := c.Param("webhook_id")
+
+	err := validation.ValidateUUID(webhookID)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, datatransfers.ResponseError("Invalid webhook ID"))
+		return nil, false
+	}
+
+	webhook, err := h.webhookService.GetWebhookByID(c.Request.Context(), webhookID)
+	if err != nil || webhook.ProjectID != c.Param("project_id") {
+		c.JSON(http.StatusNotFound, datatransfers.ResponseError("Webhook not found"))
+		return nil, false
+	}
+
+	return webhook, true
+}
+
+func validWebhookEvents(events []string) bool {
+	for _, event := range events {
+		if !domain.IsProjectEvent(event) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,36 @@
+package job
+
+import (
+	"context"
+	"time"
+
+	ports "github.com/fatihsen-dev/kanban-backend/internal/core/ports/driver"
+	"go.uber.org/zap"
+)
+
+type AutomationDueDateJob struct {
+	automationService ports.AutomationService
+	interval          time.Duration
+}
+
+func NewAutomationDueDateJob(automationService ports.AutomationService, interval time.Duration) *AutomationDueDateJob {
+	return &AutomationDueDateJob{automationService: automationService, interval: interval}
+}
+
+func (j *AutomationDueDateJob) Run(ctx context.Context) {
+	ticker := time.NewTicker(j.interval)
+	defer ticker.Stop()
+
+	for {
+		_, err := j.automationService.RunDueDateAutomations(ctx)
+		if err != nil {
+			zap.L().Error("Failed to run due date automations", zap.Error(err))
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,36 @@
+package job
+
+import (
+	"context"
+	"time"
+
+	ports "github.com/fatihsen-dev/kanban-backend/internal/core/ports/driver"
+	"go.uber.org/zap"
+)
+
+type EmailDigestJob struct {
+	emailService ports.EmailService
+	interval     time.Duration
+}
+
+func NewEmailDigestJob(emailService ports.EmailService, interval time.Duration) *EmailDigestJob {
+	return &EmailDigestJob{emailService: emailService, interval: interval}
+}
+
+func (j *EmailDigestJob) Run(ctx context.Context) {
+	ticker := time.NewTicker(j.interval)
+	defer ticker.Stop()
+
+	for {
+		_, err := j.emailService.SendDailyDigests(ctx, time.Now())
+		if err != nil {
+			zap.L().Error("Failed to send daily digests", zap.Error(err))
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,36 @@
+package job
+
+import (
+	"context"
+	"time"
+
+	ports "github.com/fatihsen-dev/kanban-backend/internal/core/ports/driver"
+	"go.uber.org/zap"
+)
+
+type NotificationReminderJob struct {
+	notificationService ports.NotificationService
+	interval            time.Duration
+}
+
+func NewNotificationReminderJob(notificationService ports.NotificationService, interval time.Duration) *NotificationReminderJob {
+	return &NotificationReminderJob{notificationService: notificationService, interval: interval}
+}
+
+func (j *NotificationReminderJob) Run(ctx context.Context) {
+	ticker := time.NewTicker(j.interval)
+	defer ticker.Stop()
+
+	for {
+		_, err := j.notificationService.SendDueDateReminders(ctx)
+		if err != nil {
+			zap.L().Error("Failed to send due date reminders", zap.Error(err))
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
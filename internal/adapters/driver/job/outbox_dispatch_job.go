@@ -0,0 +1,49 @@
+package job
+
+import (
+	"context"
+	"time"
+
+	ports "github.com/fatihsen-dev/kanban-backend/internal/core/ports/driver"
+	"go.uber.org/zap"
+)
+
+type OutboxDispatchJob struct {
+	outboxDispatcher ports.OutboxDispatcher
+	interval         time.Duration
+	purgeInterval    time.Duration
+}
+
+func NewOutboxDispatchJob(outboxDispatcher ports.OutboxDispatcher, interval, purgeInterval time.Duration) *OutboxDispatchJob {
+	return &OutboxDispatchJob{outboxDispatcher: outboxDispatcher, interval: interval, purgeInterval: purgeInterval}
+}
+
+func (j *OutboxDispatchJob) Run(ctx context.Context) {
+	ticker := time.NewTicker(j.interval)
+	defer ticker.Stop()
+
+	purgeTicker := time.NewTicker(j.purgeInterval)
+	defer purgeTicker.Stop()
+
+	for {
+		published, err := j.outboxDispatcher.DispatchPending(ctx)
+		if err != nil {
+			zap.L().Error("Failed to dispatch outbox events", zap.Error(err))
+		}
+
+		if published > 0 {
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-purgeTicker.C:
+			_, err := j.outboxDispatcher.PurgePublished(ctx)
+			if err != nil {
+				zap.L().Error("Failed to purge outbox events", zap.Error(err))
+			}
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,35 @@
+package job
+
+import (
+	"context"
+	"time"
+
+	ports "github.com/fatihsen-dev/kanban-backend/internal/core/ports/driver"
+	"go.uber.org/zap"
+)
+
+type TrashPurgeJob struct {
+	trashService ports.TrashService
+	interval     time.Duration
+}
+
+func NewTrashPurgeJob(trashService ports.TrashService, interval time.Duration) *TrashPurgeJob {
+	return &TrashPurgeJob{trashService: trashService, interval: interval}
+}
+
+func (j *TrashPurgeJob) Run(ctx context.Context) {
+	ticker := time.NewTicker(j.interval)
+	defer ticker.Stop()
+
+	for {
+		if err := j.trashService.PurgeExpired(ctx); err != nil {
+			zap.L().Error("Failed to purge expired trash", zap.Error(err))
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
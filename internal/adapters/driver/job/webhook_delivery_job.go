@@ -0,0 +1,36 @@
+package job
+
+import (
+	"context"
+	"time"
+
+	ports "github.com/fatihsen-dev/kanban-backend/internal/core/ports/driver"
+	"go.uber.org/zap"
+)
+
+type WebhookDeliveryJob struct {
+	webhookService ports.WebhookService
+	interval       time.Duration
+}
+
+func NewWebhookDeliveryJob(webhookService ports.WebhookService, interval time.Duration) *WebhookDeliveryJob {
+	return &WebhookDeliveryJob{webhookService: webhookService, interval: interval}
+}
+
+func (j *WebhookDeliveryJob) Run(ctx context.Context) {
+	ticker := time.NewTicker(j.interval)
+	defer ticker.Stop()
+
+	for {
+		_, err := j.webhookService.DeliverDue(ctx)
+		if err != nil {
+			zap.L().Error("Failed to deliver webhooks", zap.Error(err))
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,18 @@
+package validation
+
+import (
+	"github.com/fatihsen-dev/kanban-backend/internal/core/domain"
+	"github.com/go-playground/validator/v10"
+)
+
+func ValidateAutomationTrigger(fl validator.FieldLevel) bool {
+	return domain.IsValidAutomationTrigger(fl.Field().String())
+}
+
+func ValidateAutomationCondition(fl validator.FieldLevel) bool {
+	return domain.IsValidAutomationConditionType(fl.Field().String())
+}
+
+func ValidateAutomationAction(fl validator.FieldLevel) bool {
+	return domain.IsValidAutomationActionType(fl.Field().String())
+}
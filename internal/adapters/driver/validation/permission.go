@@ -0,0 +1,10 @@
+package validation
+
+import (
+	"github.com/fatihsen-dev/kanban-backend/internal/core/domain"
+	"github.com/go-playground/validator/v10"
+)
+
+func ValidatePermission(fl validator.FieldLevel) bool {
+	return domain.IsValidPermission(fl.Field().String())
+}
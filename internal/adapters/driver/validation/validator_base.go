@@ -25,6 +25,13 @@ func (ve ValidationErrors) Error() string {
 func Validate(data interface{}) error {
 	validate := validator.New()
 	validate.RegisterValidation("notblank", ValidateNotBlank)
+	validate.RegisterValidation("permission", ValidatePermission)
+	validate.RegisterValidation("transition_condition", ValidateTransitionCondition)
+	validate.RegisterValidation("task_link_type", ValidateTaskLinkType)
+	validate.RegisterValidation("project_key", ValidateProjectKey)
+	validate.RegisterValidation("automation_trigger", ValidateAutomationTrigger)
+	validate.RegisterValidation("automation_condition", ValidateAutomationCondition)
+	validate.RegisterValidation("automation_action", ValidateAutomationAction)
 	err := validate.Struct(data)
 	if err != nil {
 		var validationErrors ValidationErrors
@@ -71,6 +78,20 @@ func getErrorMessage(err validator.FieldError) string {
 		return "is invalid"
 	case "notblank":
 		return "This field cannot be empty"
+	case "permission":
+		return "Must be a valid permission"
+	case "transition_condition":
+		return "Must be a valid transition condition"
+	case "project_key":
+		return "Must be 2-10 uppercase letters or digits, starting with a letter"
+	case "task_link_type":
+		return "Must be a valid task link type"
+	case "automation_trigger":
+		return "Must be a valid automation trigger"
+	case "automation_condition":
+		return "Must be a valid automation condition"
+	case "automation_action":
+		return "Must be a valid automation action"
 	default:
 		return fmt.Sprintf("Failed %s validation", err.Tag())
 	}
@@ -92,7 +92,7 @@ func (c *Client) SendUserStatusEvent(status string) {
 		return
 	}
 
-	c.hub.SendMessageToProject(*c.projectID, BaseResponse{
+	c.hub.broadcastToProject(*c.projectID, BaseResponse{
 		Name: EventNameUserStatusUpdated,
 		Data: map[string]interface{}{
 			"id":     c.userID,
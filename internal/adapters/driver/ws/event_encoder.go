@@ -0,0 +1,159 @@
+package ws
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/fatihsen-dev/kanban-backend/internal/adapters/driver/http/datatransfers/responses"
+	"github.com/fatihsen-dev/kanban-backend/internal/core/domain"
+)
+
+type EventEncoder struct{}
+
+func NewEventEncoder() *EventEncoder {
+	return &EventEncoder{}
+}
+
+func (e *EventEncoder) Encode(event domain.Event) ([]byte, error) {
+	data, err := eventData(event)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(data)
+}
+
+func eventData(event domain.Event) (interface{}, error) {
+	switch event := event.(type) {
+	case *domain.EntityRemovedEvent:
+		return map[string]interface{}{"id": event.ID}, nil
+	case *domain.TaskChangedEvent:
+		data := responses.NewTaskResponse(event.Task)
+		data.ColumnLoad = responses.NewColumnLoadResponse(event.Load)
+		return data, nil
+	case *domain.TaskWatchersChangedEvent:
+		return responses.TaskWatchersResponse{
+			TaskID:   event.Task.ID,
+			Watchers: event.Task.Watchers,
+		}, nil
+	case *domain.TaskLinkChangedEvent:
+		if event.Name == domain.EventTaskLinkDeleted {
+			return responses.TaskLinkDeleteResponse{
+				ID:           event.Link.ID,
+				SourceTaskID: event.Link.SourceTaskID,
+				TargetTaskID: event.Link.TargetTaskID,
+			}, nil
+		}
+		return responses.NewTaskLinkResponse(event.Link), nil
+	case *domain.ChecklistItemChangedEvent:
+		if event.Name == domain.EventChecklistItemDeleted {
+			return responses.ChecklistItemDeleteResponse{
+				ID:       event.Item.ID,
+				TaskID:   event.Item.TaskID,
+				Progress: responses.NewChecklistProgressResponse(event.Progress),
+			}, nil
+		}
+		return responses.ChecklistItemChangeResponse{
+			Item:     responses.NewChecklistItemResponse(event.Item),
+			Progress: responses.NewChecklistProgressResponse(event.Progress),
+		}, nil
+	case *domain.ChecklistReorderedEvent:
+		return responses.ChecklistReorderResponse{
+			TaskID: event.TaskID,
+			Items:  responses.NewChecklistItemResponses(event.Items),
+		}, nil
+	case *domain.ColumnChangedEvent:
+		if event.Name == domain.EventColumnRestored {
+			return responses.NewColumnWithDetailsResponse(event.Column, event.Tasks), nil
+		}
+		return responses.NewColumnResponse(event.Column), nil
+	case *domain.ColumnDeletedEvent:
+		return responses.ColumnDeleteResponse{
+			ID:             event.Column.ID,
+			TargetColumnID: event.TargetColumnID,
+			MovedTaskIDs:   event.MovedTaskIDs,
+		}, nil
+	case *domain.ColumnTransitionChangedEvent:
+		return responses.NewColumnTransitionResponse(event.Transition), nil
+	case *domain.LaneChangedEvent:
+		return responses.NewLaneResponse(event.Lane), nil
+	case *domain.CustomFieldChangedEvent:
+		return responses.NewCustomFieldResponse(event.Field), nil
+	case *domain.AutomationRuleChangedEvent:
+		return responses.NewAutomationRuleResponse(event.Rule), nil
+	case *domain.ProjectRoleChangedEvent:
+		return responses.NewProjectRoleResponse(event.Role), nil
+	case *domain.SavedViewChangedEvent:
+		return responses.NewSavedViewResponse(event.View), nil
+	case *domain.TeamChangedEvent:
+		if event.Name == domain.EventTeamCreated {
+			return responses.TeamWithMembersResponse{
+				ID:        event.Team.ID,
+				Name:      event.Team.Name,
+				Role:      string(event.Team.Role),
+				RoleID:    event.Team.RoleID,
+				ProjectID: event.Team.ProjectID,
+				Members:   make([]responses.ProjectMemberResponse, 0),
+				CreatedAt: event.Team.CreatedAt.Format(time.RFC3339),
+			}, nil
+		}
+		return responses.UpdateTeamResponse{
+			ID:        event.Team.ID,
+			Name:      event.Team.Name,
+			Role:      string(event.Team.Role),
+			RoleID:    event.Team.RoleID,
+			ProjectID: event.Team.ProjectID,
+		}, nil
+	case *domain.TeamMembersAddedEvent:
+		return responses.AddTeamMemberResponse{
+			TeamID:    event.Team.ID,
+			MemberIDs: event.MemberIDs,
+		}, nil
+	case *domain.InvitationCreatedEvent:
+		return responses.NewInvitationResponse(event.Invitation, event.Invitee, event.Inviter, event.Project), nil
+	case *domain.ProjectMemberChangedEvent:
+		if event.Name == domain.EventProjectMemberCreated {
+			return responses.ProjectMemberWithUserResponse{
+				ID:        event.Member.ID,
+				UserID:    event.Member.UserID,
+				Role:      string(event.Member.Role),
+				RoleID:    event.Member.RoleID,
+				TeamID:    event.Member.TeamID,
+				ProjectID: event.Member.ProjectID,
+				CreatedAt: event.Member.CreatedAt.Format(time.RFC3339),
+				User: responses.UserResponse{
+					ID:        event.User.ID,
+					Name:      event.User.Name,
+					Email:     event.User.Email,
+					IsAdmin:   event.User.IsAdmin,
+					CreatedAt: event.User.CreatedAt.Format(time.RFC3339),
+				},
+			}, nil
+		}
+
+		data := responses.UpdateProjectMemberResponse{
+			ID:     event.Member.ID,
+			Role:   string(event.Member.Role),
+			RoleID: event.Member.RoleID,
+		}
+		if event.Member.TeamID != nil {
+			data.TeamID = *event.Member.TeamID
+		}
+		return data, nil
+	case *domain.ProjectChangedEvent:
+		return responses.NewProjectResponse(event.Project), nil
+	case *domain.NotificationCreatedEvent:
+		return responses.NotificationPushResponse{
+			Notification: responses.NewNotificationResponse(event.Notification),
+			UnreadCount:  event.UnreadCount,
+		}, nil
+	case *domain.NotificationsReadEvent:
+		return responses.NotificationMarkReadResponse{
+			IDs:         event.IDs,
+			UnreadCount: event.UnreadCount,
+		}, nil
+	}
+
+	return nil, fmt.Errorf("unknown event type %T", event)
+}
@@ -3,33 +3,46 @@ package ws
 import (
 	"context"
 	"encoding/json"
+	"errors"
+	"sync"
+	"time"
 
 	"github.com/fatihsen-dev/kanban-backend/internal/adapters/driver/http/datatransfers/responses"
+	"github.com/fatihsen-dev/kanban-backend/internal/core/domain"
 	ports "github.com/fatihsen-dev/kanban-backend/internal/core/ports/driver"
 )
 
+const (
+	broadcastBufferSize = 256
+	broadcastTimeout    = 5 * time.Second
+)
+
+var errBroadcastTimeout = errors.New("hub did not accept the broadcast in time")
+
 type BroadcastMessage struct {
 	ProjectID string
+	UserID    string
 	Data      []byte
 }
 
 type Hub struct {
+	mu                   sync.RWMutex
 	clients              map[string]*Client
 	broadcast            chan BroadcastMessage
 	register             chan *Client
 	unregister           chan *Client
 	ctx                  context.Context
-	projectMemberService ports.ProjectMemberService
+	projectAccessService ports.ProjectAccessService
 }
 
-func NewHub(projectMemberService ports.ProjectMemberService) *Hub {
+func NewHub(projectAccessService ports.ProjectAccessService) *Hub {
 	return &Hub{
-		broadcast:            make(chan BroadcastMessage),
+		broadcast:            make(chan BroadcastMessage, broadcastBufferSize),
 		register:             make(chan *Client),
 		unregister:           make(chan *Client),
 		clients:              make(map[string]*Client),
 		ctx:                  context.Background(),
-		projectMemberService: projectMemberService,
+		projectAccessService: projectAccessService,
 	}
 }
 
@@ -37,56 +50,104 @@ func (h *Hub) Run() {
 	for {
 		select {
 		case client := <-h.register:
+			h.mu.Lock()
 			h.clients[client.userID] = client
+			h.mu.Unlock()
 			go client.SendUserStatusEvent("online")
 		case client := <-h.unregister:
+			h.mu.Lock()
 			if client, ok := h.clients[client.userID]; ok {
 				go client.SendUserStatusEvent("offline")
 				delete(h.clients, client.userID)
 				close(client.send)
 			}
+			h.mu.Unlock()
 		case message := <-h.broadcast:
-			if message.ProjectID == "" {
-				continue
-			}
+			h.mu.Lock()
+			h.deliver(message)
+			h.mu.Unlock()
+		}
+	}
+}
 
-			for userID, client := range h.clients {
-				if client.projectID == nil || *client.projectID != message.ProjectID {
-					continue
-				}
-
-				select {
-				case client.send <- message.Data:
-				default:
-					close(client.send)
-					delete(h.clients, userID)
-				}
-			}
+func (h *Hub) deliver(message BroadcastMessage) {
+	if message.UserID != "" {
+		h.sendToClient(message.UserID, message.Data)
+		return
+	}
+
+	if message.ProjectID == "" {
+		return
+	}
+
+	for userID, client := range h.clients {
+		if client.projectID == nil || *client.projectID != message.ProjectID {
+			continue
+		}
+
+		select {
+		case client.send <- message.Data:
+		default:
+			close(client.send)
+			delete(h.clients, userID)
 		}
 	}
 }
 
-func (h *Hub) SendMessageToProject(projectID string, data BaseResponse) {
-	jsonData, err := json.Marshal(data)
+func (h *Hub) HandleEvent(ctx context.Context, event domain.Event) error {
+	data, err := eventData(event)
 	if err != nil {
-		return
+		return err
 	}
 
-	h.broadcast <- BroadcastMessage{
-		ProjectID: projectID,
+	jsonData, err := json.Marshal(BaseResponse{
+		Name: EventName(event.EventName()),
+		Data: data,
+	})
+	if err != nil {
+		return err
+	}
+
+	message := BroadcastMessage{
+		ProjectID: event.EventProjectID(),
 		Data:      jsonData,
 	}
+	if recipientEvent, ok := event.(domain.RecipientEvent); ok {
+		message = BroadcastMessage{
+			UserID: recipientEvent.EventRecipientID(),
+			Data:   jsonData,
+		}
+	}
+
+	timer := time.NewTimer(broadcastTimeout)
+	defer timer.Stop()
+
+	select {
+	case h.broadcast <- message:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return errBroadcastTimeout
+	}
 }
 
-func (h *Hub) SendMessageToUser(userID string, data BaseResponse) {
+func (h *Hub) broadcastToProject(projectID string, data BaseResponse) {
 	jsonData, err := json.Marshal(data)
 	if err != nil {
 		return
 	}
 
+	h.broadcast <- BroadcastMessage{
+		ProjectID: projectID,
+		Data:      jsonData,
+	}
+}
+
+func (h *Hub) sendToClient(userID string, data []byte) {
 	if client, ok := h.clients[userID]; ok {
 		select {
-		case client.send <- jsonData:
+		case client.send <- data:
 		default:
 			close(client.send)
 			delete(h.clients, userID)
@@ -95,6 +156,9 @@ func (h *Hub) SendMessageToUser(userID string, data BaseResponse) {
 }
 
 func (h *Hub) GetOnlineUsers(projectID string) []responses.OnlineProjectMembersResponse {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
 	onlineUsers := []responses.OnlineProjectMembersResponse{}
 	for _, client := range h.clients {
 		if client.projectID != nil && *client.projectID == projectID {
@@ -40,7 +40,7 @@ func ServeWs(hub *Hub, c *gin.Context) {
 	}
 
 	if projectID != "" {
-		_, err = middlewares.CheckAccess(user.ID, projectID, c.Request.Context(), hub.projectMemberService)
+		_, err = middlewares.CheckAccess(user.ID, projectID, c.Request.Context(), hub.projectAccessService)
 		if err != nil {
 			return
 		}
@@ -0,0 +1,223 @@
+package domain
+
+import (
+	"context"
+	"reflect"
+	"time"
+)
+
+type EntityType string
+
+const (
+	EntityTask          EntityType = "task"
+	EntityColumn        EntityType = "column"
+	EntityTeam          EntityType = "team"
+	EntityProjectMember EntityType = "project_member"
+	EntityInvitation    EntityType = "invitation"
+	EntityProject       EntityType = "project"
+)
+
+type ActivityAction string
+
+const (
+	ActivityActionCreated    ActivityAction = "created"
+	ActivityActionUpdated    ActivityAction = "updated"
+	ActivityActionMoved      ActivityAction = "moved"
+	ActivityActionArchived   ActivityAction = "archived"
+	ActivityActionRestored   ActivityAction = "restored"
+	ActivityActionDeleted    ActivityAction = "deleted"
+	ActivityActionAccepted   ActivityAction = "accepted"
+	ActivityActionRejected   ActivityAction = "rejected"
+	ActivityActionReferenced ActivityAction = "referenced"
+)
+
+const (
+	DefaultActivityPageSize = 50
+	MaxActivityPageSize     = 200
+)
+
+type actorContextKey struct{}
+
+type FieldChange struct {
+	Before interface{}
+	After  interface{}
+}
+
+type Activity struct {
+	ID         string
+	ProjectID  string
+	ActorID    *string
+	EntityType EntityType
+	EntityID   string
+	Action     ActivityAction
+	Changes    map[string]FieldChange
+	CreatedAt  time.Time
+}
+
+type ActivityFilter struct {
+	ProjectID  string
+	EntityType EntityType
+	EntityID   string
+	Limit      int
+	Cursor     string
+}
+
+func (f *ActivityFilter) PageSize() int {
+	if f.Limit <= 0 {
+		return DefaultActivityPageSize
+	}
+	if f.Limit > MaxActivityPageSize {
+		return MaxActivityPageSize
+	}
+	return f.Limit
+}
+
+type ActivityPage struct {
+	Activities []*Activity
+	NextCursor string
+}
+
+func ContextWithActor(ctx context.Context, userID string) context.Context {
+	return context.WithValue(ctx, actorContextKey{}, userID)
+}
+
+func ActorFromContext(ctx context.Context) *string {
+	userID, ok := ctx.Value(actorContextKey{}).(string)
+	if !ok || userID == "" {
+		return nil
+	}
+	return &userID
+}
+
+func DiffSnapshots(before, after map[string]interface{}) map[string]FieldChange {
+	changes := make(map[string]FieldChange)
+
+	for field, value := range after {
+		previous, ok := before[field]
+		if !ok || !reflect.DeepEqual(previous, value) {
+			changes[field] = FieldChange{Before: previous, After: value}
+		}
+	}
+
+	for field, value := range before {
+		if _, ok := after[field]; !ok {
+			changes[field] = FieldChange{Before: value}
+		}
+	}
+
+	return changes
+}
+
+func (t *Task) Snapshot() map[string]interface{} {
+	return map[string]interface{}{
+		"title":         t.Title,
+		"content":       stringValue(t.Content),
+		"column_id":     t.ColumnID,
+		"lane_id":       stringValue(t.LaneID),
+		"assignee_id":   stringValue(t.AssigneeID),
+		"labels":        stringSliceValue(t.Labels),
+		"priority":      string(t.Priority),
+		"estimate":      floatValue(t.Estimate),
+		"custom_fields": customFieldsValue(t.CustomFields),
+		"due_date":      timeValue(t.DueDate),
+		"completed_at":  timeValue(t.CompletedAt),
+	}
+}
+
+func (c *Column) Snapshot() map[string]interface{} {
+	return map[string]interface{}{
+		"name":      c.Name,
+		"color":     stringValue(c.Color),
+		"wip_limit": intValue(c.WipLimit),
+		"category":  string(c.Category),
+	}
+}
+
+func (t *Team) Snapshot() map[string]interface{} {
+	return map[string]interface{}{
+		"name":    t.Name,
+		"role":    string(t.Role),
+		"role_id": stringValue(t.RoleID),
+	}
+}
+
+func (m *ProjectMember) Snapshot() map[string]interface{} {
+	return map[string]interface{}{
+		"user_id": m.UserID,
+		"team_id": stringValue(m.TeamID),
+		"role":    string(m.Role),
+		"role_id": stringValue(m.RoleID),
+	}
+}
+
+func (i *Invitation) Snapshot() map[string]interface{} {
+	return map[string]interface{}{
+		"inviter_id": i.InviterID,
+		"invitee_id": i.InviteeID,
+		"message":    stringValue(i.Message),
+		"status":     string(i.Status),
+	}
+}
+
+func (p *Project) Snapshot() map[string]interface{} {
+	return map[string]interface{}{
+		"name":                p.Name,
+		"key":                 p.Key,
+		"wip_enforcement":     string(p.WipEnforcement),
+		"lane_grouping":       string(p.LaneGrouping),
+		"blocker_enforcement": string(p.BlockerEnforcement),
+	}
+}
+
+func (c *PushCommit) Snapshot(event *PushEvent) map[string]interface{} {
+	return map[string]interface{}{
+		"repository":     event.Repository,
+		"ref":            event.Ref,
+		"commit_id":      c.ID,
+		"commit_message": c.Message,
+		"commit_url":     c.URL,
+		"commit_author":  c.AuthorName,
+	}
+}
+
+func stringValue(value *string) interface{} {
+	if value == nil {
+		return nil
+	}
+	return *value
+}
+
+func intValue(value *int) interface{} {
+	if value == nil {
+		return nil
+	}
+	return *value
+}
+
+func floatValue(value *float64) interface{} {
+	if value == nil {
+		return nil
+	}
+	return *value
+}
+
+func timeValue(value *time.Time) interface{} {
+	if value == nil {
+		return nil
+	}
+	return value.UTC().Format(time.RFC3339)
+}
+
+func stringSliceValue(values []string) []string {
+	if values == nil {
+		return []string{}
+	}
+	return values
+}
+
+func customFieldsValue(values map[string]interface{}) map[string]interface{} {
+	if values == nil {
+		return map[string]interface{}{}
+	}
+	return values
+}
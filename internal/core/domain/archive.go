@@ -0,0 +1,17 @@
+package domain
+
+type ArchiveState string
+
+const (
+	ArchiveStateActive   ArchiveState = "active"
+	ArchiveStateArchived ArchiveState = "archived"
+	ArchiveStateTrashed  ArchiveState = "trashed"
+)
+
+func IsValidArchiveState(state string) bool {
+	switch ArchiveState(state) {
+	case ArchiveStateArchived, ArchiveStateTrashed:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,164 @@
+package domain
+
+import "time"
+
+type AutomationTrigger string
+
+const (
+	AutomationTriggerTaskCreated       AutomationTrigger = "task.created"
+	AutomationTriggerTaskMoved         AutomationTrigger = "task.moved"
+	AutomationTriggerTaskDueDatePassed AutomationTrigger = "task.due_date_passed"
+)
+
+func IsValidAutomationTrigger(trigger string) bool {
+	switch AutomationTrigger(trigger) {
+	case AutomationTriggerTaskCreated, AutomationTriggerTaskMoved, AutomationTriggerTaskDueDatePassed:
+		return true
+	}
+	return false
+}
+
+type AutomationConditionType string
+
+const (
+	AutomationConditionColumnIs      AutomationConditionType = "column_is"
+	AutomationConditionFromColumnIs  AutomationConditionType = "from_column_is"
+	AutomationConditionLabelHas      AutomationConditionType = "label_has"
+	AutomationConditionPriorityIs    AutomationConditionType = "priority_is"
+	AutomationConditionAssigneeSet   AutomationConditionType = "assignee_set"
+	AutomationConditionAssigneeEmpty AutomationConditionType = "assignee_empty"
+)
+
+func IsValidAutomationConditionType(conditionType string) bool {
+	switch AutomationConditionType(conditionType) {
+	case AutomationConditionColumnIs, AutomationConditionFromColumnIs, AutomationConditionLabelHas,
+		AutomationConditionPriorityIs, AutomationConditionAssigneeSet, AutomationConditionAssigneeEmpty:
+		return true
+	}
+	return false
+}
+
+type AutomationActionType string
+
+const (
+	AutomationActionSetCompletedAt   AutomationActionType = "set_completed_at"
+	AutomationActionClearCompletedAt AutomationActionType = "clear_completed_at"
+	AutomationActionUnassign         AutomationActionType = "unassign"
+	AutomationActionAssignTo         AutomationActionType = "assign_to"
+	AutomationActionAddLabel         AutomationActionType = "add_label"
+	AutomationActionRemoveLabel      AutomationActionType = "remove_label"
+	AutomationActionSetPriority      AutomationActionType = "set_priority"
+	AutomationActionMoveToColumn     AutomationActionType = "move_to_column"
+)
+
+func IsValidAutomationActionType(actionType string) bool {
+	switch AutomationActionType(actionType) {
+	case AutomationActionSetCompletedAt, AutomationActionClearCompletedAt, AutomationActionUnassign, AutomationActionAssignTo,
+		AutomationActionAddLabel, AutomationActionRemoveLabel, AutomationActionSetPriority, AutomationActionMoveToColumn:
+		return true
+	}
+	return false
+}
+
+type AutomationCondition struct {
+	Type  AutomationConditionType
+	Value string
+}
+
+type AutomationAction struct {
+	Type  AutomationActionType
+	Value string
+}
+
+func (c AutomationCondition) RequiresValue() bool {
+	switch c.Type {
+	case AutomationConditionColumnIs, AutomationConditionFromColumnIs, AutomationConditionLabelHas, AutomationConditionPriorityIs:
+		return true
+	}
+	return false
+}
+
+func (a AutomationAction) RequiresValue() bool {
+	switch a.Type {
+	case AutomationActionAssignTo, AutomationActionAddLabel, AutomationActionRemoveLabel, AutomationActionSetPriority, AutomationActionMoveToColumn:
+		return true
+	}
+	return false
+}
+
+type AutomationRule struct {
+	ID         string
+	ProjectID  string
+	Name       string
+	Enabled    bool
+	Trigger    AutomationTrigger
+	Conditions []AutomationCondition
+	Actions    []AutomationAction
+	CreatedAt  time.Time
+}
+
+type TaskEvent struct {
+	Trigger          AutomationTrigger
+	Task             *Task
+	PreviousColumnID string
+}
+
+func (c AutomationCondition) IsMetBy(event *TaskEvent) bool {
+	task := event.Task
+	switch c.Type {
+	case AutomationConditionColumnIs:
+		return task.ColumnID == c.Value
+	case AutomationConditionFromColumnIs:
+		return event.PreviousColumnID == c.Value
+	case AutomationConditionLabelHas:
+		for _, label := range task.Labels {
+			if label == c.Value {
+				return true
+			}
+		}
+		return false
+	case AutomationConditionPriorityIs:
+		return string(task.Priority) == c.Value
+	case AutomationConditionAssigneeSet:
+		return task.AssigneeID != nil
+	case AutomationConditionAssigneeEmpty:
+		return task.AssigneeID == nil
+	}
+	return false
+}
+
+func (r *AutomationRule) Matches(event *TaskEvent) bool {
+	if !r.Enabled || r.Trigger != event.Trigger {
+		return false
+	}
+
+	for _, condition := range r.Conditions {
+		if !condition.IsMetBy(event) {
+			return false
+		}
+	}
+
+	return true
+}
+
+type AutomationExecutionStatus string
+
+const (
+	AutomationExecutionSucceeded AutomationExecutionStatus = "succeeded"
+	AutomationExecutionFailed    AutomationExecutionStatus = "failed"
+)
+
+type AutomationExecution struct {
+	ID        string
+	RuleID    string
+	TaskID    *string
+	Status    AutomationExecutionStatus
+	Message   *string
+	CreatedAt time.Time
+}
+
+type AutomationOutcome struct {
+	RuleID string
+	Task   *Task
+	Moved  bool
+}
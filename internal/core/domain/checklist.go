@@ -0,0 +1,23 @@
+package domain
+
+import "time"
+
+type ChecklistItem struct {
+	ID         string
+	TaskID     string
+	Text       string
+	Done       bool
+	AssigneeID *string
+	DueDate    *time.Time
+	Position   int
+	CreatedAt  time.Time
+}
+
+type ChecklistProgress struct {
+	Done  int
+	Total int
+}
+
+func (p ChecklistProgress) IsComplete() bool {
+	return p.Done == p.Total
+}
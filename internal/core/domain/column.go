@@ -2,10 +2,26 @@ package domain
 
 import "time"
 
+type ColumnCategory string
+
+const (
+	ColumnCategoryActive ColumnCategory = "active"
+	ColumnCategoryDone   ColumnCategory = "done"
+)
+
 type Column struct {
-	ID        string
-	Name      string
-	Color     *string
-	ProjectID string
-	CreatedAt time.Time
+	ID         string
+	Name       string
+	Color      *string
+	ProjectID  string
+	WipLimit   *int
+	Category   ColumnCategory
+	TaskCount  int
+	ArchivedAt *time.Time
+	DeletedAt  *time.Time
+	CreatedAt  time.Time
+}
+
+func (c *Column) IsDone() bool {
+	return c.Category == ColumnCategoryDone
 }
@@ -0,0 +1,103 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+)
+
+type TransitionCondition string
+
+const (
+	TransitionConditionAssigneeSet       TransitionCondition = "assignee_set"
+	TransitionConditionContentSet        TransitionCondition = "content_set"
+	TransitionConditionPrioritySet       TransitionCondition = "priority_set"
+	TransitionConditionChecklistComplete TransitionCondition = "checklist_complete"
+)
+
+func IsValidTransitionCondition(condition string) bool {
+	switch TransitionCondition(condition) {
+	case TransitionConditionAssigneeSet, TransitionConditionContentSet, TransitionConditionPrioritySet, TransitionConditionChecklistComplete:
+		return true
+	}
+	return false
+}
+
+func (c TransitionCondition) IsMetBy(task *Task) bool {
+	switch c {
+	case TransitionConditionAssigneeSet:
+		return task.AssigneeID != nil
+	case TransitionConditionContentSet:
+		return task.Content != nil && *task.Content != ""
+	case TransitionConditionPrioritySet:
+		return task.Priority != "" && task.Priority != TaskPriorityNone
+	case TransitionConditionChecklistComplete:
+		return task.Checklist.IsComplete()
+	}
+	return false
+}
+
+type ColumnTransition struct {
+	ID           string
+	ProjectID    string
+	FromColumnID string
+	ToColumnID   string
+	Conditions   []TransitionCondition
+	CreatedAt    time.Time
+}
+
+type TransitionError struct {
+	FromColumnID     string
+	ToColumnID       string
+	Allowed          bool
+	AllowedColumnIDs []string
+	UnmetConditions  []TransitionCondition
+}
+
+func (e *TransitionError) Error() string {
+	if !e.Allowed {
+		return "moving tasks between these columns is not allowed"
+	}
+	return fmt.Sprintf("task does not meet %d condition(s) required for this move", len(e.UnmetConditions))
+}
+
+func CheckTransition(transitions []*ColumnTransition, fromColumnID, toColumnID string, task *Task) error {
+	if len(transitions) == 0 {
+		return nil
+	}
+
+	var matched *ColumnTransition
+	allowedColumnIDs := make([]string, 0, len(transitions))
+	for _, transition := range transitions {
+		allowedColumnIDs = append(allowedColumnIDs, transition.ToColumnID)
+		if transition.ToColumnID == toColumnID {
+			matched = transition
+		}
+	}
+
+	if matched == nil {
+		return &TransitionError{
+			FromColumnID:     fromColumnID,
+			ToColumnID:       toColumnID,
+			AllowedColumnIDs: allowedColumnIDs,
+		}
+	}
+
+	var unmet []TransitionCondition
+	for _, condition := range matched.Conditions {
+		if !condition.IsMetBy(task) {
+			unmet = append(unmet, condition)
+		}
+	}
+
+	if len(unmet) > 0 {
+		return &TransitionError{
+			FromColumnID:     fromColumnID,
+			ToColumnID:       toColumnID,
+			Allowed:          true,
+			AllowedColumnIDs: allowedColumnIDs,
+			UnmetConditions:  unmet,
+		}
+	}
+
+	return nil
+}
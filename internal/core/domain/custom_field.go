@@ -0,0 +1,134 @@
+package domain
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"time"
+	"unicode/utf8"
+)
+
+type CustomFieldType string
+
+const (
+	CustomFieldTypeText         CustomFieldType = "text"
+	CustomFieldTypeNumber       CustomFieldType = "number"
+	CustomFieldTypeDate         CustomFieldType = "date"
+	CustomFieldTypeSingleSelect CustomFieldType = "single_select"
+	CustomFieldTypeMultiSelect  CustomFieldType = "multi_select"
+	CustomFieldTypeUser         CustomFieldType = "user"
+	CustomFieldTypeCheckbox     CustomFieldType = "checkbox"
+)
+
+const customFieldDateLayout = "2006-01-02"
+
+type CustomField struct {
+	ID        string
+	ProjectID string
+	Name      string
+	Type      CustomFieldType
+	Required  bool
+	Options   []string
+	MinValue  *float64
+	MaxValue  *float64
+	MaxLength *int
+	Position  int
+	CreatedAt time.Time
+}
+
+func (f *CustomField) IsSelect() bool {
+	return f.Type == CustomFieldTypeSingleSelect || f.Type == CustomFieldTypeMultiSelect
+}
+
+func (f *CustomField) IsValid() bool {
+	if f.IsSelect() != (len(f.Options) > 0) {
+		return false
+	}
+
+	if (f.MinValue != nil || f.MaxValue != nil) && f.Type != CustomFieldTypeNumber {
+		return false
+	}
+
+	if f.MinValue != nil && f.MaxValue != nil && *f.MinValue > *f.MaxValue {
+		return false
+	}
+
+	if f.MaxLength != nil && f.Type != CustomFieldTypeText {
+		return false
+	}
+
+	return true
+}
+
+func (f *CustomField) NormalizeValue(value interface{}) (interface{}, error) {
+	switch f.Type {
+	case CustomFieldTypeText:
+		text, ok := value.(string)
+		if ok && (f.MaxLength == nil || utf8.RuneCountInString(text) <= *f.MaxLength) && (text != "" || !f.Required) {
+			return text, nil
+		}
+	case CustomFieldTypeNumber:
+		number, ok := value.(float64)
+		if ok && (f.MinValue == nil || number >= *f.MinValue) && (f.MaxValue == nil || number <= *f.MaxValue) {
+			return number, nil
+		}
+	case CustomFieldTypeDate:
+		date, ok := value.(string)
+		if ok {
+			if _, err := time.Parse(customFieldDateLayout, date); err == nil {
+				return date, nil
+			}
+		}
+	case CustomFieldTypeSingleSelect, CustomFieldTypeUser:
+		option, ok := value.(string)
+		if ok && option != "" && (f.Type == CustomFieldTypeUser || slices.Contains(f.Options, option)) {
+			return option, nil
+		}
+	case CustomFieldTypeMultiSelect:
+		values, ok := value.([]interface{})
+		if ok && (len(values) > 0 || !f.Required) {
+			options := make([]string, 0, len(values))
+			for _, item := range values {
+				option, ok := item.(string)
+				if !ok || !slices.Contains(f.Options, option) {
+					return nil, f.invalidValue()
+				}
+				if !slices.Contains(options, option) {
+					options = append(options, option)
+				}
+			}
+			return options, nil
+		}
+	case CustomFieldTypeCheckbox:
+		checked, ok := value.(bool)
+		if ok {
+			return checked, nil
+		}
+	}
+
+	return nil, f.invalidValue()
+}
+
+func (f *CustomField) MatchValue(raw string) (interface{}, error) {
+	switch f.Type {
+	case CustomFieldTypeNumber:
+		number, err := strconv.ParseFloat(raw, 64)
+		if err != nil {
+			return nil, f.invalidValue()
+		}
+		return number, nil
+	case CustomFieldTypeCheckbox:
+		checked, err := strconv.ParseBool(raw)
+		if err != nil {
+			return nil, f.invalidValue()
+		}
+		return checked, nil
+	case CustomFieldTypeMultiSelect:
+		return []string{raw}, nil
+	}
+	return raw, nil
+}
+
+func (f *CustomField) invalidValue() error {
+	return fmt.Errorf("%w: %s", ErrInvalidCustomFieldValue, f.Name)
+}
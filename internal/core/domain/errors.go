@@ -0,0 +1,40 @@
+package domain
+
+import "errors"
+
+var (
+	ErrColumnNotFound           = errors.New("column not found")
+	ErrTaskNotFound             = errors.New("task not found")
+	ErrTeamNotFound             = errors.New("team not found")
+	ErrProjectMemberNotFound    = errors.New("project member not found")
+	ErrProjectOwnerMember       = errors.New("the project owner's membership cannot be changed")
+	ErrRoleExceedsPermissions   = errors.New("role grants permissions you do not have yourself")
+	ErrNotOrganizationMember    = errors.New("user is not a member of this organization")
+	ErrOrganizationAccessDenied = errors.New("you are not allowed to create projects in this organization")
+	ErrOrganizationAdminGrant   = errors.New("only the organization owner can grant or revoke the admin role")
+	ErrLastOrganizationAdmin    = errors.New("an organization needs at least one admin")
+	ErrColumnNotActive          = errors.New("column is archived or in trash")
+	ErrColumnNotEmpty           = errors.New("column still contains tasks, choose a target column to move them into")
+	ErrInvalidTargetColumn      = errors.New("target column must be a different active column of the same project")
+	ErrInvalidLane              = errors.New("lane does not belong to this project")
+	ErrInvalidAssignee          = errors.New("assignee must be a member of this project")
+	ErrInvalidTransition        = errors.New("transition columns must be two different columns of this project")
+	ErrWipLimitExceeded         = errors.New("column has reached its work-in-progress limit")
+	ErrInvalidTaskKey           = errors.New("task key must look like KEY-123")
+	ErrProjectKeyTaken          = errors.New("project key is already used by another project")
+	ErrTaskLinkCycle            = errors.New("link would create a cycle")
+	ErrInvalidTaskLink          = errors.New("a task cannot be linked to itself or to a task you cannot access")
+	ErrTaskBlocked              = errors.New("task has open blockers and cannot be moved to a done column")
+	ErrInvalidChecklistOrder    = errors.New("checklist order must list every item of the task exactly once")
+	ErrInvalidAutomationRule    = errors.New("automation rule is missing a value or references something outside this project")
+	ErrInvalidCustomField       = errors.New("select fields need options and limits must match the field type")
+	ErrInvalidCustomFieldValue  = errors.New("custom field value is missing, unknown or does not match the field rules")
+	ErrInvalidTaskCursor        = errors.New("cursor is malformed or was issued for a different sort order")
+	ErrTaskRevisionUnchanged    = errors.New("task already matches this revision")
+	ErrInvalidPageCursor        = errors.New("page cursor is malformed")
+	ErrInvalidUnsubscribeToken  = errors.New("unsubscribe link is invalid")
+	ErrInvalidWebhook           = errors.New("webhook needs an http(s) URL and at least one event")
+	ErrInvalidEmailDelivery     = errors.New("email delivery must be immediate, digest or off for a known notification type")
+	ErrInvalidIncomingWebhook   = errors.New("incoming webhook target column must be an active column of this project")
+	ErrInvalidPushSignature     = errors.New("push signature does not match the incoming webhook secret")
+)
@@ -0,0 +1,368 @@
+package domain
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+type EventName string
+
+const (
+	EventColumnCreated        EventName = "column.created"
+	EventColumnUpdated        EventName = "column.updated"
+	EventColumnDeleted        EventName = "column.deleted"
+	EventColumnArchived       EventName = "column.archived"
+	EventColumnRestored       EventName = "column.restored"
+	EventTransitionCreated    EventName = "transition.created"
+	EventTransitionUpdated    EventName = "transition.updated"
+	EventTransitionDeleted    EventName = "transition.deleted"
+	EventLaneCreated          EventName = "lane.created"
+	EventLaneUpdated          EventName = "lane.updated"
+	EventLaneDeleted          EventName = "lane.deleted"
+	EventTaskCreated          EventName = "task.created"
+	EventTaskUpdated          EventName = "task.updated"
+	EventTaskDeleted          EventName = "task.deleted"
+	EventTaskMoved            EventName = "task.moved"
+	EventTaskArchived         EventName = "task.archived"
+	EventTaskRestored         EventName = "task.restored"
+	EventTeamCreated          EventName = "team.created"
+	EventTeamUpdated          EventName = "team.updated"
+	EventTeamDeleted          EventName = "team.deleted"
+	EventTeamMembersAdded     EventName = "team.members.added"
+	EventInvitationCreated    EventName = "invitation.created"
+	EventProjectMemberCreated EventName = "project.member.created"
+	EventProjectMemberUpdated EventName = "project.member.updated"
+	EventProjectMemberDeleted EventName = "project.member.deleted"
+	EventProjectUpdated       EventName = "project.updated"
+	EventProjectDeleted       EventName = "project.deleted"
+	EventRoleCreated          EventName = "role.created"
+	EventRoleUpdated          EventName = "role.updated"
+	EventRoleDeleted          EventName = "role.deleted"
+	EventTaskLinkCreated      EventName = "task.link.created"
+	EventTaskLinkDeleted      EventName = "task.link.deleted"
+	EventTaskWatchersUpdated  EventName = "task.watchers.updated"
+	EventChecklistItemCreated EventName = "checklist.item.created"
+	EventChecklistItemUpdated EventName = "checklist.item.updated"
+	EventChecklistItemDeleted EventName = "checklist.item.deleted"
+	EventChecklistReordered   EventName = "checklist.reordered"
+	EventAutomationCreated    EventName = "automation.created"
+	EventAutomationUpdated    EventName = "automation.updated"
+	EventAutomationDeleted    EventName = "automation.deleted"
+	EventCustomFieldCreated   EventName = "custom_field.created"
+	EventCustomFieldUpdated   EventName = "custom_field.updated"
+	EventCustomFieldDeleted   EventName = "custom_field.deleted"
+	EventViewCreated          EventName = "view.created"
+	EventViewUpdated          EventName = "view.updated"
+	EventViewDeleted          EventName = "view.deleted"
+	EventNotificationCreated  EventName = "notification.created"
+	EventNotificationsRead    EventName = "notification.read"
+)
+
+var projectEventNames = map[EventName]bool{
+	EventColumnCreated:        true,
+	EventColumnUpdated:        true,
+	EventColumnDeleted:        true,
+	EventColumnArchived:       true,
+	EventColumnRestored:       true,
+	EventTransitionCreated:    true,
+	EventTransitionUpdated:    true,
+	EventTransitionDeleted:    true,
+	EventLaneCreated:          true,
+	EventLaneUpdated:          true,
+	EventLaneDeleted:          true,
+	EventTaskCreated:          true,
+	EventTaskUpdated:          true,
+	EventTaskDeleted:          true,
+	EventTaskMoved:            true,
+	EventTaskArchived:         true,
+	EventTaskRestored:         true,
+	EventTeamCreated:          true,
+	EventTeamUpdated:          true,
+	EventTeamDeleted:          true,
+	EventTeamMembersAdded:     true,
+	EventInvitationCreated:    true,
+	EventProjectMemberCreated: true,
+	EventProjectMemberUpdated: true,
+	EventProjectMemberDeleted: true,
+	EventProjectUpdated:       true,
+	EventProjectDeleted:       true,
+	EventRoleCreated:          true,
+	EventRoleUpdated:          true,
+	EventRoleDeleted:          true,
+	EventTaskLinkCreated:      true,
+	EventTaskLinkDeleted:      true,
+	EventTaskWatchersUpdated:  true,
+	EventChecklistItemCreated: true,
+	EventChecklistItemUpdated: true,
+	EventChecklistItemDeleted: true,
+	EventChecklistReordered:   true,
+	EventAutomationCreated:    true,
+	EventAutomationUpdated:    true,
+	EventAutomationDeleted:    true,
+	EventCustomFieldCreated:   true,
+	EventCustomFieldUpdated:   true,
+	EventCustomFieldDeleted:   true,
+	EventViewCreated:          true,
+	EventViewUpdated:          true,
+	EventViewDeleted:          true,
+}
+
+func IsProjectEvent(name string) bool {
+	return projectEventNames[EventName(name)]
+}
+
+type Event interface {
+	EventName() EventName
+	EventProjectID() string
+}
+
+type RecipientEvent interface {
+	Event
+	EventRecipientID() string
+}
+
+func DecodeEvent(name string, payload []byte) (Event, error) {
+	newEvent, ok := eventTypes[EventName(name)]
+	if !ok {
+		return nil, fmt.Errorf("unknown event %q", name)
+	}
+
+	event := newEvent()
+	err := json.Unmarshal(payload, event)
+	if err != nil {
+		return nil, err
+	}
+	return event, nil
+}
+
+var eventTypes = map[EventName]func() Event{
+	EventColumnCreated:        func() Event { return &ColumnChangedEvent{} },
+	EventColumnUpdated:        func() Event { return &ColumnChangedEvent{} },
+	EventColumnDeleted:        func() Event { return &ColumnDeletedEvent{} },
+	EventColumnArchived:       func() Event { return &EntityRemovedEvent{} },
+	EventColumnRestored:       func() Event { return &ColumnChangedEvent{} },
+	EventTransitionCreated:    func() Event { return &ColumnTransitionChangedEvent{} },
+	EventTransitionUpdated:    func() Event { return &ColumnTransitionChangedEvent{} },
+	EventTransitionDeleted:    func() Event { return &EntityRemovedEvent{} },
+	EventLaneCreated:          func() Event { return &LaneChangedEvent{} },
+	EventLaneUpdated:          func() Event { return &LaneChangedEvent{} },
+	EventLaneDeleted:          func() Event { return &EntityRemovedEvent{} },
+	EventTaskCreated:          func() Event { return &TaskChangedEvent{} },
+	EventTaskUpdated:          func() Event { return &TaskChangedEvent{} },
+	EventTaskDeleted:          func() Event { return &EntityRemovedEvent{} },
+	EventTaskMoved:            func() Event { return &TaskChangedEvent{} },
+	EventTaskArchived:         func() Event { return &EntityRemovedEvent{} },
+	EventTaskRestored:         func() Event { return &TaskChangedEvent{} },
+	EventTeamCreated:          func() Event { return &TeamChangedEvent{} },
+	EventTeamUpdated:          func() Event { return &TeamChangedEvent{} },
+	EventTeamDeleted:          func() Event { return &EntityRemovedEvent{} },
+	EventTeamMembersAdded:     func() Event { return &TeamMembersAddedEvent{} },
+	EventInvitationCreated:    func() Event { return &InvitationCreatedEvent{} },
+	EventProjectMemberCreated: func() Event { return &ProjectMemberChangedEvent{} },
+	EventProjectMemberUpdated: func() Event { return &ProjectMemberChangedEvent{} },
+	EventProjectMemberDeleted: func() Event { return &EntityRemovedEvent{} },
+	EventProjectUpdated:       func() Event { return &ProjectChangedEvent{} },
+	EventProjectDeleted:       func() Event { return &EntityRemovedEvent{} },
+	EventRoleCreated:          func() Event { return &ProjectRoleChangedEvent{} },
+	EventRoleUpdated:          func() Event { return &ProjectRoleChangedEvent{} },
+	EventRoleDeleted:          func() Event { return &EntityRemovedEvent{} },
+	EventTaskLinkCreated:      func() Event { return &TaskLinkChangedEvent{} },
+	EventTaskLinkDeleted:      func() Event { return &TaskLinkChangedEvent{} },
+	EventTaskWatchersUpdated:  func() Event { return &TaskWatchersChangedEvent{} },
+	EventChecklistItemCreated: func() Event { return &ChecklistItemChangedEvent{} },
+	EventChecklistItemUpdated: func() Event { return &ChecklistItemChangedEvent{} },
+	EventChecklistItemDeleted: func() Event { return &ChecklistItemChangedEvent{} },
+	EventChecklistReordered:   func() Event { return &ChecklistReorderedEvent{} },
+	EventAutomationCreated:    func() Event { return &AutomationRuleChangedEvent{} },
+	EventAutomationUpdated:    func() Event { return &AutomationRuleChangedEvent{} },
+	EventAutomationDeleted:    func() Event { return &EntityRemovedEvent{} },
+	EventCustomFieldCreated:   func() Event { return &CustomFieldChangedEvent{} },
+	EventCustomFieldUpdated:   func() Event { return &CustomFieldChangedEvent{} },
+	EventCustomFieldDeleted:   func() Event { return &EntityRemovedEvent{} },
+	EventViewCreated:          func() Event { return &SavedViewChangedEvent{} },
+	EventViewUpdated:          func() Event { return &SavedViewChangedEvent{} },
+	EventViewDeleted:          func() Event { return &EntityRemovedEvent{} },
+	EventNotificationCreated:  func() Event { return &NotificationCreatedEvent{} },
+	EventNotificationsRead:    func() Event { return &NotificationsReadEvent{} },
+}
+
+type EntityRemovedEvent struct {
+	Name      EventName
+	ProjectID string
+	ID        string
+}
+
+func (e *EntityRemovedEvent) EventName() EventName   { return e.Name }
+func (e *EntityRemovedEvent) EventProjectID() string { return e.ProjectID }
+
+type TaskChangedEvent struct {
+	Name EventName
+	Task *Task
+	Load *ColumnLoad
+}
+
+func (e *TaskChangedEvent) EventName() EventName   { return e.Name }
+func (e *TaskChangedEvent) EventProjectID() string { return e.Task.ProjectID }
+
+type TaskWatchersChangedEvent struct {
+	Task *Task
+}
+
+func (e *TaskWatchersChangedEvent) EventName() EventName   { return EventTaskWatchersUpdated }
+func (e *TaskWatchersChangedEvent) EventProjectID() string { return e.Task.ProjectID }
+
+type TaskLinkChangedEvent struct {
+	Name      EventName
+	ProjectID string
+	Link      *TaskLink
+}
+
+func (e *TaskLinkChangedEvent) EventName() EventName   { return e.Name }
+func (e *TaskLinkChangedEvent) EventProjectID() string { return e.ProjectID }
+
+type ChecklistItemChangedEvent struct {
+	Name      EventName
+	ProjectID string
+	Item      *ChecklistItem
+	Progress  ChecklistProgress
+}
+
+func (e *ChecklistItemChangedEvent) EventName() EventName   { return e.Name }
+func (e *ChecklistItemChangedEvent) EventProjectID() string { return e.ProjectID }
+
+type ChecklistReorderedEvent struct {
+	ProjectID string
+	TaskID    string
+	Items     []*ChecklistItem
+}
+
+func (e *ChecklistReorderedEvent) EventName() EventName   { return EventChecklistReordered }
+func (e *ChecklistReorderedEvent) EventProjectID() string { return e.ProjectID }
+
+type ColumnChangedEvent struct {
+	Name   EventName
+	Column *Column
+	Tasks  []*Task
+}
+
+func (e *ColumnChangedEvent) EventName() EventName   { return e.Name }
+func (e *ColumnChangedEvent) EventProjectID() string { return e.Column.ProjectID }
+
+type ColumnDeletedEvent struct {
+	Column         *Column
+	TargetColumnID *string
+	MovedTaskIDs   []string
+}
+
+func (e *ColumnDeletedEvent) EventName() EventName   { return EventColumnDeleted }
+func (e *ColumnDeletedEvent) EventProjectID() string { return e.Column.ProjectID }
+
+type ColumnTransitionChangedEvent struct {
+	Name       EventName
+	Transition *ColumnTransition
+}
+
+func (e *ColumnTransitionChangedEvent) EventName() EventName   { return e.Name }
+func (e *ColumnTransitionChangedEvent) EventProjectID() string { return e.Transition.ProjectID }
+
+type LaneChangedEvent struct {
+	Name EventName
+	Lane *Lane
+}
+
+func (e *LaneChangedEvent) EventName() EventName   { return e.Name }
+func (e *LaneChangedEvent) EventProjectID() string { return e.Lane.ProjectID }
+
+type CustomFieldChangedEvent struct {
+	Name  EventName
+	Field *CustomField
+}
+
+func (e *CustomFieldChangedEvent) EventName() EventName   { return e.Name }
+func (e *CustomFieldChangedEvent) EventProjectID() string { return e.Field.ProjectID }
+
+type AutomationRuleChangedEvent struct {
+	Name EventName
+	Rule *AutomationRule
+}
+
+func (e *AutomationRuleChangedEvent) EventName() EventName   { return e.Name }
+func (e *AutomationRuleChangedEvent) EventProjectID() string { return e.Rule.ProjectID }
+
+type ProjectRoleChangedEvent struct {
+	Name EventName
+	Role *ProjectRole
+}
+
+func (e *ProjectRoleChangedEvent) EventName() EventName   { return e.Name }
+func (e *ProjectRoleChangedEvent) EventProjectID() string { return e.Role.ProjectID }
+
+type SavedViewChangedEvent struct {
+	Name EventName
+	View *SavedView
+}
+
+func (e *SavedViewChangedEvent) EventName() EventName   { return e.Name }
+func (e *SavedViewChangedEvent) EventProjectID() string { return e.View.ProjectID }
+
+type TeamChangedEvent struct {
+	Name EventName
+	Team *Team
+}
+
+func (e *TeamChangedEvent) EventName() EventName   { return e.Name }
+func (e *TeamChangedEvent) EventProjectID() string { return e.Team.ProjectID }
+
+type TeamMembersAddedEvent struct {
+	Team      *Team
+	MemberIDs []string
+}
+
+func (e *TeamMembersAddedEvent) EventName() EventName   { return EventTeamMembersAdded }
+func (e *TeamMembersAddedEvent) EventProjectID() string { return e.Team.ProjectID }
+
+type InvitationCreatedEvent struct {
+	Invitation *Invitation
+	Invitee    *User
+	Inviter    *User
+	Project    *Project
+}
+
+func (e *InvitationCreatedEvent) EventName() EventName     { return EventInvitationCreated }
+func (e *InvitationCreatedEvent) EventProjectID() string   { return e.Invitation.ProjectID }
+func (e *InvitationCreatedEvent) EventRecipientID() string { return e.Invitation.InviteeID }
+
+type ProjectMemberChangedEvent struct {
+	Name   EventName
+	Member *ProjectMember
+	User   *User
+}
+
+func (e *ProjectMemberChangedEvent) EventName() EventName   { return e.Name }
+func (e *ProjectMemberChangedEvent) EventProjectID() string { return e.Member.ProjectID }
+
+type ProjectChangedEvent struct {
+	Project *Project
+}
+
+func (e *ProjectChangedEvent) EventName() EventName   { return EventProjectUpdated }
+func (e *ProjectChangedEvent) EventProjectID() string { return e.Project.ID }
+
+type NotificationCreatedEvent struct {
+	Notification *Notification
+	UnreadCount  int
+}
+
+func (e *NotificationCreatedEvent) EventName() EventName     { return EventNotificationCreated }
+func (e *NotificationCreatedEvent) EventProjectID() string   { return e.Notification.ProjectID }
+func (e *NotificationCreatedEvent) EventRecipientID() string { return e.Notification.RecipientID }
+
+type NotificationsReadEvent struct {
+	RecipientID string
+	IDs         []string
+	UnreadCount int
+}
+
+func (e *NotificationsReadEvent) EventName() EventName     { return EventNotificationsRead }
+func (e *NotificationsReadEvent) EventProjectID() string   { return "" }
+func (e *NotificationsReadEvent) EventRecipientID() string { return e.RecipientID }
@@ -0,0 +1,85 @@
+package domain
+
+import (
+	"crypto/hmac"
+	"regexp"
+	"strings"
+	"time"
+)
+
+var (
+	commitReferencePattern = regexp.MustCompile(`(?i)\b(close[sd]?|fix(?:e[sd])?|resolve[sd]?|refs?|references|see)\b\s*:?\s*((?:[A-Za-z][A-Za-z0-9]{1,9}-\d+|[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12})(?:\s*(?:,|and)\s*(?:[A-Za-z][A-Za-z0-9]{1,9}-\d+|[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}))*)`)
+	taskReferencePattern   = regexp.MustCompile(`[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}|[A-Za-z][A-Za-z0-9]{1,9}-\d+`)
+)
+
+const PushSignatureHeader = "X-Hub-Signature-256"
+
+type IncomingWebhook struct {
+	ProjectID      string
+	Secret         string
+	TargetColumnID *string
+	CreatedAt      time.Time
+}
+
+func (w *IncomingWebhook) VerifySignature(signature string, payload []byte) bool {
+	return hmac.Equal([]byte(signature), []byte(SignWebhookPayload(w.Secret, payload)))
+}
+
+type PushCommit struct {
+	ID         string
+	Message    string
+	URL        string
+	AuthorName string
+}
+
+type PushEvent struct {
+	Repository string
+	Ref        string
+	Commits    []PushCommit
+}
+
+type CommitReference struct {
+	Ref    string
+	Closes bool
+}
+
+func (r CommitReference) IsTaskKey() bool {
+	_, _, ok := ParseTaskKey(r.Ref)
+	return ok
+}
+
+func ParseCommitReferences(message string) []CommitReference {
+	references := []CommitReference{}
+	index := map[string]int{}
+
+	for _, match := range commitReferencePattern.FindAllStringSubmatch(message, -1) {
+		keyword := strings.ToLower(match[1])
+		closes := !strings.HasPrefix(keyword, "ref") && keyword != "see"
+
+		for _, ref := range taskReferencePattern.FindAllString(match[2], -1) {
+			ref = strings.ToUpper(ref)
+			if strings.Count(ref, "-") == 4 {
+				ref = strings.ToLower(ref)
+			}
+
+			if i, ok := index[ref]; ok {
+				references[i].Closes = references[i].Closes || closes
+				continue
+			}
+			index[ref] = len(references)
+			references = append(references, CommitReference{Ref: ref, Closes: closes})
+		}
+	}
+
+	return references
+}
+
+type PushTaskResult struct {
+	Ref      string
+	CommitID string
+	Task     *Task
+	Closes   bool
+	Moved    bool
+	Result   *TaskResult
+	Error    error
+}
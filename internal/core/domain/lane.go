@@ -0,0 +1,40 @@
+package domain
+
+import "time"
+
+type LaneGrouping string
+
+const (
+	LaneGroupingCustom   LaneGrouping = "custom"
+	LaneGroupingAssignee LaneGrouping = "assignee"
+	LaneGroupingLabel    LaneGrouping = "label"
+	LaneGroupingPriority LaneGrouping = "priority"
+)
+
+type Lane struct {
+	ID        string
+	Name      string
+	ProjectID string
+	Position  int
+	CreatedAt time.Time
+}
+
+func (t *Task) LaneKey(grouping LaneGrouping) string {
+	switch grouping {
+	case LaneGroupingAssignee:
+		if t.AssigneeID != nil {
+			return *t.AssigneeID
+		}
+	case LaneGroupingLabel:
+		if len(t.Labels) > 0 {
+			return t.Labels[0]
+		}
+	case LaneGroupingPriority:
+		return string(t.Priority)
+	default:
+		if t.LaneID != nil {
+			return *t.LaneID
+		}
+	}
+	return ""
+}
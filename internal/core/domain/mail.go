@@ -0,0 +1,29 @@
+package domain
+
+import "time"
+
+const (
+	DigestInterval      = 24 * time.Hour
+	DigestDueSoonWindow = 72 * time.Hour
+	DigestTaskLimit     = 20
+)
+
+type MailMessage struct {
+	To       string
+	Subject  string
+	HTMLBody string
+	TextBody string
+}
+
+type Digest struct {
+	Recipient      *User
+	AssignedTasks  []*Task
+	DueSoon        []*Task
+	Notifications  []*Notification
+	Since          time.Time
+	UnsubscribeURL string
+}
+
+func (d *Digest) IsEmpty() bool {
+	return len(d.AssignedTasks) == 0 && len(d.DueSoon) == 0 && len(d.Notifications) == 0
+}
@@ -0,0 +1,98 @@
+package domain
+
+import (
+	"regexp"
+	"strings"
+	"unicode"
+)
+
+var mentionPattern = regexp.MustCompile(`(?:^|[^\p{L}\p{N}_@.])@([A-Za-z0-9._%+\-]+@[A-Za-z0-9\-]+(?:\.[A-Za-z0-9\-]+)*\.[A-Za-z]{2,}|[\p{L}\p{N}_.\-]*[\p{L}\p{N}_])`)
+
+type Mention struct {
+	Text   string
+	UserID string
+	Name   string
+}
+
+func ParseMentions(content string) []string {
+	tokens := []string{}
+	seen := map[string]bool{}
+	for _, match := range mentionPattern.FindAllStringSubmatch(content, -1) {
+		token := match[1]
+		if !seen[token] {
+			seen[token] = true
+			tokens = append(tokens, token)
+		}
+	}
+	return tokens
+}
+
+func ResolveMentions(content *string, members []*User) []Mention {
+	mentions := []Mention{}
+	if content == nil {
+		return mentions
+	}
+
+	for _, token := range ParseMentions(*content) {
+		var candidates []*User
+		if strings.Contains(token, "@") {
+			for _, member := range members {
+				if strings.EqualFold(member.Email, token) {
+					candidates = append(candidates, member)
+				}
+			}
+		} else {
+			normalizedToken := normalizeMentionName(token)
+			for _, member := range members {
+				localPart, _, _ := strings.Cut(member.Email, "@")
+				if normalizeMentionName(member.Name) == normalizedToken || normalizeMentionName(localPart) == normalizedToken {
+					candidates = append(candidates, member)
+				}
+			}
+		}
+
+		if len(candidates) == 1 {
+			mentions = append(mentions, Mention{Text: "@" + token, UserID: candidates[0].ID, Name: candidates[0].Name})
+		}
+	}
+
+	return mentions
+}
+
+func MentionedUserIDs(mentions []Mention) []string {
+	userIDs := []string{}
+	seen := map[string]bool{}
+	for _, mention := range mentions {
+		if !seen[mention.UserID] {
+			seen[mention.UserID] = true
+			userIDs = append(userIDs, mention.UserID)
+		}
+	}
+	return userIDs
+}
+
+func NewlyMentionedUserIDs(before, after *Task) []string {
+	previous := map[string]bool{}
+	if before != nil {
+		for _, mention := range before.Mentions {
+			previous[mention.UserID] = true
+		}
+	}
+
+	userIDs := []string{}
+	for _, userID := range MentionedUserIDs(after.Mentions) {
+		if !previous[userID] {
+			userIDs = append(userIDs, userID)
+		}
+	}
+	return userIDs
+}
+
+func normalizeMentionName(name string) string {
+	return strings.Map(func(r rune) rune {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			return unicode.ToLower(r)
+		}
+		return -1
+	}, name)
+}
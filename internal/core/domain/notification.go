@@ -0,0 +1,108 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+)
+
+type NotificationType string
+
+const (
+	NotificationInvitationReceived NotificationType = "invitation_received"
+	NotificationTaskAssigned       NotificationType = "task_assigned"
+	NotificationMentioned          NotificationType = "mentioned"
+	NotificationWatchedTaskComment NotificationType = "watched_task_comment"
+	NotificationDueDateReminder    NotificationType = "due_date_reminder"
+)
+
+const (
+	DefaultNotificationPageSize = 30
+	MaxNotificationPageSize     = 100
+	DueDateReminderWindow       = 24 * time.Hour
+)
+
+type Notification struct {
+	ID          string
+	RecipientID string
+	ActorID     *string
+	ProjectID   string
+	Type        NotificationType
+	EntityType  EntityType
+	EntityID    string
+	Message     string
+	ReadAt      *time.Time
+	CreatedAt   time.Time
+}
+
+func (n *Notification) IsRead() bool {
+	return n.ReadAt != nil
+}
+
+type NotificationFilter struct {
+	RecipientID string
+	UnreadOnly  bool
+	Since       *time.Time
+	Types       []NotificationType
+	Limit       int
+	Cursor      string
+}
+
+func (f *NotificationFilter) PageSize() int {
+	if f.Limit <= 0 {
+		return DefaultNotificationPageSize
+	}
+	if f.Limit > MaxNotificationPageSize {
+		return MaxNotificationPageSize
+	}
+	return f.Limit
+}
+
+type NotificationPage struct {
+	Notifications []*Notification
+	NextCursor    string
+	UnreadCount   int
+}
+
+func NewTaskAssignedNotification(task *Task) *Notification {
+	return &Notification{
+		RecipientID: *task.AssigneeID,
+		ProjectID:   task.ProjectID,
+		Type:        NotificationTaskAssigned,
+		EntityType:  EntityTask,
+		EntityID:    task.ID,
+		Message:     fmt.Sprintf("You were assigned to %s: %s", task.Key(), task.Title),
+	}
+}
+
+func NewMentionedNotification(task *Task, userID string) *Notification {
+	return &Notification{
+		RecipientID: userID,
+		ProjectID:   task.ProjectID,
+		Type:        NotificationMentioned,
+		EntityType:  EntityTask,
+		EntityID:    task.ID,
+		Message:     fmt.Sprintf("You were mentioned in %s: %s", task.Key(), task.Title),
+	}
+}
+
+func NewDueDateReminderNotification(task *Task) *Notification {
+	return &Notification{
+		RecipientID: *task.AssigneeID,
+		ProjectID:   task.ProjectID,
+		Type:        NotificationDueDateReminder,
+		EntityType:  EntityTask,
+		EntityID:    task.ID,
+		Message:     fmt.Sprintf("%s: %s is due %s", task.Key(), task.Title, task.DueDate.UTC().Format("Jan 2 15:04 MST")),
+	}
+}
+
+func NewInvitationReceivedNotification(invitation *Invitation, project *Project) *Notification {
+	return &Notification{
+		RecipientID: invitation.InviteeID,
+		ProjectID:   invitation.ProjectID,
+		Type:        NotificationInvitationReceived,
+		EntityType:  EntityInvitation,
+		EntityID:    invitation.ID,
+		Message:     fmt.Sprintf("You were invited to %s", project.Name),
+	}
+}
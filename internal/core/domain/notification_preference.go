@@ -0,0 +1,63 @@
+package domain
+
+type EmailDelivery string
+
+const (
+	EmailDeliveryImmediate EmailDelivery = "immediate"
+	EmailDeliveryDigest    EmailDelivery = "digest"
+	EmailDeliveryOff       EmailDelivery = "off"
+)
+
+const NotificationDailyDigest NotificationType = "daily_digest"
+
+var NotificationPreferenceTypes = []NotificationType{
+	NotificationInvitationReceived,
+	NotificationTaskAssigned,
+	NotificationMentioned,
+	NotificationWatchedTaskComment,
+	NotificationDueDateReminder,
+	NotificationDailyDigest,
+}
+
+var defaultEmailDeliveries = map[NotificationType]EmailDelivery{
+	NotificationInvitationReceived: EmailDeliveryImmediate,
+	NotificationTaskAssigned:       EmailDeliveryImmediate,
+	NotificationMentioned:          EmailDeliveryImmediate,
+	NotificationWatchedTaskComment: EmailDeliveryDigest,
+	NotificationDueDateReminder:    EmailDeliveryDigest,
+	NotificationDailyDigest:        EmailDeliveryDigest,
+}
+
+func IsValidEmailDelivery(notificationType NotificationType, delivery string) bool {
+	if _, ok := defaultEmailDeliveries[notificationType]; !ok {
+		return false
+	}
+
+	switch EmailDelivery(delivery) {
+	case EmailDeliveryDigest, EmailDeliveryOff:
+		return true
+	case EmailDeliveryImmediate:
+		return notificationType != NotificationDailyDigest
+	}
+	return false
+}
+
+type NotificationPreferences map[NotificationType]EmailDelivery
+
+func (p NotificationPreferences) EmailDelivery(notificationType NotificationType) EmailDelivery {
+	if delivery, ok := p[notificationType]; ok {
+		return delivery
+	}
+	if delivery, ok := defaultEmailDeliveries[notificationType]; ok {
+		return delivery
+	}
+	return EmailDeliveryOff
+}
+
+func (p NotificationPreferences) WithDefaults() NotificationPreferences {
+	preferences := make(NotificationPreferences, len(NotificationPreferenceTypes))
+	for _, notificationType := range NotificationPreferenceTypes {
+		preferences[notificationType] = p.EmailDelivery(notificationType)
+	}
+	return preferences
+}
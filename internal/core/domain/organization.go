@@ -0,0 +1,59 @@
+package domain
+
+import "time"
+
+type OrganizationRole string
+
+const (
+	OrganizationOwnerRole  OrganizationRole = "owner"
+	OrganizationAdminRole  OrganizationRole = "admin"
+	OrganizationMemberRole OrganizationRole = "member"
+)
+
+var organizationRoleRanks = map[OrganizationRole]int{
+	OrganizationOwnerRole:  3,
+	OrganizationAdminRole:  2,
+	OrganizationMemberRole: 1,
+}
+
+func (r OrganizationRole) AtLeast(role OrganizationRole) bool {
+	return organizationRoleRanks[r] >= organizationRoleRanks[role]
+}
+
+type Organization struct {
+	ID        string
+	Name      string
+	OwnerID   string
+	CreatedAt time.Time
+}
+
+type OrganizationMember struct {
+	ID             string
+	OrganizationID string
+	UserID         string
+	Role           OrganizationRole
+	CreatedAt      time.Time
+}
+
+type OrganizationTeam struct {
+	ID             string
+	Name           string
+	OrganizationID string
+	MemberIDs      []string
+	CreatedAt      time.Time
+}
+
+type OrganizationTeamProject struct {
+	TeamID    string
+	ProjectID string
+	Role      AccessRole
+	CreatedAt time.Time
+}
+
+type OrganizationDetails struct {
+	Organization *Organization
+	Members      []*OrganizationMember
+	Users        []*User
+	Teams        []*OrganizationTeam
+	TeamProjects []*OrganizationTeamProject
+}
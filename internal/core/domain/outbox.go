@@ -0,0 +1,81 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+type OutboxEventStatus string
+
+const (
+	OutboxEventPending   OutboxEventStatus = "pending"
+	OutboxEventPublished OutboxEventStatus = "published"
+	OutboxEventFailed    OutboxEventStatus = "failed"
+)
+
+const (
+	OutboxMaxAttempts    = 10
+	OutboxInitialBackoff = time.Second
+	OutboxMaxBackoff     = 5 * time.Minute
+	OutboxLease          = 30 * time.Second
+	OutboxBatchSize      = 100
+	OutboxRetention      = 7 * 24 * time.Hour
+)
+
+type outboxEventContextKey struct{}
+
+type OutboxEvent struct {
+	ID          int64
+	ProjectID   string
+	Sequence    int64
+	RecipientID *string
+	Name        string
+	Payload     []byte
+	Status      OutboxEventStatus
+	Attempts    int
+	Error       *string
+	AvailableAt time.Time
+	PublishedAt *time.Time
+	CreatedAt   time.Time
+}
+
+func (e *OutboxEvent) MarkPublished(now time.Time) {
+	e.Status = OutboxEventPublished
+	e.Error = nil
+	e.PublishedAt = &now
+}
+
+func (e *OutboxEvent) RecordFailure(err error, now time.Time) {
+	message := err.Error()
+	e.Attempts++
+	e.Error = &message
+	e.PublishedAt = nil
+
+	if e.Attempts >= OutboxMaxAttempts {
+		e.Status = OutboxEventFailed
+		return
+	}
+
+	e.Status = OutboxEventPending
+	e.AvailableAt = now.Add(OutboxBackoff(e.Attempts))
+}
+
+func OutboxBackoff(attempts int) time.Duration {
+	backoff := OutboxInitialBackoff
+	for i := 1; i < attempts; i++ {
+		backoff *= 2
+		if backoff >= OutboxMaxBackoff {
+			return OutboxMaxBackoff
+		}
+	}
+	return backoff
+}
+
+func ContextWithOutboxEvent(ctx context.Context, event *OutboxEvent) context.Context {
+	return context.WithValue(ctx, outboxEventContextKey{}, event)
+}
+
+func OutboxEventFromContext(ctx context.Context) *OutboxEvent {
+	event, _ := ctx.Value(outboxEventContextKey{}).(*OutboxEvent)
+	return event
+}
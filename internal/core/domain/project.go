@@ -5,8 +5,26 @@ import (
 )
 
 type Project struct {
-	ID        string
-	Name      string
-	OwnerID   string
-	CreatedAt time.Time
+	ID                 string
+	Name               string
+	Key                string
+	OwnerID            string
+	OrganizationID     *string
+	WipEnforcement     WipEnforcement
+	LaneGrouping       LaneGrouping
+	BlockerEnforcement BlockerEnforcement
+	CreatedAt          time.Time
+}
+
+type ProjectDetails struct {
+	Project              *Project
+	Columns              []*Column
+	Lanes                []*Lane
+	TasksByColumn        map[string][]*Task
+	TasksByColumnAndLane map[string]map[string][]*Task
+	Teams                []*Team
+	Members              []*ProjectMember
+	Users                []*User
+	Roles                []*ProjectRole
+	Transitions          []*ColumnTransition
 }
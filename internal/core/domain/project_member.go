@@ -8,5 +8,6 @@ type ProjectMember struct {
 	UserID    string
 	ProjectID string
 	Role      AccessRole
+	RoleID    *string
 	CreatedAt time.Time
 }
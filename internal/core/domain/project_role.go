@@ -0,0 +1,107 @@
+package domain
+
+import (
+	"slices"
+	"time"
+)
+
+type Permission string
+
+const (
+	PermissionProjectView   Permission = "project.view"
+	PermissionProjectManage Permission = "project.manage"
+	PermissionProjectDelete Permission = "project.delete"
+	PermissionColumnManage  Permission = "column.manage"
+	PermissionTaskCreate    Permission = "task.create"
+	PermissionTaskUpdate    Permission = "task.update"
+	PermissionTaskMove      Permission = "task.move"
+	PermissionTaskDelete    Permission = "task.delete"
+	PermissionTeamManage    Permission = "team.manage"
+	PermissionMemberManage  Permission = "member.manage"
+	PermissionMemberInvite  Permission = "member.invite"
+	PermissionRoleManage    Permission = "role.manage"
+)
+
+var AllPermissions = []Permission{
+	PermissionProjectView,
+	PermissionProjectManage,
+	PermissionProjectDelete,
+	PermissionColumnManage,
+	PermissionTaskCreate,
+	PermissionTaskUpdate,
+	PermissionTaskMove,
+	PermissionTaskDelete,
+	PermissionTeamManage,
+	PermissionMemberManage,
+	PermissionMemberInvite,
+	PermissionRoleManage,
+}
+
+var accessRolePermissions = map[AccessRole][]Permission{
+	AccessOwnerRole: AllPermissions,
+	AccessAdminRole: {
+		PermissionProjectView,
+		PermissionProjectManage,
+		PermissionColumnManage,
+		PermissionTaskCreate,
+		PermissionTaskUpdate,
+		PermissionTaskMove,
+		PermissionTaskDelete,
+		PermissionMemberInvite,
+	},
+	AccessWriteRole: {
+		PermissionProjectView,
+		PermissionTaskCreate,
+		PermissionTaskUpdate,
+		PermissionTaskMove,
+		PermissionTaskDelete,
+	},
+	AccessReadRole: {
+		PermissionProjectView,
+	},
+}
+
+func (r AccessRole) Permissions() []Permission {
+	return accessRolePermissions[r]
+}
+
+func IsValidPermission(permission string) bool {
+	return slices.Contains(AllPermissions, Permission(permission))
+}
+
+type ProjectRole struct {
+	ID          string
+	Name        string
+	ProjectID   string
+	Permissions []Permission
+	CreatedAt   time.Time
+}
+
+type ProjectAccess struct {
+	ProjectID   string
+	UserID      string
+	Member      *ProjectMember
+	IsOwner     bool
+	Permissions []Permission
+}
+
+func (a *ProjectAccess) Can(permission Permission) bool {
+	return slices.Contains(a.Permissions, permission)
+}
+
+func (a *ProjectAccess) CanGrant(permissions []Permission) bool {
+	for _, permission := range permissions {
+		if !a.Can(permission) {
+			return false
+		}
+	}
+	return true
+}
+
+func (a *ProjectAccess) Grant(permissions []Permission) {
+	for _, permission := range permissions {
+		if !a.Can(permission) {
+			a.Permissions = append(a.Permissions, permission)
+		}
+	}
+}
@@ -0,0 +1,38 @@
+package domain
+
+import "time"
+
+type SavedViewVisibility string
+
+const (
+	SavedViewVisibilityPersonal SavedViewVisibility = "personal"
+	SavedViewVisibilityShared   SavedViewVisibility = "shared"
+)
+
+type SavedView struct {
+	ID            string
+	ProjectID     string
+	OwnerID       string
+	Name          string
+	Visibility    SavedViewVisibility
+	Filter        *TaskFilter
+	Grouping      LaneGrouping
+	VisibleFields []string
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
+
+func (v *SavedView) IsShared() bool {
+	return v.Visibility == SavedViewVisibilityShared
+}
+
+func (v *SavedView) CanView(userID string) bool {
+	return v.IsShared() || v.OwnerID == userID
+}
+
+func (v *SavedView) CanEdit(userID string, access *ProjectAccess) bool {
+	if v.OwnerID == userID {
+		return true
+	}
+	return v.IsShared() && access.Can(PermissionProjectManage)
+}
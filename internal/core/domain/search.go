@@ -0,0 +1,30 @@
+package domain
+
+type SearchResultType string
+
+const (
+	SearchResultTypeTask    SearchResultType = "task"
+	SearchResultTypeProject SearchResultType = "project"
+)
+
+const (
+	DefaultSearchLimit = 20
+	MaxSearchLimit     = 100
+)
+
+type SearchQuery struct {
+	Text       string
+	ProjectIDs []string
+	Types      []SearchResultType
+	Limit      int
+}
+
+type SearchResult struct {
+	Type      SearchResultType
+	ID        string
+	ProjectID string
+	Key       string
+	Title     string
+	Snippet   string
+	Rank      float64
+}
@@ -2,11 +2,136 @@ package domain
 
 import "time"
 
+type TaskPriority string
+
+const (
+	TaskPriorityNone   TaskPriority = "none"
+	TaskPriorityLow    TaskPriority = "low"
+	TaskPriorityMedium TaskPriority = "medium"
+	TaskPriorityHigh   TaskPriority = "high"
+	TaskPriorityUrgent TaskPriority = "urgent"
+)
+
+func IsValidTaskPriority(priority string) bool {
+	switch TaskPriority(priority) {
+	case TaskPriorityNone, TaskPriorityLow, TaskPriorityMedium, TaskPriorityHigh, TaskPriorityUrgent:
+		return true
+	}
+	return false
+}
+
 type Task struct {
-	ID        string
-	Title     string
-	Content   *string
-	ColumnID  string
-	ProjectID string
-	CreatedAt time.Time
+	ID           string
+	Number       int
+	ProjectKey   string
+	Title        string
+	Content      *string
+	ColumnID     string
+	ProjectID    string
+	LaneID       *string
+	AssigneeID   *string
+	Labels       []string
+	Priority     TaskPriority
+	Estimate     *float64
+	CustomFields map[string]interface{}
+	DueDate      *time.Time
+	CompletedAt  *time.Time
+	Checklist    ChecklistProgress
+	OpenBlockers int
+	Mentions     []Mention
+	Watchers     []string
+	ArchivedAt   *time.Time
+	DeletedAt    *time.Time
+	CreatedAt    time.Time
+}
+
+func (t *Task) WithChanges(changes *Task) *Task {
+	next := *t
+
+	if changes.Title != "" {
+		next.Title = changes.Title
+	}
+
+	if changes.Content != nil {
+		next.Content = changes.Content
+	}
+
+	if changes.ColumnID != "" {
+		next.ColumnID = changes.ColumnID
+	}
+
+	if changes.LaneID != nil {
+		next.LaneID = nilIfEmpty(changes.LaneID)
+	}
+
+	if changes.AssigneeID != nil {
+		next.AssigneeID = nilIfEmpty(changes.AssigneeID)
+	}
+
+	if changes.Labels != nil {
+		next.Labels = changes.Labels
+	}
+
+	if changes.Priority != "" {
+		next.Priority = changes.Priority
+	}
+
+	if changes.Estimate != nil {
+		next.Estimate = changes.Estimate
+		if *changes.Estimate == 0 {
+			next.Estimate = nil
+		}
+	}
+
+	if changes.CustomFields != nil {
+		next.CustomFields = changes.CustomFields
+	}
+
+	if changes.DueDate != nil {
+		next.DueDate = nilIfZero(changes.DueDate)
+	}
+
+	if changes.CompletedAt != nil {
+		next.CompletedAt = nilIfZero(changes.CompletedAt)
+	}
+
+	return &next
+}
+
+func (t *Task) HasChanges() bool {
+	return t.Title != "" || t.Content != nil || t.ColumnID != "" || t.LaneID != nil || t.AssigneeID != nil ||
+		t.Labels != nil || t.Priority != "" || t.Estimate != nil || t.CustomFields != nil || t.DueDate != nil || t.CompletedAt != nil
+}
+
+func (t *Task) IsBlocked() bool {
+	return t.OpenBlockers > 0
+}
+
+func TotalEstimate(tasks []*Task) float64 {
+	var total float64
+	for _, task := range tasks {
+		if task.Estimate != nil {
+			total += *task.Estimate
+		}
+	}
+	return total
+}
+
+type TaskResult struct {
+	Load        *ColumnLoad
+	Automations []*AutomationOutcome
+}
+
+func nilIfZero(value *time.Time) *time.Time {
+	if value == nil || value.IsZero() {
+		return nil
+	}
+	return value
+}
+
+func nilIfEmpty(value *string) *string {
+	if value == nil || *value == "" {
+		return nil
+	}
+	return value
 }
@@ -0,0 +1,87 @@
+package domain
+
+import (
+	"slices"
+	"time"
+)
+
+type TaskSortField string
+
+const (
+	TaskSortCreatedAt TaskSortField = "created_at"
+	TaskSortPriority  TaskSortField = "priority"
+	TaskSortEstimate  TaskSortField = "estimate"
+	TaskSortDueDate   TaskSortField = "due_date"
+	TaskSortTitle     TaskSortField = "title"
+	TaskSortNumber    TaskSortField = "number"
+)
+
+const CurrentUserAssignee = "me"
+
+const (
+	DefaultTaskPageSize = 50
+	MaxTaskPageSize     = 200
+)
+
+var taskPriorityOrder = []TaskPriority{TaskPriorityNone, TaskPriorityLow, TaskPriorityMedium, TaskPriorityHigh, TaskPriorityUrgent}
+
+func IsValidTaskSortField(field string) bool {
+	switch TaskSortField(field) {
+	case TaskSortCreatedAt, TaskSortPriority, TaskSortEstimate, TaskSortDueDate, TaskSortTitle, TaskSortNumber:
+		return true
+	}
+	return false
+}
+
+func (p TaskPriority) Rank() int {
+	return slices.Index(taskPriorityOrder, p) + 1
+}
+
+type TaskFilter struct {
+	ProjectID        string
+	State            ArchiveState
+	ColumnIDs        []string
+	AssigneeIDs      []string
+	Unassigned       bool
+	Labels           []string
+	Priorities       []TaskPriority
+	MinEstimate      *float64
+	MaxEstimate      *float64
+	DueAfter         *time.Time
+	DueBefore        *time.Time
+	CreatedAfter     *time.Time
+	CreatedBefore    *time.Time
+	Text             string
+	CustomFields     map[string]string
+	CustomFieldMatch map[string]interface{}
+	SortBy           TaskSortField
+	SortDesc         bool
+	Limit            int
+	Cursor           string
+}
+
+func (f *TaskFilter) PageSize() int {
+	if f.Limit <= 0 {
+		return DefaultTaskPageSize
+	}
+	return min(f.Limit, MaxTaskPageSize)
+}
+
+func (f *TaskFilter) ForUser(userID string) *TaskFilter {
+	next := *f
+	if slices.Contains(f.AssigneeIDs, CurrentUserAssignee) {
+		next.AssigneeIDs = make([]string, len(f.AssigneeIDs))
+		for i, assigneeID := range f.AssigneeIDs {
+			if assigneeID == CurrentUserAssignee {
+				assigneeID = userID
+			}
+			next.AssigneeIDs[i] = assigneeID
+		}
+	}
+	return &next
+}
+
+type TaskPage struct {
+	Tasks      []*Task
+	NextCursor string
+}
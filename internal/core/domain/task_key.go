@@ -0,0 +1,66 @@
+package domain
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+var projectKeyPattern = regexp.MustCompile(`^[A-Z][A-Z0-9]{1,9}$`)
+
+func IsValidProjectKey(key string) bool {
+	return projectKeyPattern.MatchString(key)
+}
+
+func DeriveProjectKey(name string) string {
+	words := strings.FieldsFunc(strings.ToUpper(name), func(r rune) bool {
+		return r > unicode.MaxASCII || !unicode.IsLetter(r)
+	})
+
+	var key string
+	if len(words) > 1 {
+		for _, word := range words {
+			key += word[:1]
+		}
+	} else if len(words) == 1 {
+		key = words[0]
+	}
+
+	if len(key) > 4 {
+		key = key[:4]
+	}
+
+	if len(key) < 2 {
+		return "PRJ"
+	}
+
+	return key
+}
+
+func ParseTaskKey(key string) (string, int, bool) {
+	index := strings.LastIndex(key, "-")
+	if index <= 0 {
+		return "", 0, false
+	}
+
+	projectKey := strings.ToUpper(key[:index])
+	if !IsValidProjectKey(projectKey) {
+		return "", 0, false
+	}
+
+	number, err := strconv.Atoi(key[index+1:])
+	if err != nil || number <= 0 {
+		return "", 0, false
+	}
+
+	return projectKey, number, true
+}
+
+func (t *Task) Key() string {
+	if t.ProjectKey == "" || t.Number == 0 {
+		return ""
+	}
+	return fmt.Sprintf("%s-%d", t.ProjectKey, t.Number)
+}
@@ -0,0 +1,82 @@
+package domain
+
+import "time"
+
+type TaskLinkType string
+
+const (
+	TaskLinkBlocks       TaskLinkType = "blocks"
+	TaskLinkBlockedBy    TaskLinkType = "blocked_by"
+	TaskLinkRelatesTo    TaskLinkType = "relates_to"
+	TaskLinkDuplicates   TaskLinkType = "duplicates"
+	TaskLinkDuplicatedBy TaskLinkType = "duplicated_by"
+	TaskLinkParentOf     TaskLinkType = "parent_of"
+	TaskLinkChildOf      TaskLinkType = "child_of"
+)
+
+var taskLinkInverses = map[TaskLinkType]TaskLinkType{
+	TaskLinkBlocks:       TaskLinkBlockedBy,
+	TaskLinkBlockedBy:    TaskLinkBlocks,
+	TaskLinkRelatesTo:    TaskLinkRelatesTo,
+	TaskLinkDuplicates:   TaskLinkDuplicatedBy,
+	TaskLinkDuplicatedBy: TaskLinkDuplicates,
+	TaskLinkParentOf:     TaskLinkChildOf,
+	TaskLinkChildOf:      TaskLinkParentOf,
+}
+
+func IsValidTaskLinkType(linkType string) bool {
+	_, ok := taskLinkInverses[TaskLinkType(linkType)]
+	return ok
+}
+
+func (t TaskLinkType) Inverse() TaskLinkType {
+	return taskLinkInverses[t]
+}
+
+func (t TaskLinkType) IsCanonical() bool {
+	switch t {
+	case TaskLinkBlocks, TaskLinkRelatesTo, TaskLinkDuplicates, TaskLinkParentOf:
+		return true
+	}
+	return false
+}
+
+func (t TaskLinkType) IsAcyclic() bool {
+	return t == TaskLinkBlocks || t == TaskLinkParentOf
+}
+
+type TaskLink struct {
+	ID           string
+	SourceTaskID string
+	TargetTaskID string
+	Type         TaskLinkType
+	CreatedAt    time.Time
+}
+
+func NewTaskLink(taskID, otherTaskID string, linkType TaskLinkType) *TaskLink {
+	if linkType.IsCanonical() {
+		return &TaskLink{SourceTaskID: taskID, TargetTaskID: otherTaskID, Type: linkType}
+	}
+	return &TaskLink{SourceTaskID: otherTaskID, TargetTaskID: taskID, Type: linkType.Inverse()}
+}
+
+func (l *TaskLink) TypeFor(taskID string) TaskLinkType {
+	if l.SourceTaskID == taskID {
+		return l.Type
+	}
+	return l.Type.Inverse()
+}
+
+func (l *TaskLink) OtherTaskID(taskID string) string {
+	if l.SourceTaskID == taskID {
+		return l.TargetTaskID
+	}
+	return l.SourceTaskID
+}
+
+type BlockerEnforcement string
+
+const (
+	BlockerEnforcementNone BlockerEnforcement = "none"
+	BlockerEnforcementHard BlockerEnforcement = "hard"
+)
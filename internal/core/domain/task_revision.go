@@ -0,0 +1,108 @@
+package domain
+
+import (
+	"reflect"
+	"time"
+)
+
+type TaskRevision struct {
+	ID        string
+	TaskID    string
+	ProjectID string
+	Number    int
+	ActorID   *string
+	State     *Task
+	CreatedAt time.Time
+}
+
+func NewTaskRevision(task *Task, actorID *string) *TaskRevision {
+	return &TaskRevision{TaskID: task.ID, ProjectID: task.ProjectID, ActorID: actorID, State: task}
+}
+
+func (r *TaskRevision) Diff(previous *TaskRevision) map[string]FieldChange {
+	if previous == nil {
+		return DiffSnapshots(nil, r.State.Snapshot())
+	}
+	return DiffSnapshots(previous.State.Snapshot(), r.State.Snapshot())
+}
+
+func (r *TaskRevision) RevertChanges(current *Task) *Task {
+	state := r.State
+	changes := &Task{ID: current.ID}
+
+	if state.Title != current.Title {
+		changes.Title = state.Title
+	}
+
+	if !reflect.DeepEqual(stringValue(state.Content), stringValue(current.Content)) {
+		changes.Content = emptyIfNil(state.Content)
+	}
+
+	if state.ColumnID != current.ColumnID {
+		changes.ColumnID = state.ColumnID
+	}
+
+	if !reflect.DeepEqual(stringValue(state.LaneID), stringValue(current.LaneID)) {
+		changes.LaneID = emptyIfNil(state.LaneID)
+	}
+
+	if !reflect.DeepEqual(stringValue(state.AssigneeID), stringValue(current.AssigneeID)) {
+		changes.AssigneeID = emptyIfNil(state.AssigneeID)
+	}
+
+	if !reflect.DeepEqual(stringSliceValue(state.Labels), stringSliceValue(current.Labels)) {
+		changes.Labels = stringSliceValue(state.Labels)
+	}
+
+	if state.Priority != current.Priority {
+		changes.Priority = state.Priority
+	}
+
+	if !reflect.DeepEqual(floatValue(state.Estimate), floatValue(current.Estimate)) {
+		estimate := 0.0
+		if state.Estimate != nil {
+			estimate = *state.Estimate
+		}
+		changes.Estimate = &estimate
+	}
+
+	customFields := map[string]interface{}{}
+	for fieldID, value := range state.CustomFields {
+		if !reflect.DeepEqual(current.CustomFields[fieldID], value) {
+			customFields[fieldID] = value
+		}
+	}
+	for fieldID := range current.CustomFields {
+		if _, ok := state.CustomFields[fieldID]; !ok {
+			customFields[fieldID] = nil
+		}
+	}
+	if len(customFields) > 0 {
+		changes.CustomFields = customFields
+	}
+
+	if timeValue(state.DueDate) != timeValue(current.DueDate) {
+		changes.DueDate = zeroIfNil(state.DueDate)
+	}
+
+	if timeValue(state.CompletedAt) != timeValue(current.CompletedAt) {
+		changes.CompletedAt = zeroIfNil(state.CompletedAt)
+	}
+
+	return changes
+}
+
+func emptyIfNil(value *string) *string {
+	if value == nil {
+		empty := ""
+		return &empty
+	}
+	return value
+}
+
+func zeroIfNil(value *time.Time) *time.Time {
+	if value == nil {
+		return &time.Time{}
+	}
+	return value
+}
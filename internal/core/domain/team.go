@@ -15,6 +15,7 @@ type Team struct {
 	ID        string
 	Name      string
 	Role      AccessRole
+	RoleID    *string
 	ProjectID string
 	CreatedAt time.Time
 }
@@ -7,6 +7,6 @@ type User struct {
 	Name         string
 	Email        string
 	IsAdmin      bool
-	PasswordHash string
+	PasswordHash string `json:"-"`
 	CreatedAt    time.Time
 }
@@ -0,0 +1,152 @@
+package domain
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/url"
+	"time"
+)
+
+type WebhookDeliveryStatus string
+
+const (
+	WebhookDeliveryPending   WebhookDeliveryStatus = "pending"
+	WebhookDeliverySucceeded WebhookDeliveryStatus = "succeeded"
+	WebhookDeliveryFailed    WebhookDeliveryStatus = "failed"
+)
+
+const (
+	WebhookMaxAttempts             = 8
+	WebhookInitialBackoff          = 30 * time.Second
+	WebhookMaxBackoff              = 6 * time.Hour
+	WebhookDeliveryLease           = 2 * time.Minute
+	WebhookDeliveryBatchSize       = 20
+	DefaultWebhookDeliveryPageSize = 30
+	MaxWebhookDeliveryPageSize     = 100
+	WebhookSignatureHeader         = "X-Kanban-Signature"
+	WebhookEventHeader             = "X-Kanban-Event"
+	WebhookDeliveryHeader          = "X-Kanban-Delivery"
+	webhookSignaturePrefix         = "sha256="
+)
+
+type Webhook struct {
+	ID        string
+	ProjectID string
+	URL       string
+	Secret    string
+	Events    []string
+	Active    bool
+	CreatedAt time.Time
+}
+
+func (w *Webhook) IsValid() bool {
+	target, err := url.Parse(w.URL)
+	if err != nil || (target.Scheme != "http" && target.Scheme != "https") || target.Host == "" {
+		return false
+	}
+	return len(w.Events) > 0
+}
+
+type WebhookResponse struct {
+	StatusCode int
+	Body       string
+}
+
+type WebhookDelivery struct {
+	ID             string
+	WebhookID      string
+	ProjectID      string
+	Event          string
+	Payload        []byte
+	Status         WebhookDeliveryStatus
+	Attempts       int
+	ResponseStatus *int
+	ResponseBody   *string
+	Error          *string
+	NextAttemptAt  *time.Time
+	DeliveredAt    *time.Time
+	CreatedAt      time.Time
+}
+
+func (d *WebhookDelivery) RecordAttempt(response *WebhookResponse, err error, now time.Time) {
+	d.Attempts++
+	d.ResponseStatus = nil
+	d.ResponseBody = nil
+	d.Error = nil
+
+	if err != nil {
+		message := err.Error()
+		d.Error = &message
+	}
+
+	if response != nil {
+		d.ResponseStatus = &response.StatusCode
+		d.ResponseBody = &response.Body
+	}
+
+	if err == nil && response != nil && response.StatusCode >= 200 && response.StatusCode < 300 {
+		d.Status = WebhookDeliverySucceeded
+		d.DeliveredAt = &now
+		d.NextAttemptAt = nil
+		return
+	}
+
+	if d.Attempts >= WebhookMaxAttempts {
+		d.Status = WebhookDeliveryFailed
+		d.NextAttemptAt = nil
+		return
+	}
+
+	nextAttemptAt := now.Add(WebhookBackoff(d.Attempts))
+	d.Status = WebhookDeliveryPending
+	d.NextAttemptAt = &nextAttemptAt
+}
+
+func (d *WebhookDelivery) Cancel(reason string) {
+	d.Status = WebhookDeliveryFailed
+	d.Error = &reason
+	d.NextAttemptAt = nil
+}
+
+func WebhookBackoff(attempts int) time.Duration {
+	if attempts < 1 {
+		return WebhookInitialBackoff
+	}
+
+	backoff := WebhookInitialBackoff
+	for i := 1; i < attempts; i++ {
+		backoff *= 2
+		if backoff >= WebhookMaxBackoff {
+			return WebhookMaxBackoff
+		}
+	}
+	return backoff
+}
+
+func SignWebhookPayload(secret string, payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(payload)
+	return webhookSignaturePrefix + hex.EncodeToString(mac.Sum(nil))
+}
+
+type WebhookDeliveryFilter struct {
+	WebhookID string
+	Limit     int
+	Cursor    string
+}
+
+func (f *WebhookDeliveryFilter) PageSize() int {
+	if f.Limit <= 0 {
+		return DefaultWebhookDeliveryPageSize
+	}
+	if f.Limit > MaxWebhookDeliveryPageSize {
+		return MaxWebhookDeliveryPageSize
+	}
+	return f.Limit
+}
+
+type WebhookDeliveryPage struct {
+	Deliveries []*WebhookDelivery
+	NextCursor string
+}
@@ -0,0 +1,26 @@
+package domain
+
+type WipEnforcement string
+
+const (
+	WipEnforcementHard WipEnforcement = "hard"
+	WipEnforcementSoft WipEnforcement = "soft"
+)
+
+type ColumnLoad struct {
+	ColumnID  string
+	TaskCount int
+	WipLimit  *int
+}
+
+func (l *ColumnLoad) OverLimit() bool {
+	return l.WipLimit != nil && l.TaskCount > *l.WipLimit
+}
+
+func (c *Column) Load() *ColumnLoad {
+	return &ColumnLoad{
+		ColumnID:  c.ID,
+		TaskCount: c.TaskCount,
+		WipLimit:  c.WipLimit,
+	}
+}
@@ -0,0 +1,12 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/fatihsen-dev/kanban-backend/internal/core/domain"
+)
+
+type ActivityRepository interface {
+	Save(ctx context.Context, activity *domain.Activity) error
+	Query(ctx context.Context, filter *domain.ActivityFilter) (*domain.ActivityPage, error)
+}
@@ -0,0 +1,12 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/fatihsen-dev/kanban-backend/internal/core/domain"
+)
+
+type AutomationExecutionRepository interface {
+	Save(ctx context.Context, execution *domain.AutomationExecution) error
+	GetExecutionsByRuleID(ctx context.Context, ruleID string, limit int) ([]*domain.AutomationExecution, error)
+}
@@ -0,0 +1,17 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/fatihsen-dev/kanban-backend/internal/core/domain"
+)
+
+type AutomationRuleRepository interface {
+	Save(ctx context.Context, rule *domain.AutomationRule) error
+	GetByID(ctx context.Context, id string) (*domain.AutomationRule, error)
+	GetRulesByProjectID(ctx context.Context, projectID string) ([]*domain.AutomationRule, error)
+	GetEnabledRulesByTrigger(ctx context.Context, projectID string, trigger domain.AutomationTrigger) ([]*domain.AutomationRule, error)
+	GetAllEnabledRulesByTrigger(ctx context.Context, trigger domain.AutomationTrigger) ([]*domain.AutomationRule, error)
+	Update(ctx context.Context, rule *domain.AutomationRule) error
+	DeleteByID(ctx context.Context, id string) error
+}
@@ -0,0 +1,16 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/fatihsen-dev/kanban-backend/internal/core/domain"
+)
+
+type ChecklistItemRepository interface {
+	Save(ctx context.Context, item *domain.ChecklistItem) error
+	GetByID(ctx context.Context, id string) (*domain.ChecklistItem, error)
+	GetItemsByTaskID(ctx context.Context, taskID string) ([]*domain.ChecklistItem, error)
+	Update(ctx context.Context, item *domain.ChecklistItem) error
+	Reorder(ctx context.Context, taskID string, itemIDs []string) error
+	DeleteByID(ctx context.Context, id string) error
+}
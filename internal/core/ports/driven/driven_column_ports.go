@@ -2,6 +2,7 @@ package ports
 
 import (
 	"context"
+	"time"
 
 	"github.com/fatihsen-dev/kanban-backend/internal/core/domain"
 )
@@ -9,7 +10,12 @@ import (
 type ColumnRepository interface {
 	Save(ctx context.Context, column *domain.Column) error
 	GetByID(ctx context.Context, id string) (*domain.Column, error)
+	GetByIDForUpdate(ctx context.Context, id string) (*domain.Column, error)
 	GetColumnsByProjectID(ctx context.Context, projectID string) ([]*domain.Column, error)
 	Update(ctx context.Context, column *domain.Column) error
 	Delete(ctx context.Context, id string) error
+	Archive(ctx context.Context, id string) error
+	Restore(ctx context.Context, id string) error
+	GetByProjectIDAndState(ctx context.Context, projectID string, state domain.ArchiveState) ([]*domain.Column, error)
+	PurgeDeleted(ctx context.Context, olderThan time.Duration) (int64, error)
 }
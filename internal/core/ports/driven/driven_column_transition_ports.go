@@ -0,0 +1,16 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/fatihsen-dev/kanban-backend/internal/core/domain"
+)
+
+type ColumnTransitionRepository interface {
+	Save(ctx context.Context, transition *domain.ColumnTransition) error
+	GetByID(ctx context.Context, id string) (*domain.ColumnTransition, error)
+	GetTransitionsByProjectID(ctx context.Context, projectID string) ([]*domain.ColumnTransition, error)
+	GetTransitionsFromColumn(ctx context.Context, fromColumnID string) ([]*domain.ColumnTransition, error)
+	Update(ctx context.Context, transition *domain.ColumnTransition) error
+	DeleteByID(ctx context.Context, id string) error
+}
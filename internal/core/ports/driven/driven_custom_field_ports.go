@@ -0,0 +1,15 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/fatihsen-dev/kanban-backend/internal/core/domain"
+)
+
+type CustomFieldRepository interface {
+	Save(ctx context.Context, field *domain.CustomField) error
+	GetByID(ctx context.Context, id string) (*domain.CustomField, error)
+	GetFieldsByProjectID(ctx context.Context, projectID string) ([]*domain.CustomField, error)
+	Update(ctx context.Context, field *domain.CustomField) error
+	DeleteByID(ctx context.Context, id string) error
+}
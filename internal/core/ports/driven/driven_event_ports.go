@@ -0,0 +1,19 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/fatihsen-dev/kanban-backend/internal/core/domain"
+)
+
+type EventPublisher interface {
+	Publish(ctx context.Context, event domain.Event) error
+}
+
+type EventEncoder interface {
+	Encode(event domain.Event) ([]byte, error)
+}
+
+type EventSubscriber interface {
+	HandleEvent(ctx context.Context, event domain.Event) error
+}
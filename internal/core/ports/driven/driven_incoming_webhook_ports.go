@@ -0,0 +1,13 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/fatihsen-dev/kanban-backend/internal/core/domain"
+)
+
+type IncomingWebhookRepository interface {
+	Save(ctx context.Context, webhook *domain.IncomingWebhook) error
+	GetByProjectID(ctx context.Context, projectID string) (*domain.IncomingWebhook, error)
+	DeleteByProjectID(ctx context.Context, projectID string) error
+}
@@ -0,0 +1,15 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/fatihsen-dev/kanban-backend/internal/core/domain"
+)
+
+type LaneRepository interface {
+	Save(ctx context.Context, lane *domain.Lane) error
+	GetByID(ctx context.Context, id string) (*domain.Lane, error)
+	GetLanesByProjectID(ctx context.Context, projectID string) ([]*domain.Lane, error)
+	Update(ctx context.Context, lane *domain.Lane) error
+	DeleteByID(ctx context.Context, id string) error
+}
@@ -0,0 +1,16 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/fatihsen-dev/kanban-backend/internal/core/domain"
+)
+
+type Mailer interface {
+	Send(ctx context.Context, message *domain.MailMessage) error
+}
+
+type MailComposer interface {
+	ComposeNotification(recipient *domain.User, notification *domain.Notification, unsubscribeURL string) (*domain.MailMessage, error)
+	ComposeDigest(digest *domain.Digest) (*domain.MailMessage, error)
+}
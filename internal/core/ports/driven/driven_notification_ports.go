@@ -0,0 +1,16 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/fatihsen-dev/kanban-backend/internal/core/domain"
+)
+
+type NotificationRepository interface {
+	Save(ctx context.Context, notification *domain.Notification) error
+	GetByID(ctx context.Context, id string) (*domain.Notification, error)
+	Query(ctx context.Context, filter *domain.NotificationFilter) (*domain.NotificationPage, error)
+	CountUnread(ctx context.Context, recipientID string) (int, error)
+	MarkRead(ctx context.Context, recipientID string, ids []string) ([]string, error)
+	MarkAllRead(ctx context.Context, recipientID string) (int64, error)
+}
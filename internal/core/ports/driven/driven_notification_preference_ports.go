@@ -0,0 +1,16 @@
+package ports
+
+import (
+	"context"
+	"time"
+
+	"github.com/fatihsen-dev/kanban-backend/internal/core/domain"
+)
+
+type NotificationPreferenceRepository interface {
+	GetByUserID(ctx context.Context, userID string) (domain.NotificationPreferences, error)
+	Save(ctx context.Context, userID string, preferences domain.NotificationPreferences) error
+	GetDigestRecipientIDs(ctx context.Context, sentBefore time.Time) ([]string, error)
+	GetLastDigestAt(ctx context.Context, userID string) (*time.Time, error)
+	MarkDigestSent(ctx context.Context, userID string, sentAt time.Time) error
+}
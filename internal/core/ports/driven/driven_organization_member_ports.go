@@ -0,0 +1,17 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/fatihsen-dev/kanban-backend/internal/core/domain"
+)
+
+type OrganizationMemberRepository interface {
+	Save(ctx context.Context, member *domain.OrganizationMember) error
+	GetByID(ctx context.Context, id string) (*domain.OrganizationMember, error)
+	GetByUserIDAndOrganizationID(ctx context.Context, userID, organizationID string) (*domain.OrganizationMember, error)
+	GetByUserID(ctx context.Context, userID string) ([]*domain.OrganizationMember, error)
+	GetMembersByOrganizationID(ctx context.Context, organizationID string) ([]*domain.OrganizationMember, error)
+	Update(ctx context.Context, member *domain.OrganizationMember) error
+	DeleteByID(ctx context.Context, id string) error
+}
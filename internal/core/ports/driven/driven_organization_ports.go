@@ -0,0 +1,15 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/fatihsen-dev/kanban-backend/internal/core/domain"
+)
+
+type OrganizationRepository interface {
+	Save(ctx context.Context, organization *domain.Organization) error
+	GetByID(ctx context.Context, id string) (*domain.Organization, error)
+	GetByIDs(ctx context.Context, ids []string) ([]*domain.Organization, error)
+	Update(ctx context.Context, organization *domain.Organization) error
+	DeleteByID(ctx context.Context, id string) error
+}
@@ -0,0 +1,21 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/fatihsen-dev/kanban-backend/internal/core/domain"
+)
+
+type OrganizationTeamRepository interface {
+	Save(ctx context.Context, team *domain.OrganizationTeam) error
+	GetByID(ctx context.Context, id string) (*domain.OrganizationTeam, error)
+	GetTeamsByOrganizationID(ctx context.Context, organizationID string) ([]*domain.OrganizationTeam, error)
+	DeleteByID(ctx context.Context, id string) error
+	AddMembers(ctx context.Context, teamID string, userIDs []string) error
+	RemoveMember(ctx context.Context, teamID, userID string) error
+	RemoveUserFromOrganizationTeams(ctx context.Context, organizationID, userID string) error
+	SaveProjectGrants(ctx context.Context, grants []*domain.OrganizationTeamProject) error
+	DeleteProjectGrant(ctx context.Context, teamID, projectID string) error
+	GetProjectGrantsByTeamIDs(ctx context.Context, teamIDs []string) ([]*domain.OrganizationTeamProject, error)
+	GetUserProjectGrants(ctx context.Context, userID string) ([]*domain.OrganizationTeamProject, error)
+}
@@ -0,0 +1,20 @@
+package ports
+
+import (
+	"context"
+	"time"
+
+	"github.com/fatihsen-dev/kanban-backend/internal/core/domain"
+)
+
+type Transactor interface {
+	WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error
+}
+
+type OutboxRepository interface {
+	Save(ctx context.Context, event *domain.OutboxEvent) error
+	ClaimPending(ctx context.Context, limit int, lease time.Duration) ([]*domain.OutboxEvent, error)
+	Release(ctx context.Context, ids []int64) error
+	Update(ctx context.Context, event *domain.OutboxEvent) error
+	DeletePublishedBefore(ctx context.Context, before time.Time) (int64, error)
+}
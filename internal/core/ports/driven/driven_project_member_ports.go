@@ -10,7 +10,9 @@ type ProjectMemberRepository interface {
 	Save(ctx context.Context, projectMember *domain.ProjectMember) error
 	GetProjectMembersByProjectID(ctx context.Context, projectID string, query *string) ([]*domain.ProjectMember, error)
 	DeleteByID(ctx context.Context, id string) error
+	GetByID(ctx context.Context, id string) (*domain.ProjectMember, error)
 	GetByUserIDAndProjectID(ctx context.Context, userID, projectID string) (*domain.ProjectMember, error)
 	GetByUserID(ctx context.Context, userID string) ([]*domain.ProjectMember, error)
 	UpdateProjectMember(ctx context.Context, projectMember *domain.ProjectMember) error
+	GetUsersByProjectID(ctx context.Context, projectID string) ([]*domain.User, error)
 }
@@ -9,7 +9,10 @@ import (
 type ProjectRepository interface {
 	Save(ctx context.Context, project *domain.Project) error
 	GetByID(ctx context.Context, id string) (*domain.Project, error)
+	GetByKey(ctx context.Context, key string) (*domain.Project, error)
 	GetUserProjects(ctx context.Context, userID string) ([]*domain.Project, error)
 	GetByIDs(ctx context.Context, ids []string) ([]*domain.Project, error)
+	GetByOrganizationID(ctx context.Context, organizationID string) ([]*domain.Project, error)
+	Update(ctx context.Context, project *domain.Project) error
 	DeleteByID(ctx context.Context, id string) error
 }
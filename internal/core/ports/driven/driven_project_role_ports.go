@@ -0,0 +1,15 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/fatihsen-dev/kanban-backend/internal/core/domain"
+)
+
+type ProjectRoleRepository interface {
+	Save(ctx context.Context, role *domain.ProjectRole) error
+	GetByID(ctx context.Context, id string) (*domain.ProjectRole, error)
+	GetRolesByProjectID(ctx context.Context, projectID string) ([]*domain.ProjectRole, error)
+	Update(ctx context.Context, role *domain.ProjectRole) error
+	DeleteByID(ctx context.Context, id string) error
+}
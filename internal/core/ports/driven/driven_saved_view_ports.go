@@ -0,0 +1,15 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/fatihsen-dev/kanban-backend/internal/core/domain"
+)
+
+type SavedViewRepository interface {
+	Save(ctx context.Context, view *domain.SavedView) error
+	GetByID(ctx context.Context, id string) (*domain.SavedView, error)
+	GetVisibleViews(ctx context.Context, projectID string, userID string) ([]*domain.SavedView, error)
+	Update(ctx context.Context, view *domain.SavedView) error
+	DeleteByID(ctx context.Context, id string) error
+}
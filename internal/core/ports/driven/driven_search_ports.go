@@ -0,0 +1,11 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/fatihsen-dev/kanban-backend/internal/core/domain"
+)
+
+type SearchEngine interface {
+	Search(ctx context.Context, query *domain.SearchQuery) ([]*domain.SearchResult, error)
+}
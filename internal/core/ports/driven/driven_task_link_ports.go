@@ -0,0 +1,15 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/fatihsen-dev/kanban-backend/internal/core/domain"
+)
+
+type TaskLinkRepository interface {
+	Save(ctx context.Context, link *domain.TaskLink) error
+	GetByID(ctx context.Context, id string) (*domain.TaskLink, error)
+	GetLinksByTaskID(ctx context.Context, taskID string) ([]*domain.TaskLink, error)
+	HasPath(ctx context.Context, linkType domain.TaskLinkType, fromTaskID string, toTaskID string) (bool, error)
+	DeleteByID(ctx context.Context, id string) error
+}
@@ -2,6 +2,7 @@ package ports
 
 import (
 	"context"
+	"time"
 
 	"github.com/fatihsen-dev/kanban-backend/internal/core/domain"
 )
@@ -9,8 +10,18 @@ import (
 type TaskRepository interface {
 	Save(ctx context.Context, task *domain.Task) error
 	GetByID(ctx context.Context, id string) (*domain.Task, error)
-	GetAll(ctx context.Context) ([]*domain.Task, error)
+	GetByProjectKeyAndNumber(ctx context.Context, projectKey string, number int) (*domain.Task, error)
+	Query(ctx context.Context, filter *domain.TaskFilter) (*domain.TaskPage, error)
 	GetTasksByColumnIDs(ctx context.Context, columnIDs []string) ([]*domain.Task, error)
+	TrashByColumnID(ctx context.Context, columnID string) ([]string, error)
+	MoveToColumn(ctx context.Context, fromColumnID, toColumnID string) ([]string, error)
 	Update(ctx context.Context, task *domain.Task) error
 	Delete(ctx context.Context, id string) error
+	Archive(ctx context.Context, id string) error
+	Restore(ctx context.Context, id string) error
+	GetByProjectIDAndState(ctx context.Context, projectID string, state domain.ArchiveState) ([]*domain.Task, error)
+	PurgeDeleted(ctx context.Context, olderThan time.Duration) (int64, error)
+	GetOverdueTasksForRule(ctx context.Context, projectID string, ruleID string) ([]*domain.Task, error)
+	GetTasksDueForReminder(ctx context.Context, window time.Duration) ([]*domain.Task, error)
+	GetOpenTasksByAssignee(ctx context.Context, assigneeID string, limit int) ([]*domain.Task, error)
 }
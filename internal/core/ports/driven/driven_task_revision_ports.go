@@ -0,0 +1,14 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/fatihsen-dev/kanban-backend/internal/core/domain"
+)
+
+type TaskRevisionRepository interface {
+	Save(ctx context.Context, revision *domain.TaskRevision) error
+	Exists(ctx context.Context, taskID string) (bool, error)
+	GetByTaskID(ctx context.Context, taskID string) ([]*domain.TaskRevision, error)
+	GetByNumber(ctx context.Context, taskID string, number int) (*domain.TaskRevision, error)
+}
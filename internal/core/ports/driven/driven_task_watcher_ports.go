@@ -0,0 +1,9 @@
+package ports
+
+import "context"
+
+type TaskWatcherRepository interface {
+	Add(ctx context.Context, taskID string, userIDs []string) error
+	Remove(ctx context.Context, taskID, userID string) error
+	GetUserIDsByTaskID(ctx context.Context, taskID string) ([]string, error)
+}
@@ -10,6 +10,6 @@ type TeamRepository interface {
 	Save(ctx context.Context, team *domain.Team) error
 	GetByID(ctx context.Context, id string) (*domain.Team, error)
 	GetTeamsByProjectID(ctx context.Context, projectID string) ([]*domain.Team, error)
-	DeleteByID(ctx context.Context, id string) error
+	DeleteByID(ctx context.Context, projectID, id string) error
 	Update(ctx context.Context, team *domain.Team) error
 }
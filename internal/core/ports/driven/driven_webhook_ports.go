@@ -0,0 +1,29 @@
+package ports
+
+import (
+	"context"
+	"time"
+
+	"github.com/fatihsen-dev/kanban-backend/internal/core/domain"
+)
+
+type WebhookRepository interface {
+	Save(ctx context.Context, webhook *domain.Webhook) error
+	GetByID(ctx context.Context, id string) (*domain.Webhook, error)
+	GetByProjectID(ctx context.Context, projectID string) ([]*domain.Webhook, error)
+	GetActiveByProjectIDAndEvent(ctx context.Context, projectID, event string) ([]*domain.Webhook, error)
+	Update(ctx context.Context, webhook *domain.Webhook) error
+	DeleteByID(ctx context.Context, id string) error
+}
+
+type WebhookDeliveryRepository interface {
+	Save(ctx context.Context, delivery *domain.WebhookDelivery) error
+	GetByID(ctx context.Context, id string) (*domain.WebhookDelivery, error)
+	Query(ctx context.Context, filter *domain.WebhookDeliveryFilter) (*domain.WebhookDeliveryPage, error)
+	ClaimDue(ctx context.Context, limit int, lease time.Duration) ([]*domain.WebhookDelivery, error)
+	Update(ctx context.Context, delivery *domain.WebhookDelivery) error
+}
+
+type WebhookSender interface {
+	Send(ctx context.Context, url string, headers map[string]string, payload []byte) (*domain.WebhookResponse, error)
+}
@@ -0,0 +1,12 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/fatihsen-dev/kanban-backend/internal/core/domain"
+)
+
+type ActivityService interface {
+	Record(ctx context.Context, projectID string, entityType domain.EntityType, entityID string, action domain.ActivityAction, before, after map[string]interface{})
+	GetProjectActivity(ctx context.Context, filter *domain.ActivityFilter) (*domain.ActivityPage, error)
+}
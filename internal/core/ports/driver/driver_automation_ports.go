@@ -0,0 +1,17 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/fatihsen-dev/kanban-backend/internal/core/domain"
+)
+
+type AutomationService interface {
+	CreateRule(ctx context.Context, rule *domain.AutomationRule) error
+	GetRuleByID(ctx context.Context, id string) (*domain.AutomationRule, error)
+	GetRulesByProjectID(ctx context.Context, projectID string) ([]*domain.AutomationRule, error)
+	UpdateRule(ctx context.Context, rule *domain.AutomationRule) error
+	DeleteRuleByID(ctx context.Context, id string) error
+	GetExecutionsByRuleID(ctx context.Context, ruleID string) ([]*domain.AutomationExecution, error)
+	RunDueDateAutomations(ctx context.Context) ([]*domain.AutomationOutcome, error)
+}
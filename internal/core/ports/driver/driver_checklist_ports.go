@@ -0,0 +1,17 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/fatihsen-dev/kanban-backend/internal/core/domain"
+)
+
+type ChecklistService interface {
+	AddItem(ctx context.Context, projectID string, item *domain.ChecklistItem) (domain.ChecklistProgress, error)
+	GetItemByID(ctx context.Context, id string) (*domain.ChecklistItem, error)
+	GetItemsByTaskID(ctx context.Context, taskID string) ([]*domain.ChecklistItem, error)
+	UpdateItem(ctx context.Context, projectID string, item *domain.ChecklistItem) (domain.ChecklistProgress, error)
+	ToggleItem(ctx context.Context, item *domain.ChecklistItem) (domain.ChecklistProgress, error)
+	ReorderItems(ctx context.Context, taskID string, itemIDs []string) ([]*domain.ChecklistItem, error)
+	DeleteItem(ctx context.Context, item *domain.ChecklistItem) (domain.ChecklistProgress, error)
+}
@@ -8,9 +8,12 @@ import (
 
 type ColumnService interface {
 	CreateColumn(ctx context.Context, column *domain.Column) error
-	GetColumnByID(ctx context.Context, id string) (*domain.Column, error)
+	GetColumnByID(ctx context.Context, projectID, id string) (*domain.Column, error)
 	GetColumnsByProjectID(ctx context.Context, projectID string) ([]*domain.Column, error)
-	GetColumnWithDetails(ctx context.Context, columnID string) (*domain.Column, []*domain.Task, error)
+	GetColumnWithDetails(ctx context.Context, projectID, columnID string) (*domain.Column, []*domain.Task, error)
 	UpdateColumn(ctx context.Context, column *domain.Column) error
-	DeleteColumn(ctx context.Context, id string) error
+	DeleteColumn(ctx context.Context, projectID, id string, targetColumnID *string) ([]string, error)
+	ArchiveColumn(ctx context.Context, projectID, id string) error
+	RestoreColumn(ctx context.Context, projectID, id string) (*domain.Column, []*domain.Task, error)
+	GetColumnsByState(ctx context.Context, projectID string, state domain.ArchiveState) ([]*domain.Column, error)
 }
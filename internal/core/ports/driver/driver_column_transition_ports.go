@@ -0,0 +1,15 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/fatihsen-dev/kanban-backend/internal/core/domain"
+)
+
+type ColumnTransitionService interface {
+	CreateTransition(ctx context.Context, transition *domain.ColumnTransition) error
+	GetTransitionByID(ctx context.Context, id string) (*domain.ColumnTransition, error)
+	GetTransitionsByProjectID(ctx context.Context, projectID string) ([]*domain.ColumnTransition, error)
+	UpdateTransition(ctx context.Context, transition *domain.ColumnTransition) error
+	DeleteTransitionByID(ctx context.Context, id string) error
+}
@@ -0,0 +1,15 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/fatihsen-dev/kanban-backend/internal/core/domain"
+)
+
+type CustomFieldService interface {
+	CreateCustomField(ctx context.Context, field *domain.CustomField) error
+	GetCustomFieldByID(ctx context.Context, id string) (*domain.CustomField, error)
+	GetCustomFieldsByProjectID(ctx context.Context, projectID string) ([]*domain.CustomField, error)
+	UpdateCustomField(ctx context.Context, field *domain.CustomField) error
+	DeleteCustomFieldByID(ctx context.Context, id string) error
+}
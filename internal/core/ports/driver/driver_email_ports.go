@@ -0,0 +1,15 @@
+package ports
+
+import (
+	"context"
+	"time"
+
+	"github.com/fatihsen-dev/kanban-backend/internal/core/domain"
+)
+
+type EmailService interface {
+	GetPreferences(ctx context.Context, userID string) (domain.NotificationPreferences, error)
+	UpdatePreferences(ctx context.Context, userID string, preferences domain.NotificationPreferences) (domain.NotificationPreferences, error)
+	Unsubscribe(ctx context.Context, token string) (domain.NotificationType, error)
+	SendDailyDigests(ctx context.Context, now time.Time) (int, error)
+}
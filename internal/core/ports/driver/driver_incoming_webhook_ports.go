@@ -0,0 +1,15 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/fatihsen-dev/kanban-backend/internal/core/domain"
+)
+
+type IncomingWebhookService interface {
+	GetIncomingWebhook(ctx context.Context, projectID string) (*domain.IncomingWebhook, error)
+	SaveIncomingWebhook(ctx context.Context, webhook *domain.IncomingWebhook, rotateSecret bool) error
+	DeleteIncomingWebhook(ctx context.Context, projectID string) error
+	VerifyPush(ctx context.Context, projectID, signature string, payload []byte) (*domain.IncomingWebhook, error)
+	HandlePush(ctx context.Context, webhook *domain.IncomingWebhook, event *domain.PushEvent) []*domain.PushTaskResult
+}
@@ -0,0 +1,15 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/fatihsen-dev/kanban-backend/internal/core/domain"
+)
+
+type LaneService interface {
+	CreateLane(ctx context.Context, lane *domain.Lane) error
+	GetLaneByID(ctx context.Context, id string) (*domain.Lane, error)
+	GetLanesByProjectID(ctx context.Context, projectID string) ([]*domain.Lane, error)
+	UpdateLane(ctx context.Context, lane *domain.Lane) error
+	DeleteLaneByID(ctx context.Context, id string) error
+}
@@ -0,0 +1,16 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/fatihsen-dev/kanban-backend/internal/core/domain"
+)
+
+type NotificationService interface {
+	Notify(ctx context.Context, notification *domain.Notification)
+	GetNotifications(ctx context.Context, filter *domain.NotificationFilter) (*domain.NotificationPage, error)
+	CountUnread(ctx context.Context, recipientID string) (int, error)
+	MarkRead(ctx context.Context, recipientID string, ids []string) ([]string, error)
+	MarkAllRead(ctx context.Context, recipientID string) (int64, error)
+	SendDueDateReminders(ctx context.Context) (int, error)
+}
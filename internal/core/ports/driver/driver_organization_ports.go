@@ -0,0 +1,27 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/fatihsen-dev/kanban-backend/internal/core/domain"
+)
+
+type OrganizationService interface {
+	CreateOrganization(ctx context.Context, organization *domain.Organization) error
+	GetOrganizationByID(ctx context.Context, id string) (*domain.Organization, error)
+	GetUserOrganizations(ctx context.Context, userID string) ([]*domain.Organization, error)
+	GetOrganizationWithDetails(ctx context.Context, id string) (*domain.OrganizationDetails, error)
+	UpdateOrganization(ctx context.Context, organization *domain.Organization) error
+	DeleteOrganization(ctx context.Context, id string) error
+	GetOrganizationProjects(ctx context.Context, organizationID string) ([]*domain.Project, error)
+	GetMembership(ctx context.Context, userID, organizationID string) (*domain.OrganizationMember, error)
+	AddOrganizationMember(ctx context.Context, member *domain.OrganizationMember, actor *domain.OrganizationMember) error
+	UpdateOrganizationMember(ctx context.Context, member *domain.OrganizationMember) error
+	RemoveOrganizationMember(ctx context.Context, organizationID, memberID string, actor *domain.OrganizationMember) error
+	CreateTeam(ctx context.Context, team *domain.OrganizationTeam) error
+	DeleteTeam(ctx context.Context, organizationID, teamID string) error
+	AddTeamMembers(ctx context.Context, organizationID, teamID string, userIDs []string) ([]string, error)
+	RemoveTeamMember(ctx context.Context, organizationID, teamID, userID string) error
+	GrantTeamProjects(ctx context.Context, organizationID, teamID string, projectIDs []string, role domain.AccessRole) ([]*domain.OrganizationTeamProject, error)
+	RevokeTeamProject(ctx context.Context, organizationID, teamID, projectID string) error
+}
@@ -0,0 +1,12 @@
+package ports
+
+import "context"
+
+type OutboxService interface {
+	WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error
+}
+
+type OutboxDispatcher interface {
+	DispatchPending(ctx context.Context) (int, error)
+	PurgePublished(ctx context.Context) (int64, error)
+}
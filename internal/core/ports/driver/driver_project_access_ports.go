@@ -0,0 +1,11 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/fatihsen-dev/kanban-backend/internal/core/domain"
+)
+
+type ProjectAccessService interface {
+	GetProjectAccess(ctx context.Context, userID, projectID string) (*domain.ProjectAccess, error)
+}
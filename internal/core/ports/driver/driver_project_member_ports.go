@@ -3,6 +3,7 @@ package ports
 import (
 	"context"
 
+	"github.com/fatihsen-dev/kanban-backend/internal/adapters/driver/http/datatransfers/responses"
 	"github.com/fatihsen-dev/kanban-backend/internal/core/domain"
 )
 
@@ -11,5 +12,9 @@ type ProjectMemberService interface {
 	GetProjectMembersByProjectID(ctx context.Context, projectID string, query *string) ([]*domain.ProjectMember, []*domain.User, error)
 	DeleteProjectMemberByID(ctx context.Context, id string) error
 	GetByUserIDAndProjectID(ctx context.Context, userID, projectID string) (*domain.ProjectMember, error)
-	UpdateProjectMember(ctx context.Context, projectMember *domain.ProjectMember) error
+	UpdateProjectMember(ctx context.Context, projectMember *domain.ProjectMember, grantor *domain.ProjectAccess) error
+}
+
+type PresenceTracker interface {
+	GetOnlineUsers(projectID string) []responses.OnlineProjectMembersResponse
 }
@@ -10,6 +10,8 @@ type ProjectService interface {
 	CreateProject(ctx context.Context, project *domain.Project) error
 	GetProjectByID(ctx context.Context, id string) (*domain.Project, error)
 	GetUserProjects(ctx context.Context, userID string) ([]*domain.Project, error)
-	GetProjectWithDetails(ctx context.Context, projectID string) (*domain.Project, []*domain.Column, map[string][]*domain.Task, []*domain.Team, []*domain.ProjectMember, []*domain.User, error)
+	GetProjectWithDetails(ctx context.Context, projectID string) (*domain.ProjectDetails, error)
+	GetProjectWithTasks(ctx context.Context, projectID string, tasks []*domain.Task, grouping domain.LaneGrouping) (*domain.ProjectDetails, error)
+	UpdateProject(ctx context.Context, project *domain.Project) error
 	DeleteProject(ctx context.Context, id string) error
 }
@@ -0,0 +1,15 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/fatihsen-dev/kanban-backend/internal/core/domain"
+)
+
+type ProjectRoleService interface {
+	CreateProjectRole(ctx context.Context, role *domain.ProjectRole) error
+	GetProjectRoleByID(ctx context.Context, id string) (*domain.ProjectRole, error)
+	GetProjectRolesByProjectID(ctx context.Context, projectID string) ([]*domain.ProjectRole, error)
+	UpdateProjectRole(ctx context.Context, role *domain.ProjectRole) error
+	DeleteProjectRoleByID(ctx context.Context, id string) error
+}
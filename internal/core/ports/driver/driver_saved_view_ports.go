@@ -0,0 +1,16 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/fatihsen-dev/kanban-backend/internal/core/domain"
+)
+
+type SavedViewService interface {
+	CreateView(ctx context.Context, view *domain.SavedView) error
+	GetViewByID(ctx context.Context, id string) (*domain.SavedView, error)
+	GetVisibleViews(ctx context.Context, projectID string, userID string) ([]*domain.SavedView, error)
+	UpdateView(ctx context.Context, view *domain.SavedView) error
+	DeleteViewByID(ctx context.Context, id string) error
+	ExecuteView(ctx context.Context, view *domain.SavedView, userID string) (*domain.ProjectDetails, error)
+}
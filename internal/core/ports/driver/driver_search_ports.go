@@ -0,0 +1,11 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/fatihsen-dev/kanban-backend/internal/core/domain"
+)
+
+type SearchService interface {
+	Search(ctx context.Context, userID string, query *domain.SearchQuery) ([]*domain.SearchResult, error)
+}
@@ -0,0 +1,14 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/fatihsen-dev/kanban-backend/internal/core/domain"
+)
+
+type TaskLinkService interface {
+	CreateLink(ctx context.Context, userID string, link *domain.TaskLink) ([]*domain.Task, error)
+	GetLinkByID(ctx context.Context, id string) (*domain.TaskLink, error)
+	GetLinksByTaskID(ctx context.Context, taskID string) ([]*domain.TaskLink, error)
+	DeleteLink(ctx context.Context, userID string, link *domain.TaskLink) ([]*domain.Task, error)
+}
@@ -7,9 +7,19 @@ import (
 )
 
 type TaskService interface {
-	CreateTask(ctx context.Context, task *domain.Task) error
-	GetTaskByID(ctx context.Context, id string) (*domain.Task, error)
-	GetTasks(ctx context.Context) ([]*domain.Task, error)
-	UpdateTask(ctx context.Context, task *domain.Task) error
-	DeleteTask(ctx context.Context, id string) error
+	CreateTask(ctx context.Context, task *domain.Task) (*domain.TaskResult, error)
+	GetTaskByID(ctx context.Context, projectID, id string) (*domain.Task, error)
+	GetTaskByKey(ctx context.Context, key string) (*domain.Task, error)
+	QueryTasks(ctx context.Context, filter *domain.TaskFilter) (*domain.TaskPage, error)
+	UpdateTask(ctx context.Context, task *domain.Task) (*domain.TaskResult, error)
+	DeleteTask(ctx context.Context, projectID, id string) error
+	ArchiveTask(ctx context.Context, projectID, id string) error
+	RestoreTask(ctx context.Context, projectID, id string) (*domain.Task, error)
+	GetTasksByState(ctx context.Context, projectID string, state domain.ArchiveState) ([]*domain.Task, error)
+	GetTaskRevisions(ctx context.Context, taskID string) ([]*domain.TaskRevision, error)
+	GetTaskRevision(ctx context.Context, taskID string, number int) (*domain.TaskRevision, error)
+	CompareTaskRevisions(ctx context.Context, taskID string, from, to int) (map[string]domain.FieldChange, error)
+	GetRevertChanges(ctx context.Context, taskID string, number int) (*domain.Task, error)
+	WatchTask(ctx context.Context, taskID, userID string) (*domain.Task, error)
+	UnwatchTask(ctx context.Context, taskID, userID string) (*domain.Task, error)
 }
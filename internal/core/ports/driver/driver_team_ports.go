@@ -7,10 +7,10 @@ import (
 )
 
 type TeamService interface {
-	CreateTeam(ctx context.Context, team *domain.Team) error
+	CreateTeam(ctx context.Context, team *domain.Team, grantor *domain.ProjectAccess) error
 	GetTeamsByProjectID(ctx context.Context, projectID string) ([]*domain.Team, error)
-	UpdateTeam(ctx context.Context, team *domain.Team) error
-	DeleteTeamByID(ctx context.Context, id string) error
-	GetTeamByID(ctx context.Context, id string) (*domain.Team, error)
-	AddTeamMembers(ctx context.Context, teamID string, memberIDs []string) ([]string, error)
+	UpdateTeam(ctx context.Context, team *domain.Team, grantor *domain.ProjectAccess) error
+	DeleteTeamByID(ctx context.Context, projectID, id string) error
+	GetTeamByID(ctx context.Context, projectID, id string) (*domain.Team, error)
+	AddTeamMembers(ctx context.Context, projectID, teamID string, memberIDs []string) ([]string, error)
 }
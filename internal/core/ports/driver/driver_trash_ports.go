@@ -0,0 +1,9 @@
+package ports
+
+import (
+	"context"
+)
+
+type TrashService interface {
+	PurgeExpired(ctx context.Context) error
+}
@@ -0,0 +1,19 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/fatihsen-dev/kanban-backend/internal/core/domain"
+)
+
+type WebhookService interface {
+	CreateWebhook(ctx context.Context, webhook *domain.Webhook) error
+	GetWebhookByID(ctx context.Context, id string) (*domain.Webhook, error)
+	GetWebhooksByProjectID(ctx context.Context, projectID string) ([]*domain.Webhook, error)
+	UpdateWebhook(ctx context.Context, webhook *domain.Webhook) error
+	DeleteWebhookByID(ctx context.Context, id string) error
+	GetDeliveries(ctx context.Context, filter *domain.WebhookDeliveryFilter) (*domain.WebhookDeliveryPage, error)
+	GetDeliveryByID(ctx context.Context, id string) (*domain.WebhookDelivery, error)
+	Redeliver(ctx context.Context, delivery *domain.WebhookDelivery) (*domain.WebhookDelivery, error)
+	DeliverDue(ctx context.Context) (int, error)
+}
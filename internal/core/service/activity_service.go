@@ -0,0 +1,45 @@
+package service
+
+import (
+	"context"
+
+	"github.com/fatihsen-dev/kanban-backend/internal/core/domain"
+	ports "github.com/fatihsen-dev/kanban-backend/internal/core/ports/driven"
+	"go.uber.org/zap"
+)
+
+type ActivityService struct {
+	activityRepo ports.ActivityRepository
+	transactor   ports.Transactor
+}
+
+func NewActivityService(activityRepo ports.ActivityRepository, transactor ports.Transactor) *ActivityService {
+	return &ActivityService{activityRepo: activityRepo, transactor: transactor}
+}
+
+func (s *ActivityService) Record(ctx context.Context, projectID string, entityType domain.EntityType, entityID string, action domain.ActivityAction, before, after map[string]interface{}) {
+	changes := domain.DiffSnapshots(before, after)
+	if len(changes) == 0 && (action == domain.ActivityActionUpdated || action == domain.ActivityActionMoved) {
+		return
+	}
+
+	activity := &domain.Activity{
+		ProjectID:  projectID,
+		ActorID:    domain.ActorFromContext(ctx),
+		EntityType: entityType,
+		EntityID:   entityID,
+		Action:     action,
+		Changes:    changes,
+	}
+
+	err := s.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		return s.activityRepo.Save(ctx, activity)
+	})
+	if err != nil {
+		zap.L().Error("Failed to record activity", zap.String("entity_type", string(entityType)), zap.String("entity_id", entityID), zap.Error(err))
+	}
+}
+
+func (s *ActivityService) GetProjectActivity(ctx context.Context, filter *domain.ActivityFilter) (*domain.ActivityPage, error) {
+	return s.activityRepo.Query(ctx, filter)
+}
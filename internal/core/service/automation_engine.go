@@ -0,0 +1,164 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/fatihsen-dev/kanban-backend/internal/core/domain"
+	ports "github.com/fatihsen-dev/kanban-backend/internal/core/ports/driven"
+)
+
+type AutomationEngine struct {
+	automationRuleRepo      ports.AutomationRuleRepository
+	automationExecutionRepo ports.AutomationExecutionRepository
+	taskRepo                ports.TaskRepository
+	columnRepo              ports.ColumnRepository
+	projectMemberRepo       ports.ProjectMemberRepository
+	taskRevisionRepo        ports.TaskRevisionRepository
+	taskWatcherRepo         ports.TaskWatcherRepository
+	activityService         *ActivityService
+	notificationService     *NotificationService
+	eventPublisher          ports.EventPublisher
+	transactor              ports.Transactor
+}
+
+func NewAutomationEngine(automationRuleRepo ports.AutomationRuleRepository, automationExecutionRepo ports.AutomationExecutionRepository, taskRepo ports.TaskRepository, columnRepo ports.ColumnRepository, projectMemberRepo ports.ProjectMemberRepository, taskRevisionRepo ports.TaskRevisionRepository, taskWatcherRepo ports.TaskWatcherRepository, activityService *ActivityService, notificationService *NotificationService, eventPublisher ports.EventPublisher, transactor ports.Transactor) *AutomationEngine {
+	return &AutomationEngine{automationRuleRepo: automationRuleRepo, automationExecutionRepo: automationExecutionRepo, taskRepo: taskRepo, columnRepo: columnRepo, projectMemberRepo: projectMemberRepo, taskRevisionRepo: taskRevisionRepo, taskWatcherRepo: taskWatcherRepo, activityService: activityService, notificationService: notificationService, eventPublisher: eventPublisher, transactor: transactor}
+}
+
+func (e *AutomationEngine) Handle(ctx context.Context, event *domain.TaskEvent) []*domain.AutomationOutcome {
+	rules, err := e.automationRuleRepo.GetEnabledRulesByTrigger(ctx, event.Task.ProjectID, event.Trigger)
+	if err != nil {
+		return nil
+	}
+
+	var outcomes []*domain.AutomationOutcome
+	for _, rule := range rules {
+		outcome := e.Run(ctx, rule, event)
+		if outcome == nil {
+			continue
+		}
+		outcomes = append(outcomes, outcome)
+		event = &domain.TaskEvent{Trigger: event.Trigger, Task: outcome.Task, PreviousColumnID: event.PreviousColumnID}
+	}
+
+	return outcomes
+}
+
+func (e *AutomationEngine) Run(ctx context.Context, rule *domain.AutomationRule, event *domain.TaskEvent) *domain.AutomationOutcome {
+	if !rule.Matches(event) {
+		return nil
+	}
+
+	var outcome *domain.AutomationOutcome
+	err := e.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		outcome, err = e.apply(ctx, rule, event.Task)
+		return err
+	})
+
+	execution := &domain.AutomationExecution{RuleID: rule.ID, TaskID: &event.Task.ID, Status: domain.AutomationExecutionSucceeded}
+	if err != nil {
+		message := err.Error()
+		execution.Status = domain.AutomationExecutionFailed
+		execution.Message = &message
+	}
+	e.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		return e.automationExecutionRepo.Save(ctx, execution)
+	})
+
+	if err != nil {
+		return nil
+	}
+	return outcome
+}
+
+func (e *AutomationEngine) apply(ctx context.Context, rule *domain.AutomationRule, task *domain.Task) (*domain.AutomationOutcome, error) {
+	changes := &domain.Task{ID: task.ID}
+	labels := task.Labels
+
+	for _, action := range rule.Actions {
+		switch action.Type {
+		case domain.AutomationActionSetCompletedAt:
+			now := time.Now().UTC()
+			changes.CompletedAt = &now
+		case domain.AutomationActionClearCompletedAt:
+			changes.CompletedAt = &time.Time{}
+		case domain.AutomationActionUnassign:
+			assigneeID := ""
+			changes.AssigneeID = &assigneeID
+		case domain.AutomationActionAssignTo:
+			_, err := e.projectMemberRepo.GetByUserIDAndProjectID(ctx, action.Value, task.ProjectID)
+			if err != nil {
+				return nil, domain.ErrInvalidAssignee
+			}
+			assigneeID := action.Value
+			changes.AssigneeID = &assigneeID
+		case domain.AutomationActionAddLabel:
+			if !containsLabel(labels, action.Value) {
+				labels = append(append([]string{}, labels...), action.Value)
+				changes.Labels = labels
+			}
+		case domain.AutomationActionRemoveLabel:
+			remaining := []string{}
+			for _, label := range labels {
+				if label != action.Value {
+					remaining = append(remaining, label)
+				}
+			}
+			labels = remaining
+			changes.Labels = labels
+		case domain.AutomationActionSetPriority:
+			changes.Priority = domain.TaskPriority(action.Value)
+		case domain.AutomationActionMoveToColumn:
+			column, err := e.columnRepo.GetByID(ctx, action.Value)
+			if err != nil || column.ProjectID != task.ProjectID || column.ArchivedAt != nil || column.DeletedAt != nil {
+				return nil, domain.ErrInvalidTargetColumn
+			}
+			changes.ColumnID = column.ID
+		}
+	}
+
+	err := e.taskRepo.Update(ctx, changes)
+	if err != nil {
+		return nil, err
+	}
+
+	updatedTask, err := e.taskRepo.GetByID(ctx, task.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if changes.AssigneeID != nil && *changes.AssigneeID != "" {
+		watchTask(ctx, e.transactor, e.taskWatcherRepo, updatedTask, []string{*changes.AssigneeID})
+	}
+
+	outcome := &domain.AutomationOutcome{RuleID: rule.ID, Task: updatedTask, Moved: updatedTask.ColumnID != task.ColumnID}
+
+	action := domain.ActivityActionUpdated
+	name := domain.EventTaskUpdated
+	if outcome.Moved {
+		action = domain.ActivityActionMoved
+		name = domain.EventTaskMoved
+	}
+	systemCtx := domain.ContextWithActor(ctx, "")
+	e.activityService.Record(systemCtx, task.ProjectID, domain.EntityTask, task.ID, action, task.Snapshot(), updatedTask.Snapshot())
+	saveTaskRevision(systemCtx, e.transactor, e.taskRevisionRepo, task, updatedTask)
+	e.notificationService.notifyAssignee(systemCtx, task, updatedTask)
+
+	err = e.eventPublisher.Publish(systemCtx, &domain.TaskChangedEvent{Name: name, Task: updatedTask})
+	if err != nil {
+		return nil, err
+	}
+
+	return outcome, nil
+}
+
+func containsLabel(labels []string, label string) bool {
+	for _, value := range labels {
+		if value == label {
+			return true
+		}
+	}
+	return false
+}
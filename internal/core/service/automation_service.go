@@ -0,0 +1,150 @@
+package service
+
+import (
+	"context"
+
+	"github.com/fatihsen-dev/kanban-backend/internal/core/domain"
+	ports "github.com/fatihsen-dev/kanban-backend/internal/core/ports/driven"
+)
+
+const automationExecutionLimit = 100
+
+type AutomationService struct {
+	automationRuleRepo      ports.AutomationRuleRepository
+	automationExecutionRepo ports.AutomationExecutionRepository
+	taskRepo                ports.TaskRepository
+	columnRepo              ports.ColumnRepository
+	projectMemberRepo       ports.ProjectMemberRepository
+	automationEngine        *AutomationEngine
+	eventPublisher          ports.EventPublisher
+}
+
+func NewAutomationService(automationRuleRepo ports.AutomationRuleRepository, automationExecutionRepo ports.AutomationExecutionRepository, taskRepo ports.TaskRepository, columnRepo ports.ColumnRepository, projectMemberRepo ports.ProjectMemberRepository, automationEngine *AutomationEngine, eventPublisher ports.EventPublisher) *AutomationService {
+	return &AutomationService{automationRuleRepo: automationRuleRepo, automationExecutionRepo: automationExecutionRepo, taskRepo: taskRepo, columnRepo: columnRepo, projectMemberRepo: projectMemberRepo, automationEngine: automationEngine, eventPublisher: eventPublisher}
+}
+
+func (s *AutomationService) CreateRule(ctx context.Context, rule *domain.AutomationRule) error {
+	err := s.validateRule(ctx, rule)
+	if err != nil {
+		return err
+	}
+
+	err = s.automationRuleRepo.Save(ctx, rule)
+	if err != nil {
+		return err
+	}
+
+	return s.eventPublisher.Publish(ctx, &domain.AutomationRuleChangedEvent{Name: domain.EventAutomationCreated, Rule: rule})
+}
+
+func (s *AutomationService) GetRuleByID(ctx context.Context, id string) (*domain.AutomationRule, error) {
+	return s.automationRuleRepo.GetByID(ctx, id)
+}
+
+func (s *AutomationService) GetRulesByProjectID(ctx context.Context, projectID string) ([]*domain.AutomationRule, error) {
+	return s.automationRuleRepo.GetRulesByProjectID(ctx, projectID)
+}
+
+func (s *AutomationService) UpdateRule(ctx context.Context, rule *domain.AutomationRule) error {
+	err := s.validateRule(ctx, rule)
+	if err != nil {
+		return err
+	}
+
+	err = s.automationRuleRepo.Update(ctx, rule)
+	if err != nil {
+		return err
+	}
+
+	return s.eventPublisher.Publish(ctx, &domain.AutomationRuleChangedEvent{Name: domain.EventAutomationUpdated, Rule: rule})
+}
+
+func (s *AutomationService) DeleteRuleByID(ctx context.Context, id string) error {
+	rule, err := s.automationRuleRepo.GetByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	err = s.automationRuleRepo.DeleteByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	return s.eventPublisher.Publish(ctx, &domain.EntityRemovedEvent{Name: domain.EventAutomationDeleted, ProjectID: rule.ProjectID, ID: rule.ID})
+}
+
+func (s *AutomationService) GetExecutionsByRuleID(ctx context.Context, ruleID string) ([]*domain.AutomationExecution, error) {
+	return s.automationExecutionRepo.GetExecutionsByRuleID(ctx, ruleID, automationExecutionLimit)
+}
+
+func (s *AutomationService) RunDueDateAutomations(ctx context.Context) ([]*domain.AutomationOutcome, error) {
+	rules, err := s.automationRuleRepo.GetAllEnabledRulesByTrigger(ctx, domain.AutomationTriggerTaskDueDatePassed)
+	if err != nil {
+		return nil, err
+	}
+
+	var outcomes []*domain.AutomationOutcome
+	for _, rule := range rules {
+		tasks, err := s.taskRepo.GetOverdueTasksForRule(ctx, rule.ProjectID, rule.ID)
+		if err != nil {
+			return outcomes, err
+		}
+
+		for _, task := range tasks {
+			outcome := s.automationEngine.Run(ctx, rule, &domain.TaskEvent{Trigger: domain.AutomationTriggerTaskDueDatePassed, Task: task})
+			if outcome != nil {
+				outcomes = append(outcomes, outcome)
+			}
+		}
+	}
+
+	return outcomes, nil
+}
+
+func (s *AutomationService) validateRule(ctx context.Context, rule *domain.AutomationRule) error {
+	for _, condition := range rule.Conditions {
+		if condition.RequiresValue() && condition.Value == "" {
+			return domain.ErrInvalidAutomationRule
+		}
+
+		switch condition.Type {
+		case domain.AutomationConditionColumnIs, domain.AutomationConditionFromColumnIs:
+			if !s.isProjectColumn(ctx, rule.ProjectID, condition.Value) {
+				return domain.ErrInvalidAutomationRule
+			}
+		case domain.AutomationConditionPriorityIs:
+			if !domain.IsValidTaskPriority(condition.Value) {
+				return domain.ErrInvalidAutomationRule
+			}
+		}
+	}
+
+	for _, action := range rule.Actions {
+		if action.RequiresValue() && action.Value == "" {
+			return domain.ErrInvalidAutomationRule
+		}
+
+		switch action.Type {
+		case domain.AutomationActionMoveToColumn:
+			if !s.isProjectColumn(ctx, rule.ProjectID, action.Value) {
+				return domain.ErrInvalidAutomationRule
+			}
+		case domain.AutomationActionAssignTo:
+			_, err := s.projectMemberRepo.GetByUserIDAndProjectID(ctx, action.Value, rule.ProjectID)
+			if err != nil {
+				return domain.ErrInvalidAutomationRule
+			}
+		case domain.AutomationActionSetPriority:
+			if !domain.IsValidTaskPriority(action.Value) {
+				return domain.ErrInvalidAutomationRule
+			}
+		}
+	}
+
+	return nil
+}
+
+func (s *AutomationService) isProjectColumn(ctx context.Context, projectID string, columnID string) bool {
+	column, err := s.columnRepo.GetByID(ctx, columnID)
+	return err == nil && column.ProjectID == projectID
+}
@@ -0,0 +1,148 @@
+package service
+
+import (
+	"context"
+
+	"github.com/fatihsen-dev/kanban-backend/internal/core/domain"
+	ports "github.com/fatihsen-dev/kanban-backend/internal/core/ports/driven"
+)
+
+type ChecklistService struct {
+	checklistItemRepo ports.ChecklistItemRepository
+	taskRepo          ports.TaskRepository
+	projectMemberRepo ports.ProjectMemberRepository
+	eventPublisher    ports.EventPublisher
+}
+
+func NewChecklistService(checklistItemRepo ports.ChecklistItemRepository, taskRepo ports.TaskRepository, projectMemberRepo ports.ProjectMemberRepository, eventPublisher ports.EventPublisher) *ChecklistService {
+	return &ChecklistService{checklistItemRepo: checklistItemRepo, taskRepo: taskRepo, projectMemberRepo: projectMemberRepo, eventPublisher: eventPublisher}
+}
+
+func (s *ChecklistService) AddItem(ctx context.Context, projectID string, item *domain.ChecklistItem) (domain.ChecklistProgress, error) {
+	err := s.checkAssignee(ctx, projectID, item.AssigneeID)
+	if err != nil {
+		return domain.ChecklistProgress{}, err
+	}
+
+	err = s.checklistItemRepo.Save(ctx, item)
+	if err != nil {
+		return domain.ChecklistProgress{}, err
+	}
+
+	return s.publishItemChange(ctx, domain.EventChecklistItemCreated, item)
+}
+
+func (s *ChecklistService) GetItemByID(ctx context.Context, id string) (*domain.ChecklistItem, error) {
+	return s.checklistItemRepo.GetByID(ctx, id)
+}
+
+func (s *ChecklistService) GetItemsByTaskID(ctx context.Context, taskID string) ([]*domain.ChecklistItem, error) {
+	return s.checklistItemRepo.GetItemsByTaskID(ctx, taskID)
+}
+
+func (s *ChecklistService) UpdateItem(ctx context.Context, projectID string, item *domain.ChecklistItem) (domain.ChecklistProgress, error) {
+	err := s.checkAssignee(ctx, projectID, item.AssigneeID)
+	if err != nil {
+		return domain.ChecklistProgress{}, err
+	}
+
+	err = s.checklistItemRepo.Update(ctx, item)
+	if err != nil {
+		return domain.ChecklistProgress{}, err
+	}
+
+	return s.publishItemChange(ctx, domain.EventChecklistItemUpdated, item)
+}
+
+func (s *ChecklistService) ToggleItem(ctx context.Context, item *domain.ChecklistItem) (domain.ChecklistProgress, error) {
+	item.Done = !item.Done
+
+	err := s.checklistItemRepo.Update(ctx, item)
+	if err != nil {
+		return domain.ChecklistProgress{}, err
+	}
+
+	return s.publishItemChange(ctx, domain.EventChecklistItemUpdated, item)
+}
+
+func (s *ChecklistService) ReorderItems(ctx context.Context, taskID string, itemIDs []string) ([]*domain.ChecklistItem, error) {
+	items, err := s.checklistItemRepo.GetItemsByTaskID(ctx, taskID)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(items) != len(itemIDs) {
+		return nil, domain.ErrInvalidChecklistOrder
+	}
+
+	seen := make(map[string]bool, len(itemIDs))
+	for _, itemID := range itemIDs {
+		if seen[itemID] {
+			return nil, domain.ErrInvalidChecklistOrder
+		}
+		seen[itemID] = true
+	}
+
+	for _, item := range items {
+		if !seen[item.ID] {
+			return nil, domain.ErrInvalidChecklistOrder
+		}
+	}
+
+	err = s.checklistItemRepo.Reorder(ctx, taskID, itemIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	task, err := s.taskRepo.GetByID(ctx, taskID)
+	if err != nil {
+		return nil, err
+	}
+
+	items, err = s.checklistItemRepo.GetItemsByTaskID(ctx, taskID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.eventPublisher.Publish(ctx, &domain.ChecklistReorderedEvent{ProjectID: task.ProjectID, TaskID: taskID, Items: items})
+	if err != nil {
+		return nil, err
+	}
+
+	return items, nil
+}
+
+func (s *ChecklistService) DeleteItem(ctx context.Context, item *domain.ChecklistItem) (domain.ChecklistProgress, error) {
+	err := s.checklistItemRepo.DeleteByID(ctx, item.ID)
+	if err != nil {
+		return domain.ChecklistProgress{}, err
+	}
+
+	return s.publishItemChange(ctx, domain.EventChecklistItemDeleted, item)
+}
+
+func (s *ChecklistService) publishItemChange(ctx context.Context, name domain.EventName, item *domain.ChecklistItem) (domain.ChecklistProgress, error) {
+	task, err := s.taskRepo.GetByID(ctx, item.TaskID)
+	if err != nil {
+		return domain.ChecklistProgress{}, err
+	}
+
+	err = s.eventPublisher.Publish(ctx, &domain.ChecklistItemChangedEvent{Name: name, ProjectID: task.ProjectID, Item: item, Progress: task.Checklist})
+	if err != nil {
+		return domain.ChecklistProgress{}, err
+	}
+
+	return task.Checklist, nil
+}
+
+func (s *ChecklistService) checkAssignee(ctx context.Context, projectID string, assigneeID *string) error {
+	if assigneeID == nil {
+		return nil
+	}
+
+	_, err := s.projectMemberRepo.GetByUserIDAndProjectID(ctx, *assigneeID, projectID)
+	if err != nil {
+		return domain.ErrInvalidAssignee
+	}
+	return nil
+}
@@ -2,18 +2,23 @@ package service
 
 import (
 	"context"
+	"errors"
 
 	"github.com/fatihsen-dev/kanban-backend/internal/core/domain"
 	ports "github.com/fatihsen-dev/kanban-backend/internal/core/ports/driven"
 )
 
 type ColumnService struct {
-	columnRepo ports.ColumnRepository
-	taskRepo   ports.TaskRepository
+	columnRepo      ports.ColumnRepository
+	taskRepo        ports.TaskRepository
+	projectRepo     ports.ProjectRepository
+	activityService *ActivityService
+	eventPublisher  ports.EventPublisher
+	transactor      ports.Transactor
 }
 
-func NewColumnService(columnRepo ports.ColumnRepository, taskRepo ports.TaskRepository) *ColumnService {
-	return &ColumnService{columnRepo: columnRepo, taskRepo: taskRepo}
+func NewColumnService(columnRepo ports.ColumnRepository, taskRepo ports.TaskRepository, projectRepo ports.ProjectRepository, activityService *ActivityService, eventPublisher ports.EventPublisher, transactor ports.Transactor) *ColumnService {
+	return &ColumnService{columnRepo: columnRepo, taskRepo: taskRepo, projectRepo: projectRepo, activityService: activityService, eventPublisher: eventPublisher, transactor: transactor}
 }
 
 func (s *ColumnService) CreateColumn(ctx context.Context, column *domain.Column) error {
@@ -21,19 +26,21 @@ func (s *ColumnService) CreateColumn(ctx context.Context, column *domain.Column)
 	if err != nil {
 		return err
 	}
-	return nil
+
+	s.activityService.Record(ctx, column.ProjectID, domain.EntityColumn, column.ID, domain.ActivityActionCreated, nil, column.Snapshot())
+	return s.eventPublisher.Publish(ctx, &domain.ColumnChangedEvent{Name: domain.EventColumnCreated, Column: column})
 }
 
-func (s *ColumnService) GetColumnByID(ctx context.Context, id string) (*domain.Column, error) {
-	return s.columnRepo.GetByID(ctx, id)
+func (s *ColumnService) GetColumnByID(ctx context.Context, projectID, id string) (*domain.Column, error) {
+	return s.getProjectColumn(ctx, projectID, id)
 }
 
 func (s *ColumnService) GetColumnsByProjectID(ctx context.Context, projectID string) ([]*domain.Column, error) {
 	return s.columnRepo.GetColumnsByProjectID(ctx, projectID)
 }
 
-func (s *ColumnService) GetColumnWithDetails(ctx context.Context, columnID string) (*domain.Column, []*domain.Task, error) {
-	column, err := s.columnRepo.GetByID(ctx, columnID)
+func (s *ColumnService) GetColumnWithDetails(ctx context.Context, projectID, columnID string) (*domain.Column, []*domain.Task, error) {
+	column, err := s.getProjectColumn(ctx, projectID, columnID)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -47,14 +54,178 @@ func (s *ColumnService) GetColumnWithDetails(ctx context.Context, columnID strin
 }
 
 func (s *ColumnService) UpdateColumn(ctx context.Context, column *domain.Column) error {
-	return s.columnRepo.Update(ctx, column)
+	currentColumn, err := s.getProjectColumn(ctx, column.ProjectID, column.ID)
+	if err != nil {
+		return err
+	}
+
+	err = s.columnRepo.Update(ctx, column)
+	if err != nil {
+		return err
+	}
+
+	updatedColumn, err := s.columnRepo.GetByID(ctx, column.ID)
+	if err != nil {
+		return err
+	}
+
+	s.activityService.Record(ctx, updatedColumn.ProjectID, domain.EntityColumn, updatedColumn.ID, domain.ActivityActionUpdated, currentColumn.Snapshot(), updatedColumn.Snapshot())
+	return s.eventPublisher.Publish(ctx, &domain.ColumnChangedEvent{Name: domain.EventColumnUpdated, Column: updatedColumn})
 }
 
-func (s *ColumnService) DeleteColumn(ctx context.Context, id string) error {
-	err := s.columnRepo.Delete(ctx, id)
+func (s *ColumnService) DeleteColumn(ctx context.Context, projectID, id string, targetColumnID *string) ([]string, error) {
+	if targetColumnID != nil && *targetColumnID == id {
+		return nil, domain.ErrInvalidTargetColumn
+	}
+
+	var column *domain.Column
+	var load *domain.ColumnLoad
+	movedTaskIDs := []string{}
+	trashedTaskIDs := []string{}
+	err := s.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		column, err = s.columnRepo.GetByIDForUpdate(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		if column.ProjectID != projectID || column.DeletedAt != nil {
+			return domain.ErrColumnNotFound
+		}
+
+		if targetColumnID == nil {
+			if column.TaskCount > 0 {
+				return domain.ErrColumnNotEmpty
+			}
+
+			trashedTaskIDs, err = s.taskRepo.TrashByColumnID(ctx, id)
+			if err != nil {
+				return err
+			}
+
+			return s.columnRepo.Delete(ctx, id)
+		}
+
+		target, err := s.columnRepo.GetByIDForUpdate(ctx, *targetColumnID)
+		if errors.Is(err, domain.ErrColumnNotFound) {
+			return domain.ErrInvalidTargetColumn
+		}
+		if err != nil {
+			return err
+		}
+
+		if target.ProjectID != column.ProjectID || target.ArchivedAt != nil || target.DeletedAt != nil {
+			return domain.ErrInvalidTargetColumn
+		}
+
+		load = target.Load()
+		load.TaskCount += column.TaskCount
+		err = enforceWipLimit(ctx, s.projectRepo, column.ProjectID, load)
+		if err != nil {
+			return err
+		}
+
+		movedTaskIDs, err = s.taskRepo.MoveToColumn(ctx, id, target.ID)
+		if err != nil {
+			return err
+		}
+
+		return s.columnRepo.Delete(ctx, id)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.activityService.Record(ctx, column.ProjectID, domain.EntityColumn, column.ID, domain.ActivityActionDeleted, column.Snapshot(), nil)
+	for _, taskID := range trashedTaskIDs {
+		s.activityService.Record(ctx, column.ProjectID, domain.EntityTask, taskID, domain.ActivityActionDeleted, nil, nil)
+
+		err = s.eventPublisher.Publish(ctx, &domain.EntityRemovedEvent{Name: domain.EventTaskDeleted, ProjectID: column.ProjectID, ID: taskID})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	for _, taskID := range movedTaskIDs {
+		s.activityService.Record(ctx, column.ProjectID, domain.EntityTask, taskID, domain.ActivityActionMoved, map[string]interface{}{"column_id": column.ID}, map[string]interface{}{"column_id": *targetColumnID})
+
+		task, err := s.taskRepo.GetByID(ctx, taskID)
+		if err != nil {
+			return nil, err
+		}
+
+		if task.ArchivedAt != nil || task.DeletedAt != nil {
+			continue
+		}
+
+		err = s.eventPublisher.Publish(ctx, &domain.TaskChangedEvent{Name: domain.EventTaskMoved, Task: task, Load: load})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err = s.eventPublisher.Publish(ctx, &domain.ColumnDeletedEvent{Column: column, TargetColumnID: targetColumnID, MovedTaskIDs: movedTaskIDs})
+	if err != nil {
+		return nil, err
+	}
+
+	return movedTaskIDs, nil
+}
+
+func (s *ColumnService) ArchiveColumn(ctx context.Context, projectID, id string) error {
+	column, err := s.getProjectColumn(ctx, projectID, id)
 	if err != nil {
 		return err
 	}
 
-	return nil
+	err = s.columnRepo.Archive(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	s.activityService.Record(ctx, column.ProjectID, domain.EntityColumn, column.ID, domain.ActivityActionArchived, nil, nil)
+	return s.eventPublisher.Publish(ctx, &domain.EntityRemovedEvent{Name: domain.EventColumnArchived, ProjectID: column.ProjectID, ID: column.ID})
+}
+
+func (s *ColumnService) RestoreColumn(ctx context.Context, projectID, id string) (*domain.Column, []*domain.Task, error) {
+	_, err := s.getProjectColumn(ctx, projectID, id)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	err = s.columnRepo.Restore(ctx, id)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	column, tasks, err := s.GetColumnWithDetails(ctx, projectID, id)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	s.activityService.Record(ctx, column.ProjectID, domain.EntityColumn, column.ID, domain.ActivityActionRestored, nil, nil)
+
+	err = s.eventPublisher.Publish(ctx, &domain.ColumnChangedEvent{Name: domain.EventColumnRestored, Column: column, Tasks: tasks})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return column, tasks, nil
+}
+
+func (s *ColumnService) getProjectColumn(ctx context.Context, projectID, id string) (*domain.Column, error) {
+	column, err := s.columnRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if column.ProjectID != projectID {
+		return nil, domain.ErrColumnNotFound
+	}
+
+	return column, nil
+}
+
+func (s *ColumnService) GetColumnsByState(ctx context.Context, projectID string, state domain.ArchiveState) ([]*domain.Column, error) {
+	return s.columnRepo.GetByProjectIDAndState(ctx, projectID, state)
 }
@@ -0,0 +1,69 @@
+package service
+
+import (
+	"context"
+
+	"github.com/fatihsen-dev/kanban-backend/internal/core/domain"
+	ports "github.com/fatihsen-dev/kanban-backend/internal/core/ports/driven"
+)
+
+type ColumnTransitionService struct {
+	columnTransitionRepo ports.ColumnTransitionRepository
+	columnRepo           ports.ColumnRepository
+	eventPublisher       ports.EventPublisher
+}
+
+func NewColumnTransitionService(columnTransitionRepo ports.ColumnTransitionRepository, columnRepo ports.ColumnRepository, eventPublisher ports.EventPublisher) *ColumnTransitionService {
+	return &ColumnTransitionService{columnTransitionRepo: columnTransitionRepo, columnRepo: columnRepo, eventPublisher: eventPublisher}
+}
+
+func (s *ColumnTransitionService) CreateTransition(ctx context.Context, transition *domain.ColumnTransition) error {
+	if transition.FromColumnID == transition.ToColumnID {
+		return domain.ErrInvalidTransition
+	}
+
+	for _, columnID := range []string{transition.FromColumnID, transition.ToColumnID} {
+		column, err := s.columnRepo.GetByID(ctx, columnID)
+		if err != nil || column.ProjectID != transition.ProjectID {
+			return domain.ErrInvalidTransition
+		}
+	}
+
+	err := s.columnTransitionRepo.Save(ctx, transition)
+	if err != nil {
+		return err
+	}
+
+	return s.eventPublisher.Publish(ctx, &domain.ColumnTransitionChangedEvent{Name: domain.EventTransitionCreated, Transition: transition})
+}
+
+func (s *ColumnTransitionService) GetTransitionByID(ctx context.Context, id string) (*domain.ColumnTransition, error) {
+	return s.columnTransitionRepo.GetByID(ctx, id)
+}
+
+func (s *ColumnTransitionService) GetTransitionsByProjectID(ctx context.Context, projectID string) ([]*domain.ColumnTransition, error) {
+	return s.columnTransitionRepo.GetTransitionsByProjectID(ctx, projectID)
+}
+
+func (s *ColumnTransitionService) UpdateTransition(ctx context.Context, transition *domain.ColumnTransition) error {
+	err := s.columnTransitionRepo.Update(ctx, transition)
+	if err != nil {
+		return err
+	}
+
+	return s.eventPublisher.Publish(ctx, &domain.ColumnTransitionChangedEvent{Name: domain.EventTransitionUpdated, Transition: transition})
+}
+
+func (s *ColumnTransitionService) DeleteTransitionByID(ctx context.Context, id string) error {
+	transition, err := s.columnTransitionRepo.GetByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	err = s.columnTransitionRepo.DeleteByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	return s.eventPublisher.Publish(ctx, &domain.EntityRemovedEvent{Name: domain.EventTransitionDeleted, ProjectID: transition.ProjectID, ID: transition.ID})
+}
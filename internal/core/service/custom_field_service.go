@@ -0,0 +1,65 @@
+package service
+
+import (
+	"context"
+
+	"github.com/fatihsen-dev/kanban-backend/internal/core/domain"
+	ports "github.com/fatihsen-dev/kanban-backend/internal/core/ports/driven"
+)
+
+type CustomFieldService struct {
+	customFieldRepo ports.CustomFieldRepository
+	eventPublisher  ports.EventPublisher
+}
+
+func NewCustomFieldService(customFieldRepo ports.CustomFieldRepository, eventPublisher ports.EventPublisher) *CustomFieldService {
+	return &CustomFieldService{customFieldRepo: customFieldRepo, eventPublisher: eventPublisher}
+}
+
+func (s *CustomFieldService) CreateCustomField(ctx context.Context, field *domain.CustomField) error {
+	if !field.IsValid() {
+		return domain.ErrInvalidCustomField
+	}
+
+	err := s.customFieldRepo.Save(ctx, field)
+	if err != nil {
+		return err
+	}
+
+	return s.eventPublisher.Publish(ctx, &domain.CustomFieldChangedEvent{Name: domain.EventCustomFieldCreated, Field: field})
+}
+
+func (s *CustomFieldService) GetCustomFieldByID(ctx context.Context, id string) (*domain.CustomField, error) {
+	return s.customFieldRepo.GetByID(ctx, id)
+}
+
+func (s *CustomFieldService) GetCustomFieldsByProjectID(ctx context.Context, projectID string) ([]*domain.CustomField, error) {
+	return s.customFieldRepo.GetFieldsByProjectID(ctx, projectID)
+}
+
+func (s *CustomFieldService) UpdateCustomField(ctx context.Context, field *domain.CustomField) error {
+	if !field.IsValid() {
+		return domain.ErrInvalidCustomField
+	}
+
+	err := s.customFieldRepo.Update(ctx, field)
+	if err != nil {
+		return err
+	}
+
+	return s.eventPublisher.Publish(ctx, &domain.CustomFieldChangedEvent{Name: domain.EventCustomFieldUpdated, Field: field})
+}
+
+func (s *CustomFieldService) DeleteCustomFieldByID(ctx context.Context, id string) error {
+	field, err := s.customFieldRepo.GetByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	err = s.customFieldRepo.DeleteByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	return s.eventPublisher.Publish(ctx, &domain.EntityRemovedEvent{Name: domain.EventCustomFieldDeleted, ProjectID: field.ProjectID, ID: field.ID})
+}
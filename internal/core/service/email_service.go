@@ -0,0 +1,260 @@
+package service
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/fatihsen-dev/kanban-backend/internal/core/domain"
+	ports "github.com/fatihsen-dev/kanban-backend/internal/core/ports/driven"
+	"go.uber.org/zap"
+)
+
+type EmailService struct {
+	preferenceRepo   ports.NotificationPreferenceRepository
+	userRepo         ports.UserRepository
+	taskRepo         ports.TaskRepository
+	notificationRepo ports.NotificationRepository
+	mailer           ports.Mailer
+	composer         ports.MailComposer
+	unsubscribeKey   []byte
+	apiURL           string
+	digestHour       int
+}
+
+func NewEmailService(preferenceRepo ports.NotificationPreferenceRepository, userRepo ports.UserRepository, taskRepo ports.TaskRepository, notificationRepo ports.NotificationRepository, mailer ports.Mailer, composer ports.MailComposer, secret string, apiURL string, digestHour int) *EmailService {
+	return &EmailService{
+		preferenceRepo:   preferenceRepo,
+		userRepo:         userRepo,
+		taskRepo:         taskRepo,
+		notificationRepo: notificationRepo,
+		mailer:           mailer,
+		composer:         composer,
+		unsubscribeKey:   []byte("unsubscribe:" + secret),
+		apiURL:           strings.TrimRight(apiURL, "/"),
+		digestHour:       digestHour,
+	}
+}
+
+func (s *EmailService) GetPreferences(ctx context.Context, userID string) (domain.NotificationPreferences, error) {
+	preferences, err := s.preferenceRepo.GetByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	return preferences.WithDefaults(), nil
+}
+
+func (s *EmailService) UpdatePreferences(ctx context.Context, userID string, preferences domain.NotificationPreferences) (domain.NotificationPreferences, error) {
+	for notificationType, delivery := range preferences {
+		if !domain.IsValidEmailDelivery(notificationType, string(delivery)) {
+			return nil, domain.ErrInvalidEmailDelivery
+		}
+	}
+
+	err := s.preferenceRepo.Save(ctx, userID, preferences)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.GetPreferences(ctx, userID)
+}
+
+func (s *EmailService) Unsubscribe(ctx context.Context, token string) (domain.NotificationType, error) {
+	userID, notificationType, err := s.parseUnsubscribeToken(token)
+	if err != nil {
+		return "", err
+	}
+
+	err = s.preferenceRepo.Save(ctx, userID, domain.NotificationPreferences{notificationType: domain.EmailDeliveryOff})
+	if err != nil {
+		return "", err
+	}
+
+	return notificationType, nil
+}
+
+func (s *EmailService) SendNotificationEmail(ctx context.Context, notification *domain.Notification) {
+	preferences, err := s.preferenceRepo.GetByUserID(ctx, notification.RecipientID)
+	if err != nil {
+		zap.L().Error("Failed to get notification preferences", zap.String("recipient_id", notification.RecipientID), zap.Error(err))
+		return
+	}
+
+	if preferences.EmailDelivery(notification.Type) != domain.EmailDeliveryImmediate {
+		return
+	}
+
+	recipient, err := s.userRepo.GetByID(ctx, notification.RecipientID)
+	if err != nil {
+		zap.L().Error("Failed to get notification recipient", zap.String("recipient_id", notification.RecipientID), zap.Error(err))
+		return
+	}
+
+	message, err := s.composer.ComposeNotification(recipient, notification, s.unsubscribeURL(recipient.ID, notification.Type))
+	if err != nil {
+		zap.L().Error("Failed to compose notification email", zap.String("type", string(notification.Type)), zap.Error(err))
+		return
+	}
+
+	err = s.mailer.Send(ctx, message)
+	if err != nil {
+		zap.L().Error("Failed to send notification email", zap.String("type", string(notification.Type)), zap.String("recipient_id", recipient.ID), zap.Error(err))
+	}
+}
+
+func (s *EmailService) SendDailyDigests(ctx context.Context, now time.Time) (int, error) {
+	now = now.UTC()
+	cutoff := time.Date(now.Year(), now.Month(), now.Day(), s.digestHour, 0, 0, 0, time.UTC)
+	if now.Before(cutoff) {
+		cutoff = cutoff.Add(-domain.DigestInterval)
+	}
+
+	userIDs, err := s.preferenceRepo.GetDigestRecipientIDs(ctx, cutoff)
+	if err != nil {
+		return 0, err
+	}
+
+	sent := 0
+	for _, userID := range userIDs {
+		delivered, err := s.sendDigest(ctx, userID, now)
+		if err != nil {
+			zap.L().Error("Failed to send daily digest", zap.String("user_id", userID), zap.Error(err))
+			continue
+		}
+
+		err = s.preferenceRepo.MarkDigestSent(ctx, userID, now)
+		if err != nil {
+			return sent, err
+		}
+
+		if delivered {
+			sent++
+		}
+	}
+
+	return sent, nil
+}
+
+func (s *EmailService) sendDigest(ctx context.Context, userID string, now time.Time) (bool, error) {
+	recipient, err := s.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return false, err
+	}
+
+	preferences, err := s.preferenceRepo.GetByUserID(ctx, userID)
+	if err != nil {
+		return false, err
+	}
+
+	since := now.Add(-domain.DigestInterval)
+	lastDigestAt, err := s.preferenceRepo.GetLastDigestAt(ctx, userID)
+	if err != nil {
+		return false, err
+	}
+	if lastDigestAt != nil {
+		since = *lastDigestAt
+	}
+
+	digest := &domain.Digest{
+		Recipient:      recipient,
+		AssignedTasks:  []*domain.Task{},
+		DueSoon:        []*domain.Task{},
+		Notifications:  []*domain.Notification{},
+		Since:          since,
+		UnsubscribeURL: s.unsubscribeURL(userID, domain.NotificationDailyDigest),
+	}
+
+	tasks, err := s.taskRepo.GetOpenTasksByAssignee(ctx, userID, domain.DigestTaskLimit)
+	if err != nil {
+		return false, err
+	}
+
+	dueSoonBefore := now.Add(domain.DigestDueSoonWindow)
+	for _, task := range tasks {
+		if task.DueDate != nil && task.DueDate.Before(dueSoonBefore) {
+			digest.DueSoon = append(digest.DueSoon, task)
+		} else {
+			digest.AssignedTasks = append(digest.AssignedTasks, task)
+		}
+	}
+
+	digestTypes := []domain.NotificationType{}
+	for _, notificationType := range domain.NotificationPreferenceTypes {
+		if notificationType != domain.NotificationDailyDigest && preferences.EmailDelivery(notificationType) == domain.EmailDeliveryDigest {
+			digestTypes = append(digestTypes, notificationType)
+		}
+	}
+
+	if len(digestTypes) > 0 {
+		page, err := s.notificationRepo.Query(ctx, &domain.NotificationFilter{
+			RecipientID: userID,
+			UnreadOnly:  true,
+			Since:       &since,
+			Types:       digestTypes,
+			Limit:       domain.MaxNotificationPageSize,
+		})
+		if err != nil {
+			return false, err
+		}
+		digest.Notifications = page.Notifications
+	}
+
+	if digest.IsEmpty() {
+		return false, nil
+	}
+
+	message, err := s.composer.ComposeDigest(digest)
+	if err != nil {
+		return false, err
+	}
+
+	err = s.mailer.Send(ctx, message)
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+func (s *EmailService) unsubscribeURL(userID string, notificationType domain.NotificationType) string {
+	return s.apiURL + "/notifications/unsubscribe?token=" + url.QueryEscape(s.signUnsubscribeToken(userID, notificationType))
+}
+
+func (s *EmailService) signUnsubscribeToken(userID string, notificationType domain.NotificationType) string {
+	payload := base64.RawURLEncoding.EncodeToString([]byte(userID + ":" + string(notificationType)))
+	return payload + "." + base64.RawURLEncoding.EncodeToString(s.unsubscribeSignature(payload))
+}
+
+func (s *EmailService) parseUnsubscribeToken(token string) (string, domain.NotificationType, error) {
+	payload, signature, ok := strings.Cut(token, ".")
+	if !ok {
+		return "", "", domain.ErrInvalidUnsubscribeToken
+	}
+
+	decodedSignature, err := base64.RawURLEncoding.DecodeString(signature)
+	if err != nil || !hmac.Equal(decodedSignature, s.unsubscribeSignature(payload)) {
+		return "", "", domain.ErrInvalidUnsubscribeToken
+	}
+
+	decodedPayload, err := base64.RawURLEncoding.DecodeString(payload)
+	if err != nil {
+		return "", "", domain.ErrInvalidUnsubscribeToken
+	}
+
+	userID, notificationType, ok := strings.Cut(string(decodedPayload), ":")
+	if !ok || userID == "" || !domain.IsValidEmailDelivery(domain.NotificationType(notificationType), string(domain.EmailDeliveryOff)) {
+		return "", "", domain.ErrInvalidUnsubscribeToken
+	}
+
+	return userID, domain.NotificationType(notificationType), nil
+}
+
+func (s *EmailService) unsubscribeSignature(payload string) []byte {
+	mac := hmac.New(sha256.New, s.unsubscribeKey)
+	mac.Write([]byte(payload))
+	return mac.Sum(nil)
+}
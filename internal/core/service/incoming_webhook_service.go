@@ -0,0 +1,123 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+
+	"github.com/fatihsen-dev/kanban-backend/internal/core/domain"
+	ports "github.com/fatihsen-dev/kanban-backend/internal/core/ports/driven"
+	"go.uber.org/zap"
+)
+
+type IncomingWebhookService struct {
+	incomingWebhookRepo ports.IncomingWebhookRepository
+	columnRepo          ports.ColumnRepository
+	taskService         *TaskService
+	activityService     *ActivityService
+	transactor          ports.Transactor
+}
+
+func NewIncomingWebhookService(incomingWebhookRepo ports.IncomingWebhookRepository, columnRepo ports.ColumnRepository, taskService *TaskService, activityService *ActivityService, transactor ports.Transactor) *IncomingWebhookService {
+	return &IncomingWebhookService{incomingWebhookRepo: incomingWebhookRepo, columnRepo: columnRepo, taskService: taskService, activityService: activityService, transactor: transactor}
+}
+
+func (s *IncomingWebhookService) GetIncomingWebhook(ctx context.Context, projectID string) (*domain.IncomingWebhook, error) {
+	return s.incomingWebhookRepo.GetByProjectID(ctx, projectID)
+}
+
+func (s *IncomingWebhookService) SaveIncomingWebhook(ctx context.Context, webhook *domain.IncomingWebhook, rotateSecret bool) error {
+	if webhook.TargetColumnID != nil {
+		column, err := s.columnRepo.GetByID(ctx, *webhook.TargetColumnID)
+		if err != nil || column.ProjectID != webhook.ProjectID || column.ArchivedAt != nil || column.DeletedAt != nil {
+			return domain.ErrInvalidIncomingWebhook
+		}
+	}
+
+	if webhook.Secret == "" || rotateSecret {
+		secret := make([]byte, 32)
+		if _, err := rand.Read(secret); err != nil {
+			return err
+		}
+		webhook.Secret = hex.EncodeToString(secret)
+	}
+
+	return s.incomingWebhookRepo.Save(ctx, webhook)
+}
+
+func (s *IncomingWebhookService) DeleteIncomingWebhook(ctx context.Context, projectID string) error {
+	return s.incomingWebhookRepo.DeleteByProjectID(ctx, projectID)
+}
+
+func (s *IncomingWebhookService) VerifyPush(ctx context.Context, projectID, signature string, payload []byte) (*domain.IncomingWebhook, error) {
+	webhook, err := s.incomingWebhookRepo.GetByProjectID(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !webhook.VerifySignature(signature, payload) {
+		return nil, domain.ErrInvalidPushSignature
+	}
+
+	return webhook, nil
+}
+
+func (s *IncomingWebhookService) HandlePush(ctx context.Context, webhook *domain.IncomingWebhook, event *domain.PushEvent) []*domain.PushTaskResult {
+	ctx = domain.ContextWithActor(ctx, "")
+	results := []*domain.PushTaskResult{}
+
+	for i := range event.Commits {
+		commit := &event.Commits[i]
+
+		for _, reference := range domain.ParseCommitReferences(commit.Message) {
+			result := &domain.PushTaskResult{Ref: reference.Ref, CommitID: commit.ID, Closes: reference.Closes}
+			results = append(results, result)
+
+			task, err := s.getReferencedTask(ctx, webhook.ProjectID, reference)
+			if err != nil {
+				result.Error = err
+				continue
+			}
+			result.Task = task
+
+			s.activityService.Record(ctx, task.ProjectID, domain.EntityTask, task.ID, domain.ActivityActionReferenced, nil, commit.Snapshot(event))
+
+			if !reference.Closes || webhook.TargetColumnID == nil || task.ColumnID == *webhook.TargetColumnID {
+				continue
+			}
+
+			err = s.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+				var err error
+				result.Result, err = s.taskService.UpdateTask(ctx, &domain.Task{ID: task.ID, ProjectID: task.ProjectID, ColumnID: *webhook.TargetColumnID})
+				return err
+			})
+			if err != nil {
+				zap.L().Warn("Failed to move referenced task", zap.String("task_id", task.ID), zap.String("commit_id", commit.ID), zap.Error(err))
+				result.Error = err
+				continue
+			}
+
+			task.ColumnID = *webhook.TargetColumnID
+			result.Moved = true
+		}
+	}
+
+	return results
+}
+
+func (s *IncomingWebhookService) getReferencedTask(ctx context.Context, projectID string, reference domain.CommitReference) (*domain.Task, error) {
+	var task *domain.Task
+	var err error
+	if reference.IsTaskKey() {
+		task, err = s.taskService.GetTaskByKey(ctx, reference.Ref)
+	} else {
+		task, err = s.taskService.GetTaskByID(ctx, projectID, reference.Ref)
+	}
+
+	if err != nil || task.ProjectID != projectID || task.ArchivedAt != nil || task.DeletedAt != nil {
+		return nil, errors.New("referenced task not found in this project")
+	}
+
+	return task, nil
+}
@@ -3,7 +3,6 @@ package service
 import (
 	"context"
 	"errors"
-	"time"
 
 	"github.com/fatihsen-dev/kanban-backend/internal/adapters/driver/http/datatransfers/requests"
 	"github.com/fatihsen-dev/kanban-backend/internal/adapters/driver/http/datatransfers/responses"
@@ -12,47 +11,24 @@ import (
 )
 
 type InvitationService struct {
-	invitationRepo    ports.InvitationRepository
-	userRepo          ports.UserRepository
-	projectRepo       ports.ProjectRepository
-	projectMemberRepo ports.ProjectMemberRepository
+	invitationRepo      ports.InvitationRepository
+	userRepo            ports.UserRepository
+	projectRepo         ports.ProjectRepository
+	projectMemberRepo   ports.ProjectMemberRepository
+	activityService     *ActivityService
+	notificationService *NotificationService
+	eventPublisher      ports.EventPublisher
 }
 
-func NewInvitationService(invitationRepo ports.InvitationRepository, userRepo ports.UserRepository, projectRepo ports.ProjectRepository, projectMemberRepo ports.ProjectMemberRepository) *InvitationService {
+func NewInvitationService(invitationRepo ports.InvitationRepository, userRepo ports.UserRepository, projectRepo ports.ProjectRepository, projectMemberRepo ports.ProjectMemberRepository, activityService *ActivityService, notificationService *NotificationService, eventPublisher ports.EventPublisher) *InvitationService {
 	return &InvitationService{
-		invitationRepo:    invitationRepo,
-		userRepo:          userRepo,
-		projectRepo:       projectRepo,
-		projectMemberRepo: projectMemberRepo,
-	}
-}
-
-func (s *InvitationService) buildInvitationResponse(invitation *domain.Invitation, invitee *domain.User, inviter *domain.User, project *domain.Project) responses.InvitationResponse {
-	return responses.InvitationResponse{
-		ID: invitation.ID,
-		Inviter: responses.UserResponse{
-			ID:        inviter.ID,
-			Name:      inviter.Name,
-			Email:     inviter.Email,
-			IsAdmin:   inviter.IsAdmin,
-			CreatedAt: inviter.CreatedAt.Format(time.RFC3339),
-		},
-		Invitee: responses.UserResponse{
-			ID:        invitee.ID,
-			Name:      invitee.Name,
-			Email:     invitee.Email,
-			IsAdmin:   invitee.IsAdmin,
-			CreatedAt: invitee.CreatedAt.Format(time.RFC3339),
-		},
-		Project: responses.ProjectResponse{
-			ID:        project.ID,
-			Name:      project.Name,
-			OwnerID:   project.OwnerID,
-			CreatedAt: project.CreatedAt.Format(time.RFC3339),
-		},
-		Message:   invitation.Message,
-		Status:    string(invitation.Status),
-		CreatedAt: invitation.CreatedAt.Format(time.RFC3339),
+		invitationRepo:      invitationRepo,
+		userRepo:            userRepo,
+		projectRepo:         projectRepo,
+		projectMemberRepo:   projectMemberRepo,
+		activityService:     activityService,
+		notificationService: notificationService,
+		eventPublisher:      eventPublisher,
 	}
 }
 
@@ -67,6 +43,7 @@ func (s *InvitationService) CreateInvitations(ctx context.Context, invitations [
 
 	for _, invitation := range invitations {
 		if invitation.ID != "" {
+			s.activityService.Record(ctx, invitation.ProjectID, domain.EntityInvitation, invitation.ID, domain.ActivityActionCreated, nil, invitation.Snapshot())
 			userIDs[invitation.InviteeID] = struct{}{}
 			userIDs[invitation.InviterID] = struct{}{}
 			projectIDs[invitation.ProjectID] = struct{}{}
@@ -109,7 +86,13 @@ func (s *InvitationService) CreateInvitations(ctx context.Context, invitations [
 			invitee := userMap[invitation.InviteeID]
 			inviter := userMap[invitation.InviterID]
 			project := projectMap[invitation.ProjectID]
-			responseData = append(responseData, s.buildInvitationResponse(invitation, invitee, inviter, project))
+			responseData = append(responseData, responses.NewInvitationResponse(invitation, invitee, inviter, project))
+			s.notificationService.Notify(ctx, domain.NewInvitationReceivedNotification(invitation, project))
+
+			err = s.eventPublisher.Publish(ctx, &domain.InvitationCreatedEvent{Invitation: invitation, Invitee: invitee, Inviter: inviter, Project: project})
+			if err != nil {
+				return nil, err
+			}
 		}
 	}
 
@@ -169,7 +152,7 @@ func (s *InvitationService) GetInvitations(ctx context.Context, userID string) (
 			invitee := userMap[invitation.InviteeID]
 			inviter := userMap[invitation.InviterID]
 			project := projectMap[invitation.ProjectID]
-			responseData = append(responseData, s.buildInvitationResponse(invitation, invitee, inviter, project))
+			responseData = append(responseData, responses.NewInvitationResponse(invitation, invitee, inviter, project))
 		}
 	}
 
@@ -199,6 +182,15 @@ func (s *InvitationService) UpdateInvitationStatus(ctx context.Context, request
 		return nil, nil, err
 	}
 
+	updatedInvitation := *invitation
+	updatedInvitation.Status = domain.InvitationStatus(request.Status)
+
+	action := domain.ActivityActionRejected
+	if updatedInvitation.Status == domain.InvitationStatusAccepted {
+		action = domain.ActivityActionAccepted
+	}
+	s.activityService.Record(ctx, invitation.ProjectID, domain.EntityInvitation, invitation.ID, action, invitation.Snapshot(), updatedInvitation.Snapshot())
+
 	if request.Status == string(domain.InvitationStatusAccepted) {
 		projectMember := &domain.ProjectMember{
 			UserID:    request.UserID,
@@ -211,11 +203,18 @@ func (s *InvitationService) UpdateInvitationStatus(ctx context.Context, request
 			return nil, nil, err
 		}
 
+		s.activityService.Record(ctx, projectMember.ProjectID, domain.EntityProjectMember, projectMember.ID, domain.ActivityActionCreated, nil, projectMember.Snapshot())
+
 		user, err := s.userRepo.GetByID(ctx, request.UserID)
 		if err != nil {
 			return nil, nil, err
 		}
 
+		err = s.eventPublisher.Publish(ctx, &domain.ProjectMemberChangedEvent{Name: domain.EventProjectMemberCreated, Member: projectMember, User: user})
+		if err != nil {
+			return nil, nil, err
+		}
+
 		return projectMember, user, nil
 	}
 
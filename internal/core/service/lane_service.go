@@ -0,0 +1,57 @@
+package service
+
+import (
+	"context"
+
+	"github.com/fatihsen-dev/kanban-backend/internal/core/domain"
+	ports "github.com/fatihsen-dev/kanban-backend/internal/core/ports/driven"
+)
+
+type LaneService struct {
+	laneRepo       ports.LaneRepository
+	eventPublisher ports.EventPublisher
+}
+
+func NewLaneService(laneRepo ports.LaneRepository, eventPublisher ports.EventPublisher) *LaneService {
+	return &LaneService{laneRepo: laneRepo, eventPublisher: eventPublisher}
+}
+
+func (s *LaneService) CreateLane(ctx context.Context, lane *domain.Lane) error {
+	err := s.laneRepo.Save(ctx, lane)
+	if err != nil {
+		return err
+	}
+
+	return s.eventPublisher.Publish(ctx, &domain.LaneChangedEvent{Name: domain.EventLaneCreated, Lane: lane})
+}
+
+func (s *LaneService) GetLaneByID(ctx context.Context, id string) (*domain.Lane, error) {
+	return s.laneRepo.GetByID(ctx, id)
+}
+
+func (s *LaneService) GetLanesByProjectID(ctx context.Context, projectID string) ([]*domain.Lane, error) {
+	return s.laneRepo.GetLanesByProjectID(ctx, projectID)
+}
+
+func (s *LaneService) UpdateLane(ctx context.Context, lane *domain.Lane) error {
+	err := s.laneRepo.Update(ctx, lane)
+	if err != nil {
+		return err
+	}
+
+	return s.eventPublisher.Publish(ctx, &domain.LaneChangedEvent{Name: domain.EventLaneUpdated, Lane: lane})
+}
+
+func (s *LaneService) DeleteLaneByID(ctx context.Context, id string) error {
+	lane, err := s.laneRepo.GetByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	err = s.laneRepo.DeleteByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	return s.eventPublisher.Publish(ctx, &domain.EntityRemovedEvent{Name: domain.EventLaneDeleted, ProjectID: lane.ProjectID, ID: lane.ID})
+}
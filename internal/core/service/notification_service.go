@@ -0,0 +1,141 @@
+package service
+
+import (
+	"context"
+
+	"github.com/fatihsen-dev/kanban-backend/internal/core/domain"
+	ports "github.com/fatihsen-dev/kanban-backend/internal/core/ports/driven"
+	"go.uber.org/zap"
+)
+
+type NotificationService struct {
+	notificationRepo ports.NotificationRepository
+	taskRepo         ports.TaskRepository
+	emailService     *EmailService
+	eventPublisher   ports.EventPublisher
+	transactor       ports.Transactor
+}
+
+func NewNotificationService(notificationRepo ports.NotificationRepository, taskRepo ports.TaskRepository, emailService *EmailService, eventPublisher ports.EventPublisher, transactor ports.Transactor) *NotificationService {
+	return &NotificationService{notificationRepo: notificationRepo, taskRepo: taskRepo, emailService: emailService, eventPublisher: eventPublisher, transactor: transactor}
+}
+
+func (s *NotificationService) Notify(ctx context.Context, notification *domain.Notification) {
+	notification.ActorID = domain.ActorFromContext(ctx)
+	if notification.ActorID != nil && *notification.ActorID == notification.RecipientID {
+		return
+	}
+
+	err := s.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		err := s.notificationRepo.Save(ctx, notification)
+		if err != nil {
+			return err
+		}
+
+		unreadCount, err := s.notificationRepo.CountUnread(ctx, notification.RecipientID)
+		if err != nil {
+			return err
+		}
+
+		return s.eventPublisher.Publish(ctx, &domain.NotificationCreatedEvent{Notification: notification, UnreadCount: unreadCount})
+	})
+	if err != nil {
+		zap.L().Error("Failed to save notification", zap.String("type", string(notification.Type)), zap.String("recipient_id", notification.RecipientID), zap.Error(err))
+	}
+}
+
+func (s *NotificationService) HandleEvent(ctx context.Context, event domain.Event) error {
+	notificationEvent, ok := event.(*domain.NotificationCreatedEvent)
+	if !ok {
+		return nil
+	}
+
+	s.emailService.SendNotificationEmail(ctx, notificationEvent.Notification)
+	return nil
+}
+
+func (s *NotificationService) GetNotifications(ctx context.Context, filter *domain.NotificationFilter) (*domain.NotificationPage, error) {
+	page, err := s.notificationRepo.Query(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	page.UnreadCount, err = s.notificationRepo.CountUnread(ctx, filter.RecipientID)
+	if err != nil {
+		return nil, err
+	}
+
+	return page, nil
+}
+
+func (s *NotificationService) CountUnread(ctx context.Context, recipientID string) (int, error) {
+	return s.notificationRepo.CountUnread(ctx, recipientID)
+}
+
+func (s *NotificationService) MarkRead(ctx context.Context, recipientID string, ids []string) ([]string, error) {
+	markedIDs, err := s.notificationRepo.MarkRead(ctx, recipientID, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.publishReadState(ctx, recipientID, markedIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	return markedIDs, nil
+}
+
+func (s *NotificationService) MarkAllRead(ctx context.Context, recipientID string) (int64, error) {
+	marked, err := s.notificationRepo.MarkAllRead(ctx, recipientID)
+	if err != nil {
+		return 0, err
+	}
+
+	err = s.publishReadState(ctx, recipientID, []string{})
+	if err != nil {
+		return 0, err
+	}
+
+	return marked, nil
+}
+
+func (s *NotificationService) publishReadState(ctx context.Context, recipientID string, ids []string) error {
+	unreadCount, err := s.notificationRepo.CountUnread(ctx, recipientID)
+	if err != nil {
+		return err
+	}
+
+	return s.eventPublisher.Publish(ctx, &domain.NotificationsReadEvent{RecipientID: recipientID, IDs: ids, UnreadCount: unreadCount})
+}
+
+func (s *NotificationService) SendDueDateReminders(ctx context.Context) (int, error) {
+	tasks, err := s.taskRepo.GetTasksDueForReminder(ctx, domain.DueDateReminderWindow)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, task := range tasks {
+		s.Notify(ctx, domain.NewDueDateReminderNotification(task))
+	}
+
+	return len(tasks), nil
+}
+
+func (s *NotificationService) notifyAssignee(ctx context.Context, before, after *domain.Task) {
+	if after.AssigneeID == nil {
+		return
+	}
+
+	if before != nil && before.AssigneeID != nil && *before.AssigneeID == *after.AssigneeID {
+		return
+	}
+
+	s.Notify(ctx, domain.NewTaskAssignedNotification(after))
+}
+
+func (s *NotificationService) notifyMentioned(ctx context.Context, before, after *domain.Task) {
+	for _, userID := range domain.NewlyMentionedUserIDs(before, after) {
+		s.Notify(ctx, domain.NewMentionedNotification(after, userID))
+	}
+}
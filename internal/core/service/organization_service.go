@@ -0,0 +1,317 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/fatihsen-dev/kanban-backend/internal/core/domain"
+	ports "github.com/fatihsen-dev/kanban-backend/internal/core/ports/driven"
+)
+
+type OrganizationService struct {
+	organizationRepo       ports.OrganizationRepository
+	organizationMemberRepo ports.OrganizationMemberRepository
+	organizationTeamRepo   ports.OrganizationTeamRepository
+	projectRepo            ports.ProjectRepository
+	userRepo               ports.UserRepository
+}
+
+func NewOrganizationService(organizationRepo ports.OrganizationRepository, organizationMemberRepo ports.OrganizationMemberRepository, organizationTeamRepo ports.OrganizationTeamRepository, projectRepo ports.ProjectRepository, userRepo ports.UserRepository) *OrganizationService {
+	return &OrganizationService{
+		organizationRepo:       organizationRepo,
+		organizationMemberRepo: organizationMemberRepo,
+		organizationTeamRepo:   organizationTeamRepo,
+		projectRepo:            projectRepo,
+		userRepo:               userRepo,
+	}
+}
+
+func (s *OrganizationService) CreateOrganization(ctx context.Context, organization *domain.Organization) error {
+	err := s.organizationRepo.Save(ctx, organization)
+	if err != nil {
+		return err
+	}
+
+	return s.organizationMemberRepo.Save(ctx, &domain.OrganizationMember{
+		OrganizationID: organization.ID,
+		UserID:         organization.OwnerID,
+		Role:           domain.OrganizationOwnerRole,
+	})
+}
+
+func (s *OrganizationService) GetOrganizationByID(ctx context.Context, id string) (*domain.Organization, error) {
+	return s.organizationRepo.GetByID(ctx, id)
+}
+
+func (s *OrganizationService) GetUserOrganizations(ctx context.Context, userID string) ([]*domain.Organization, error) {
+	members, err := s.organizationMemberRepo.GetByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	organizationIDs := make([]string, len(members))
+	for i, member := range members {
+		organizationIDs[i] = member.OrganizationID
+	}
+
+	return s.organizationRepo.GetByIDs(ctx, organizationIDs)
+}
+
+func (s *OrganizationService) GetOrganizationWithDetails(ctx context.Context, id string) (*domain.OrganizationDetails, error) {
+	organization, err := s.organizationRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	members, err := s.organizationMemberRepo.GetMembersByOrganizationID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	userIDs := make([]string, len(members))
+	for i, member := range members {
+		userIDs[i] = member.UserID
+	}
+
+	users, err := s.userRepo.GetByIDs(ctx, userIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	teams, err := s.organizationTeamRepo.GetTeamsByOrganizationID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	teamIDs := make([]string, len(teams))
+	for i, team := range teams {
+		teamIDs[i] = team.ID
+	}
+
+	teamProjects, err := s.organizationTeamRepo.GetProjectGrantsByTeamIDs(ctx, teamIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	return &domain.OrganizationDetails{
+		Organization: organization,
+		Members:      members,
+		Users:        users,
+		Teams:        teams,
+		TeamProjects: teamProjects,
+	}, nil
+}
+
+func (s *OrganizationService) UpdateOrganization(ctx context.Context, organization *domain.Organization) error {
+	return s.organizationRepo.Update(ctx, organization)
+}
+
+func (s *OrganizationService) DeleteOrganization(ctx context.Context, id string) error {
+	projects, err := s.projectRepo.GetByOrganizationID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if len(projects) > 0 {
+		return errors.New("organization still owns projects")
+	}
+
+	return s.organizationRepo.DeleteByID(ctx, id)
+}
+
+func (s *OrganizationService) GetOrganizationProjects(ctx context.Context, organizationID string) ([]*domain.Project, error) {
+	return s.projectRepo.GetByOrganizationID(ctx, organizationID)
+}
+
+func (s *OrganizationService) GetMembership(ctx context.Context, userID, organizationID string) (*domain.OrganizationMember, error) {
+	return s.organizationMemberRepo.GetByUserIDAndOrganizationID(ctx, userID, organizationID)
+}
+
+func (s *OrganizationService) AddOrganizationMember(ctx context.Context, member *domain.OrganizationMember, actor *domain.OrganizationMember) error {
+	if member.Role == domain.OrganizationAdminRole && actor.Role != domain.OrganizationOwnerRole {
+		return domain.ErrOrganizationAdminGrant
+	}
+
+	_, err := s.organizationMemberRepo.GetByUserIDAndOrganizationID(ctx, member.UserID, member.OrganizationID)
+	if err == nil {
+		return errors.New("user is already a member of this organization")
+	}
+
+	return s.organizationMemberRepo.Save(ctx, member)
+}
+
+func (s *OrganizationService) UpdateOrganizationMember(ctx context.Context, member *domain.OrganizationMember) error {
+	existingMember, err := s.getOrganizationMember(ctx, member.OrganizationID, member.ID)
+	if err != nil {
+		return err
+	}
+
+	if existingMember.Role == domain.OrganizationOwnerRole {
+		return errors.New("the organization owner's role cannot be changed")
+	}
+
+	if existingMember.Role == domain.OrganizationAdminRole && member.Role != domain.OrganizationAdminRole {
+		err = s.checkRemainingAdmins(ctx, member.OrganizationID)
+		if err != nil {
+			return err
+		}
+	}
+
+	return s.organizationMemberRepo.Update(ctx, member)
+}
+
+func (s *OrganizationService) RemoveOrganizationMember(ctx context.Context, organizationID, memberID string, actor *domain.OrganizationMember) error {
+	member, err := s.getOrganizationMember(ctx, organizationID, memberID)
+	if err != nil {
+		return err
+	}
+
+	if member.Role == domain.OrganizationOwnerRole {
+		return errors.New("the organization owner cannot be removed")
+	}
+
+	if member.Role == domain.OrganizationAdminRole {
+		if actor.Role != domain.OrganizationOwnerRole {
+			return domain.ErrOrganizationAdminGrant
+		}
+
+		err = s.checkRemainingAdmins(ctx, organizationID)
+		if err != nil {
+			return err
+		}
+	}
+
+	err = s.organizationTeamRepo.RemoveUserFromOrganizationTeams(ctx, organizationID, member.UserID)
+	if err != nil {
+		return err
+	}
+
+	return s.organizationMemberRepo.DeleteByID(ctx, memberID)
+}
+
+func (s *OrganizationService) CreateTeam(ctx context.Context, team *domain.OrganizationTeam) error {
+	return s.organizationTeamRepo.Save(ctx, team)
+}
+
+func (s *OrganizationService) DeleteTeam(ctx context.Context, organizationID, teamID string) error {
+	if _, err := s.getOrganizationTeam(ctx, organizationID, teamID); err != nil {
+		return err
+	}
+
+	return s.organizationTeamRepo.DeleteByID(ctx, teamID)
+}
+
+func (s *OrganizationService) AddTeamMembers(ctx context.Context, organizationID, teamID string, userIDs []string) ([]string, error) {
+	if _, err := s.getOrganizationTeam(ctx, organizationID, teamID); err != nil {
+		return nil, err
+	}
+
+	members, err := s.organizationMemberRepo.GetMembersByOrganizationID(ctx, organizationID)
+	if err != nil {
+		return nil, err
+	}
+
+	memberUserIDs := make(map[string]struct{}, len(members))
+	for _, member := range members {
+		memberUserIDs[member.UserID] = struct{}{}
+	}
+
+	addedUserIDs := make([]string, 0)
+	for _, userID := range userIDs {
+		if _, ok := memberUserIDs[userID]; ok {
+			addedUserIDs = append(addedUserIDs, userID)
+		}
+	}
+
+	err = s.organizationTeamRepo.AddMembers(ctx, teamID, addedUserIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	return addedUserIDs, nil
+}
+
+func (s *OrganizationService) RemoveTeamMember(ctx context.Context, organizationID, teamID, userID string) error {
+	if _, err := s.getOrganizationTeam(ctx, organizationID, teamID); err != nil {
+		return err
+	}
+
+	return s.organizationTeamRepo.RemoveMember(ctx, teamID, userID)
+}
+
+func (s *OrganizationService) GrantTeamProjects(ctx context.Context, organizationID, teamID string, projectIDs []string, role domain.AccessRole) ([]*domain.OrganizationTeamProject, error) {
+	if _, err := s.getOrganizationTeam(ctx, organizationID, teamID); err != nil {
+		return nil, err
+	}
+
+	projects, err := s.projectRepo.GetByIDs(ctx, projectIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	grants := make([]*domain.OrganizationTeamProject, 0, len(projects))
+	for _, project := range projects {
+		if project.OrganizationID == nil || *project.OrganizationID != organizationID {
+			continue
+		}
+
+		grants = append(grants, &domain.OrganizationTeamProject{
+			TeamID:    teamID,
+			ProjectID: project.ID,
+			Role:      role,
+		})
+	}
+
+	err = s.organizationTeamRepo.SaveProjectGrants(ctx, grants)
+	if err != nil {
+		return nil, err
+	}
+
+	return grants, nil
+}
+
+func (s *OrganizationService) RevokeTeamProject(ctx context.Context, organizationID, teamID, projectID string) error {
+	if _, err := s.getOrganizationTeam(ctx, organizationID, teamID); err != nil {
+		return err
+	}
+
+	return s.organizationTeamRepo.DeleteProjectGrant(ctx, teamID, projectID)
+}
+
+func (s *OrganizationService) checkRemainingAdmins(ctx context.Context, organizationID string) error {
+	members, err := s.organizationMemberRepo.GetMembersByOrganizationID(ctx, organizationID)
+	if err != nil {
+		return err
+	}
+
+	admins := 0
+	for _, member := range members {
+		if member.Role.AtLeast(domain.OrganizationAdminRole) {
+			admins++
+		}
+	}
+
+	if admins <= 1 {
+		return domain.ErrLastOrganizationAdmin
+	}
+
+	return nil
+}
+
+func (s *OrganizationService) getOrganizationMember(ctx context.Context, organizationID, memberID string) (*domain.OrganizationMember, error) {
+	member, err := s.organizationMemberRepo.GetByID(ctx, memberID)
+	if err != nil || member.OrganizationID != organizationID {
+		return nil, errors.New("organization member not found")
+	}
+
+	return member, nil
+}
+
+func (s *OrganizationService) getOrganizationTeam(ctx context.Context, organizationID, teamID string) (*domain.OrganizationTeam, error) {
+	team, err := s.organizationTeamRepo.GetByID(ctx, teamID)
+	if err != nil || team.OrganizationID != organizationID {
+		return nil, errors.New("organization team not found")
+	}
+
+	return team, nil
+}
@@ -0,0 +1,155 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/fatihsen-dev/kanban-backend/internal/core/domain"
+	ports "github.com/fatihsen-dev/kanban-backend/internal/core/ports/driven"
+	"go.uber.org/zap"
+)
+
+type OutboxService struct {
+	transactor ports.Transactor
+	outboxRepo ports.OutboxRepository
+}
+
+func NewOutboxService(transactor ports.Transactor, outboxRepo ports.OutboxRepository) *OutboxService {
+	return &OutboxService{transactor: transactor, outboxRepo: outboxRepo}
+}
+
+func (s *OutboxService) WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	return s.transactor.WithinTransaction(ctx, fn)
+}
+
+func (s *OutboxService) Publish(ctx context.Context, event domain.Event) error {
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+
+	outboxEvent := &domain.OutboxEvent{
+		ProjectID: event.EventProjectID(),
+		Name:      string(event.EventName()),
+		Payload:   payload,
+	}
+	if recipientEvent, ok := event.(domain.RecipientEvent); ok {
+		recipientID := recipientEvent.EventRecipientID()
+		outboxEvent.RecipientID = &recipientID
+	}
+
+	return s.outboxRepo.Save(ctx, outboxEvent)
+}
+
+type OutboxDispatcher struct {
+	transactor  ports.Transactor
+	outboxRepo  ports.OutboxRepository
+	subscribers []ports.EventSubscriber
+	listeners   []ports.EventSubscriber
+}
+
+type dispatchedEvent struct {
+	outboxEvent *domain.OutboxEvent
+	event       domain.Event
+}
+
+func NewOutboxDispatcher(transactor ports.Transactor, outboxRepo ports.OutboxRepository, subscribers []ports.EventSubscriber, listeners ...ports.EventSubscriber) *OutboxDispatcher {
+	return &OutboxDispatcher{transactor: transactor, outboxRepo: outboxRepo, subscribers: subscribers, listeners: listeners}
+}
+
+func (d *OutboxDispatcher) DispatchPending(ctx context.Context) (int, error) {
+	events, err := d.outboxRepo.ClaimPending(ctx, domain.OutboxBatchSize, domain.OutboxLease)
+	if err != nil {
+		return 0, err
+	}
+
+	dispatched := []dispatchedEvent{}
+	blocked := map[string]bool{}
+	released := []int64{}
+	for _, event := range events {
+		if blocked[event.ProjectID] {
+			released = append(released, event.ID)
+			continue
+		}
+
+		domainEvent, err := d.dispatch(ctx, event)
+		if err != nil {
+			zap.L().Warn("Failed to publish outbox event", zap.Int64("event_id", event.ID), zap.String("event", event.Name), zap.Error(err))
+			blocked[event.ProjectID] = true
+
+			event.RecordFailure(err, time.Now().UTC())
+			err = d.outboxRepo.Update(ctx, event)
+			if err != nil {
+				d.notifyListeners(ctx, dispatched)
+				return len(dispatched), err
+			}
+			continue
+		}
+
+		dispatched = append(dispatched, dispatchedEvent{outboxEvent: event, event: domainEvent})
+	}
+
+	d.notifyListeners(ctx, dispatched)
+
+	if len(released) > 0 {
+		err = d.outboxRepo.Release(ctx, released)
+		if err != nil {
+			return len(dispatched), err
+		}
+	}
+
+	return len(dispatched), nil
+}
+
+func (d *OutboxDispatcher) PurgePublished(ctx context.Context) (int64, error) {
+	return d.outboxRepo.DeletePublishedBefore(ctx, time.Now().UTC().Add(-domain.OutboxRetention))
+}
+
+func (d *OutboxDispatcher) dispatch(ctx context.Context, event *domain.OutboxEvent) (domain.Event, error) {
+	domainEvent, err := domain.DecodeEvent(event.Name, event.Payload)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx = domain.ContextWithOutboxEvent(ctx, event)
+	err = d.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		for _, subscriber := range d.subscribers {
+			err := subscriber.HandleEvent(ctx, domainEvent)
+			if err != nil {
+				return err
+			}
+		}
+
+		event.MarkPublished(time.Now().UTC())
+		return d.outboxRepo.Update(ctx, event)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return domainEvent, nil
+}
+
+func (d *OutboxDispatcher) notifyListeners(ctx context.Context, events []dispatchedEvent) {
+	if len(events) == 0 {
+		return
+	}
+
+	var wg sync.WaitGroup
+	for _, listener := range d.listeners {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for _, dispatched := range events {
+				err := listener.HandleEvent(domain.ContextWithOutboxEvent(ctx, dispatched.outboxEvent), dispatched.event)
+				if err != nil {
+					zap.L().Warn("Failed to notify outbox listener", zap.Int64("event_id", dispatched.outboxEvent.ID), zap.String("event", dispatched.outboxEvent.Name), zap.String("listener", fmt.Sprintf("%T", listener)), zap.Error(err))
+				}
+			}
+		}()
+	}
+	wg.Wait()
+}
@@ -0,0 +1,147 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/fatihsen-dev/kanban-backend/internal/core/domain"
+	ports "github.com/fatihsen-dev/kanban-backend/internal/core/ports/driven"
+)
+
+type memoryOutboxRepo struct {
+	events []*domain.OutboxEvent
+}
+
+func (r *memoryOutboxRepo) Save(ctx context.Context, event *domain.OutboxEvent) error {
+	event.ID = int64(len(r.events) + 1)
+	event.Status = domain.OutboxEventPending
+	event.CreatedAt = time.Now().UTC()
+	r.events = append(r.events, event)
+	return nil
+}
+
+func (r *memoryOutboxRepo) ClaimPending(ctx context.Context, limit int, lease time.Duration) ([]*domain.OutboxEvent, error) {
+	claimed := []*domain.OutboxEvent{}
+	for _, event := range r.events {
+		if event.Status == domain.OutboxEventPending && len(claimed) < limit {
+			claimed = append(claimed, event)
+		}
+	}
+	return claimed, nil
+}
+
+func (r *memoryOutboxRepo) Release(ctx context.Context, ids []int64) error {
+	return nil
+}
+
+func (r *memoryOutboxRepo) Update(ctx context.Context, event *domain.OutboxEvent) error {
+	return nil
+}
+
+func (r *memoryOutboxRepo) DeletePublishedBefore(ctx context.Context, before time.Time) (int64, error) {
+	return 0, nil
+}
+
+type immediateTransactor struct{}
+
+func (t immediateTransactor) WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
+type recordingSubscriber struct {
+	mu     sync.Mutex
+	err    error
+	events []domain.EventName
+}
+
+func (s *recordingSubscriber) HandleEvent(ctx context.Context, event domain.Event) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.events = append(s.events, event.EventName())
+	return s.err
+}
+
+func (s *recordingSubscriber) received() []domain.EventName {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return append([]domain.EventName{}, s.events...)
+}
+
+func publishTaskEvents(t *testing.T, outboxRepo *memoryOutboxRepo, names ...domain.EventName) {
+	t.Helper()
+
+	outboxService := NewOutboxService(immediateTransactor{}, outboxRepo)
+	for _, name := range names {
+		err := outboxService.Publish(context.Background(), &domain.TaskChangedEvent{
+			Name: name,
+			Task: &domain.Task{ID: "task-1", ProjectID: "project-1"},
+		})
+		if err != nil {
+			t.Fatalf("Publish returned error: %v", err)
+		}
+	}
+}
+
+func TestOutboxListenerFailureDoesNotBlockOthers(t *testing.T) {
+	outboxRepo := &memoryOutboxRepo{}
+	publishTaskEvents(t, outboxRepo, domain.EventTaskCreated, domain.EventTaskUpdated)
+
+	subscriber := &recordingSubscriber{}
+	failingListener := &recordingSubscriber{err: errors.New("hub is busy")}
+	listener := &recordingSubscriber{}
+	dispatcher := NewOutboxDispatcher(immediateTransactor{}, outboxRepo, []ports.EventSubscriber{subscriber}, failingListener, listener)
+
+	published, err := dispatcher.DispatchPending(context.Background())
+	if err != nil {
+		t.Fatalf("DispatchPending returned error: %v", err)
+	}
+	if published != 2 {
+		t.Fatalf("expected 2 published events, got %d", published)
+	}
+
+	for _, event := range outboxRepo.events {
+		if event.Status != domain.OutboxEventPublished {
+			t.Errorf("expected event %d to be published, got %s", event.ID, event.Status)
+		}
+	}
+
+	for _, received := range [][]domain.EventName{subscriber.received(), failingListener.received(), listener.received()} {
+		if len(received) != 2 || received[0] != domain.EventTaskCreated || received[1] != domain.EventTaskUpdated {
+			t.Errorf("expected both events in order, got %v", received)
+		}
+	}
+}
+
+func TestOutboxSubscriberFailureRetriesEventWithoutNotifyingListeners(t *testing.T) {
+	outboxRepo := &memoryOutboxRepo{}
+	publishTaskEvents(t, outboxRepo, domain.EventTaskCreated, domain.EventTaskUpdated)
+
+	subscriber := &recordingSubscriber{err: errors.New("database is down")}
+	listener := &recordingSubscriber{}
+	dispatcher := NewOutboxDispatcher(immediateTransactor{}, outboxRepo, []ports.EventSubscriber{subscriber}, listener)
+
+	published, err := dispatcher.DispatchPending(context.Background())
+	if err != nil {
+		t.Fatalf("DispatchPending returned error: %v", err)
+	}
+	if published != 0 {
+		t.Fatalf("expected no published events, got %d", published)
+	}
+
+	first := outboxRepo.events[0]
+	if first.Status != domain.OutboxEventPending || first.Attempts != 1 {
+		t.Errorf("expected the failed event to stay pending after one attempt, got %s with %d attempts", first.Status, first.Attempts)
+	}
+	if second := outboxRepo.events[1]; second.Attempts != 0 {
+		t.Errorf("expected the later event of the same project to wait, got %d attempts", second.Attempts)
+	}
+
+	if received := listener.received(); len(received) != 0 {
+		t.Errorf("expected listeners to skip unpublished events, got %v", received)
+	}
+}
@@ -0,0 +1,125 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/fatihsen-dev/kanban-backend/internal/core/domain"
+	ports "github.com/fatihsen-dev/kanban-backend/internal/core/ports/driven"
+)
+
+type ProjectAccessService struct {
+	projectRepo            ports.ProjectRepository
+	projectMemberRepo      ports.ProjectMemberRepository
+	teamRepo               ports.TeamRepository
+	projectRoleRepo        ports.ProjectRoleRepository
+	organizationMemberRepo ports.OrganizationMemberRepository
+	organizationTeamRepo   ports.OrganizationTeamRepository
+}
+
+func NewProjectAccessService(projectRepo ports.ProjectRepository, projectMemberRepo ports.ProjectMemberRepository, teamRepo ports.TeamRepository, projectRoleRepo ports.ProjectRoleRepository, organizationMemberRepo ports.OrganizationMemberRepository, organizationTeamRepo ports.OrganizationTeamRepository) *ProjectAccessService {
+	return &ProjectAccessService{
+		projectRepo:            projectRepo,
+		projectMemberRepo:      projectMemberRepo,
+		teamRepo:               teamRepo,
+		projectRoleRepo:        projectRoleRepo,
+		organizationMemberRepo: organizationMemberRepo,
+		organizationTeamRepo:   organizationTeamRepo,
+	}
+}
+
+func (s *ProjectAccessService) GetProjectAccess(ctx context.Context, userID, projectID string) (*domain.ProjectAccess, error) {
+	access := &domain.ProjectAccess{
+		ProjectID: projectID,
+		UserID:    userID,
+	}
+
+	projectMember, err := s.projectMemberRepo.GetByUserIDAndProjectID(ctx, userID, projectID)
+	if err == nil {
+		access.Member = projectMember
+		access.IsOwner = projectMember.Role == domain.AccessOwnerRole
+
+		permissions, err := s.rolePermissions(ctx, projectMember.Role, projectMember.RoleID)
+		if err != nil {
+			return nil, err
+		}
+		access.Grant(permissions)
+
+		if projectMember.TeamID != nil {
+			team, err := s.teamRepo.GetByID(ctx, *projectMember.TeamID)
+			if err != nil {
+				return nil, err
+			}
+
+			permissions, err := s.rolePermissions(ctx, team.Role, team.RoleID)
+			if err != nil {
+				return nil, err
+			}
+			access.Grant(permissions)
+		}
+	}
+
+	err = s.grantOrganizationAccess(ctx, access)
+	if err != nil {
+		return nil, err
+	}
+
+	if access.Member == nil && len(access.Permissions) == 0 {
+		return nil, errors.New("you are not a member of this project")
+	}
+
+	return access, nil
+}
+
+func (s *ProjectAccessService) grantOrganizationAccess(ctx context.Context, access *domain.ProjectAccess) error {
+	project, err := s.projectRepo.GetByID(ctx, access.ProjectID)
+	if err != nil {
+		return err
+	}
+
+	if project.OrganizationID == nil {
+		return nil
+	}
+
+	organizationMember, err := s.organizationMemberRepo.GetByUserIDAndOrganizationID(ctx, access.UserID, *project.OrganizationID)
+	if errors.Is(err, domain.ErrNotOrganizationMember) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	switch organizationMember.Role {
+	case domain.OrganizationOwnerRole:
+		access.IsOwner = true
+		access.Grant(domain.AccessOwnerRole.Permissions())
+	case domain.OrganizationAdminRole:
+		access.Grant(domain.AccessAdminRole.Permissions())
+	}
+
+	grants, err := s.organizationTeamRepo.GetUserProjectGrants(ctx, access.UserID)
+	if err != nil {
+		return err
+	}
+
+	for _, grant := range grants {
+		if grant.ProjectID == access.ProjectID {
+			access.Grant(grant.Role.Permissions())
+		}
+	}
+
+	return nil
+}
+
+func (s *ProjectAccessService) rolePermissions(ctx context.Context, accessRole domain.AccessRole, roleID *string) ([]domain.Permission, error) {
+	if accessRole == domain.AccessOwnerRole || roleID == nil {
+		return accessRole.Permissions(), nil
+	}
+
+	role, err := s.projectRoleRepo.GetByID(ctx, *roleID)
+	if err != nil {
+		return nil, err
+	}
+
+	return role.Permissions, nil
+}
@@ -2,6 +2,7 @@ package service
 
 import (
 	"context"
+	"errors"
 
 	"github.com/fatihsen-dev/kanban-backend/internal/core/domain"
 	ports "github.com/fatihsen-dev/kanban-backend/internal/core/ports/driven"
@@ -10,18 +11,44 @@ import (
 type ProjectMemberService struct {
 	projectMemberRepo ports.ProjectMemberRepository
 	userRepo          ports.UserRepository
+	projectRoleRepo   ports.ProjectRoleRepository
+	activityService   *ActivityService
+	eventPublisher    ports.EventPublisher
 }
 
-func NewProjectMemberService(projectMemberRepo ports.ProjectMemberRepository, userRepo ports.UserRepository) *ProjectMemberService {
-	return &ProjectMemberService{projectMemberRepo: projectMemberRepo, userRepo: userRepo}
+func NewProjectMemberService(projectMemberRepo ports.ProjectMemberRepository, userRepo ports.UserRepository, projectRoleRepo ports.ProjectRoleRepository, activityService *ActivityService, eventPublisher ports.EventPublisher) *ProjectMemberService {
+	return &ProjectMemberService{projectMemberRepo: projectMemberRepo, userRepo: userRepo, projectRoleRepo: projectRoleRepo, activityService: activityService, eventPublisher: eventPublisher}
 }
 
 func (s *ProjectMemberService) CreateProjectMember(ctx context.Context, projectMember *domain.ProjectMember) error {
-	return s.projectMemberRepo.Save(ctx, projectMember)
+	err := s.projectMemberRepo.Save(ctx, projectMember)
+	if err != nil {
+		return err
+	}
+
+	s.activityService.Record(ctx, projectMember.ProjectID, domain.EntityProjectMember, projectMember.ID, domain.ActivityActionCreated, nil, projectMember.Snapshot())
+
+	user, err := s.userRepo.GetByID(ctx, projectMember.UserID)
+	if err != nil {
+		return err
+	}
+
+	return s.eventPublisher.Publish(ctx, &domain.ProjectMemberChangedEvent{Name: domain.EventProjectMemberCreated, Member: projectMember, User: user})
 }
 
 func (s *ProjectMemberService) DeleteProjectMemberByID(ctx context.Context, id string) error {
-	return s.projectMemberRepo.DeleteByID(ctx, id)
+	projectMember, err := s.projectMemberRepo.GetByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	err = s.projectMemberRepo.DeleteByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	s.activityService.Record(ctx, projectMember.ProjectID, domain.EntityProjectMember, projectMember.ID, domain.ActivityActionDeleted, projectMember.Snapshot(), nil)
+	return s.eventPublisher.Publish(ctx, &domain.EntityRemovedEvent{Name: domain.EventProjectMemberDeleted, ProjectID: projectMember.ProjectID, ID: projectMember.ID})
 }
 
 func (s *ProjectMemberService) GetProjectMembersByProjectID(ctx context.Context, projectID string, query *string) ([]*domain.ProjectMember, []*domain.User, error) {
@@ -53,6 +80,76 @@ func (s *ProjectMemberService) GetByUserIDAndProjectID(ctx context.Context, user
 	return s.projectMemberRepo.GetByUserIDAndProjectID(ctx, userID, projectID)
 }
 
-func (s *ProjectMemberService) UpdateProjectMember(ctx context.Context, projectMember *domain.ProjectMember) error {
-	return s.projectMemberRepo.UpdateProjectMember(ctx, projectMember)
+func (s *ProjectMemberService) UpdateProjectMember(ctx context.Context, projectMember *domain.ProjectMember, grantor *domain.ProjectAccess) error {
+	currentMember, err := s.getProjectMember(ctx, projectMember.ProjectID, projectMember.ID)
+	if err != nil {
+		return err
+	}
+
+	if currentMember.Role == domain.AccessOwnerRole {
+		return domain.ErrProjectOwnerMember
+	}
+
+	if err := checkGrantedRole(ctx, s.projectRoleRepo, projectMember.ProjectID, projectMember.Role, projectMember.RoleID, grantor); err != nil {
+		return err
+	}
+
+	err = s.projectMemberRepo.UpdateProjectMember(ctx, projectMember)
+	if err != nil {
+		return err
+	}
+
+	updatedMember, err := s.projectMemberRepo.GetByID(ctx, projectMember.ID)
+	if err != nil {
+		return err
+	}
+
+	s.activityService.Record(ctx, updatedMember.ProjectID, domain.EntityProjectMember, updatedMember.ID, domain.ActivityActionUpdated, currentMember.Snapshot(), updatedMember.Snapshot())
+	return s.eventPublisher.Publish(ctx, &domain.ProjectMemberChangedEvent{Name: domain.EventProjectMemberUpdated, Member: updatedMember})
+}
+
+func (s *ProjectMemberService) getProjectMember(ctx context.Context, projectID, id string) (*domain.ProjectMember, error) {
+	projectMember, err := s.projectMemberRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if projectMember.ProjectID != projectID {
+		return nil, domain.ErrProjectMemberNotFound
+	}
+
+	return projectMember, nil
+}
+
+func checkGrantedRole(ctx context.Context, projectRoleRepo ports.ProjectRoleRepository, projectID string, accessRole domain.AccessRole, roleID *string, grantor *domain.ProjectAccess) error {
+	if accessRole == domain.AccessAdminRole && !grantor.IsOwner {
+		return domain.ErrRoleExceedsPermissions
+	}
+
+	if !grantor.CanGrant(accessRole.Permissions()) {
+		return domain.ErrRoleExceedsPermissions
+	}
+
+	return checkProjectRole(ctx, projectRoleRepo, projectID, roleID, grantor)
+}
+
+func checkProjectRole(ctx context.Context, projectRoleRepo ports.ProjectRoleRepository, projectID string, roleID *string, grantor *domain.ProjectAccess) error {
+	if roleID == nil || *roleID == "" {
+		return nil
+	}
+
+	role, err := projectRoleRepo.GetByID(ctx, *roleID)
+	if err != nil {
+		return errors.New("role not found")
+	}
+
+	if role.ProjectID != projectID {
+		return errors.New("role does not belong to this project")
+	}
+
+	if !grantor.CanGrant(role.Permissions) {
+		return domain.ErrRoleExceedsPermissions
+	}
+
+	return nil
 }
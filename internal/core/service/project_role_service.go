@@ -0,0 +1,62 @@
+package service
+
+import (
+	"context"
+
+	"github.com/fatihsen-dev/kanban-backend/internal/core/domain"
+	ports "github.com/fatihsen-dev/kanban-backend/internal/core/ports/driven"
+)
+
+type ProjectRoleService struct {
+	projectRoleRepo ports.ProjectRoleRepository
+	eventPublisher  ports.EventPublisher
+}
+
+func NewProjectRoleService(projectRoleRepo ports.ProjectRoleRepository, eventPublisher ports.EventPublisher) *ProjectRoleService {
+	return &ProjectRoleService{projectRoleRepo: projectRoleRepo, eventPublisher: eventPublisher}
+}
+
+func (s *ProjectRoleService) CreateProjectRole(ctx context.Context, role *domain.ProjectRole) error {
+	err := s.projectRoleRepo.Save(ctx, role)
+	if err != nil {
+		return err
+	}
+
+	return s.eventPublisher.Publish(ctx, &domain.ProjectRoleChangedEvent{Name: domain.EventRoleCreated, Role: role})
+}
+
+func (s *ProjectRoleService) GetProjectRoleByID(ctx context.Context, id string) (*domain.ProjectRole, error) {
+	return s.projectRoleRepo.GetByID(ctx, id)
+}
+
+func (s *ProjectRoleService) GetProjectRolesByProjectID(ctx context.Context, projectID string) ([]*domain.ProjectRole, error) {
+	return s.projectRoleRepo.GetRolesByProjectID(ctx, projectID)
+}
+
+func (s *ProjectRoleService) UpdateProjectRole(ctx context.Context, role *domain.ProjectRole) error {
+	err := s.projectRoleRepo.Update(ctx, role)
+	if err != nil {
+		return err
+	}
+
+	updatedRole, err := s.projectRoleRepo.GetByID(ctx, role.ID)
+	if err != nil {
+		return err
+	}
+
+	return s.eventPublisher.Publish(ctx, &domain.ProjectRoleChangedEvent{Name: domain.EventRoleUpdated, Role: updatedRole})
+}
+
+func (s *ProjectRoleService) DeleteProjectRoleByID(ctx context.Context, id string) error {
+	role, err := s.projectRoleRepo.GetByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	err = s.projectRoleRepo.DeleteByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	return s.eventPublisher.Publish(ctx, &domain.EntityRemovedEvent{Name: domain.EventRoleDeleted, ProjectID: role.ProjectID, ID: role.ID})
+}
@@ -2,38 +2,67 @@ package service
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/fatihsen-dev/kanban-backend/internal/core/domain"
 	ports "github.com/fatihsen-dev/kanban-backend/internal/core/ports/driven"
 )
 
 type ProjectService struct {
-	projectRepo       ports.ProjectRepository
-	projectMemberRepo ports.ProjectMemberRepository
-	teamRepo          ports.TeamRepository
-	columnRepo        ports.ColumnRepository
-	taskRepo          ports.TaskRepository
-	userRepo          ports.UserRepository
+	projectRepo            ports.ProjectRepository
+	projectMemberRepo      ports.ProjectMemberRepository
+	teamRepo               ports.TeamRepository
+	columnRepo             ports.ColumnRepository
+	taskRepo               ports.TaskRepository
+	userRepo               ports.UserRepository
+	projectRoleRepo        ports.ProjectRoleRepository
+	organizationMemberRepo ports.OrganizationMemberRepository
+	organizationTeamRepo   ports.OrganizationTeamRepository
+	laneRepo               ports.LaneRepository
+	columnTransitionRepo   ports.ColumnTransitionRepository
+	activityService        *ActivityService
+	eventPublisher         ports.EventPublisher
 }
 
-func NewProjectService(projectRepo ports.ProjectRepository, columnRepo ports.ColumnRepository, taskRepo ports.TaskRepository, teamRepo ports.TeamRepository, projectMemberRepo ports.ProjectMemberRepository, userRepo ports.UserRepository) *ProjectService {
+func NewProjectService(projectRepo ports.ProjectRepository, columnRepo ports.ColumnRepository, taskRepo ports.TaskRepository, teamRepo ports.TeamRepository, projectMemberRepo ports.ProjectMemberRepository, userRepo ports.UserRepository, projectRoleRepo ports.ProjectRoleRepository, organizationMemberRepo ports.OrganizationMemberRepository, organizationTeamRepo ports.OrganizationTeamRepository, laneRepo ports.LaneRepository, columnTransitionRepo ports.ColumnTransitionRepository, activityService *ActivityService, eventPublisher ports.EventPublisher) *ProjectService {
 	return &ProjectService{
-		projectRepo:       projectRepo,
-		columnRepo:        columnRepo,
-		taskRepo:          taskRepo,
-		teamRepo:          teamRepo,
-		projectMemberRepo: projectMemberRepo,
-		userRepo:          userRepo,
+		projectRepo:            projectRepo,
+		columnRepo:             columnRepo,
+		taskRepo:               taskRepo,
+		teamRepo:               teamRepo,
+		projectMemberRepo:      projectMemberRepo,
+		userRepo:               userRepo,
+		projectRoleRepo:        projectRoleRepo,
+		organizationMemberRepo: organizationMemberRepo,
+		organizationTeamRepo:   organizationTeamRepo,
+		laneRepo:               laneRepo,
+		columnTransitionRepo:   columnTransitionRepo,
+		activityService:        activityService,
+		eventPublisher:         eventPublisher,
 	}
 }
 
 func (s *ProjectService) CreateProject(ctx context.Context, project *domain.Project) error {
-	err := s.projectRepo.Save(ctx, project)
+	if project.OrganizationID != nil {
+		organizationMember, err := s.organizationMemberRepo.GetByUserIDAndOrganizationID(ctx, project.OwnerID, *project.OrganizationID)
+		if err != nil || !organizationMember.Role.AtLeast(domain.OrganizationAdminRole) {
+			return domain.ErrOrganizationAccessDenied
+		}
+	}
+
+	err := s.assignProjectKey(ctx, project)
+	if err != nil {
+		return err
+	}
+
+	err = s.projectRepo.Save(ctx, project)
 
 	if err != nil {
 		return err
 	}
 
+	s.activityService.Record(ctx, project.ID, domain.EntityProject, project.ID, domain.ActivityActionCreated, nil, project.Snapshot())
+
 	projectMember := &domain.ProjectMember{
 		ProjectID: project.ID,
 		UserID:    project.OwnerID,
@@ -84,28 +113,83 @@ func (s *ProjectService) GetUserProjects(ctx context.Context, userID string) ([]
 		return nil, err
 	}
 
-	projectIDs := make([]string, len(projectMembers))
-	for i, projectMember := range projectMembers {
-		projectIDs[i] = projectMember.ProjectID
+	projectIDs := make(map[string]struct{})
+	for _, projectMember := range projectMembers {
+		projectIDs[projectMember.ProjectID] = struct{}{}
+	}
+
+	organizationMembers, err := s.organizationMemberRepo.GetByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, organizationMember := range organizationMembers {
+		if !organizationMember.Role.AtLeast(domain.OrganizationAdminRole) {
+			continue
+		}
+
+		projects, err := s.projectRepo.GetByOrganizationID(ctx, organizationMember.OrganizationID)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, project := range projects {
+			projectIDs[project.ID] = struct{}{}
+		}
+	}
+
+	grants, err := s.organizationTeamRepo.GetUserProjectGrants(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, grant := range grants {
+		projectIDs[grant.ProjectID] = struct{}{}
 	}
 
-	return s.projectRepo.GetByIDs(ctx, projectIDs)
+	projectIDList := make([]string, 0, len(projectIDs))
+	for id := range projectIDs {
+		projectIDList = append(projectIDList, id)
+	}
+
+	return s.projectRepo.GetByIDs(ctx, projectIDList)
+}
+
+func (s *ProjectService) GetProjectWithDetails(ctx context.Context, projectID string) (*domain.ProjectDetails, error) {
+	return s.getProjectDetails(ctx, projectID, "", func(columnIDs []string) ([]*domain.Task, error) {
+		return s.taskRepo.GetTasksByColumnIDs(ctx, columnIDs)
+	})
+}
+
+func (s *ProjectService) GetProjectWithTasks(ctx context.Context, projectID string, tasks []*domain.Task, grouping domain.LaneGrouping) (*domain.ProjectDetails, error) {
+	return s.getProjectDetails(ctx, projectID, grouping, func(columnIDs []string) ([]*domain.Task, error) {
+		return tasks, nil
+	})
 }
 
-func (s *ProjectService) GetProjectWithDetails(ctx context.Context, projectID string) (*domain.Project, []*domain.Column, map[string][]*domain.Task, []*domain.Team, []*domain.ProjectMember, []*domain.User, error) {
+func (s *ProjectService) getProjectDetails(ctx context.Context, projectID string, grouping domain.LaneGrouping, loadTasks func(columnIDs []string) ([]*domain.Task, error)) (*domain.ProjectDetails, error) {
 	project, err := s.projectRepo.GetByID(ctx, projectID)
 	if err != nil {
-		return nil, nil, nil, nil, nil, nil, err
+		return nil, err
+	}
+
+	if grouping != "" {
+		project.LaneGrouping = grouping
 	}
 
 	teams, err := s.teamRepo.GetTeamsByProjectID(ctx, projectID)
 	if err != nil {
-		return nil, nil, nil, nil, nil, nil, err
+		return nil, err
+	}
+
+	roles, err := s.projectRoleRepo.GetRolesByProjectID(ctx, projectID)
+	if err != nil {
+		return nil, err
 	}
 
 	projectMembers, err := s.projectMemberRepo.GetProjectMembersByProjectID(ctx, projectID, nil)
 	if err != nil {
-		return nil, nil, nil, nil, nil, nil, err
+		return nil, err
 	}
 
 	userIDs := make([]string, len(projectMembers))
@@ -115,17 +199,12 @@ func (s *ProjectService) GetProjectWithDetails(ctx context.Context, projectID st
 
 	users, err := s.userRepo.GetByIDs(ctx, userIDs)
 	if err != nil {
-		return nil, nil, nil, nil, nil, nil, err
-	}
-
-	userMap := make(map[string]*domain.User)
-	for _, user := range users {
-		userMap[user.ID] = user
+		return nil, err
 	}
 
 	columns, err := s.columnRepo.GetColumnsByProjectID(ctx, projectID)
 	if err != nil {
-		return nil, nil, nil, nil, nil, nil, err
+		return nil, err
 	}
 
 	columnIDs := make([]string, len(columns))
@@ -133,19 +212,111 @@ func (s *ProjectService) GetProjectWithDetails(ctx context.Context, projectID st
 		columnIDs[i] = column.ID
 	}
 
-	tasks, err := s.taskRepo.GetTasksByColumnIDs(ctx, columnIDs)
+	tasks, err := loadTasks(columnIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	lanes, err := s.laneRepo.GetLanesByProjectID(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	transitions, err := s.columnTransitionRepo.GetTransitionsByProjectID(ctx, projectID)
 	if err != nil {
-		return nil, nil, nil, nil, nil, nil, err
+		return nil, err
 	}
 
 	tasksByColumn := make(map[string][]*domain.Task)
+	tasksByColumnAndLane := make(map[string]map[string][]*domain.Task)
 	for _, task := range tasks {
 		tasksByColumn[task.ColumnID] = append(tasksByColumn[task.ColumnID], task)
+
+		if tasksByColumnAndLane[task.ColumnID] == nil {
+			tasksByColumnAndLane[task.ColumnID] = make(map[string][]*domain.Task)
+		}
+		laneKey := task.LaneKey(project.LaneGrouping)
+		tasksByColumnAndLane[task.ColumnID][laneKey] = append(tasksByColumnAndLane[task.ColumnID][laneKey], task)
+	}
+
+	return &domain.ProjectDetails{
+		Project:              project,
+		Columns:              columns,
+		Lanes:                lanes,
+		TasksByColumn:        tasksByColumn,
+		TasksByColumnAndLane: tasksByColumnAndLane,
+		Teams:                teams,
+		Members:              projectMembers,
+		Users:                users,
+		Roles:                roles,
+		Transitions:          transitions,
+	}, nil
+}
+
+func (s *ProjectService) UpdateProject(ctx context.Context, project *domain.Project) error {
+	if project.Key != "" {
+		existing, err := s.projectRepo.GetByKey(ctx, project.Key)
+		if err == nil && existing.ID != project.ID {
+			return domain.ErrProjectKeyTaken
+		}
+	}
+
+	currentProject, err := s.projectRepo.GetByID(ctx, project.ID)
+	if err != nil {
+		return err
+	}
+
+	err = s.projectRepo.Update(ctx, project)
+	if err != nil {
+		return err
+	}
+
+	updatedProject, err := s.projectRepo.GetByID(ctx, project.ID)
+	if err != nil {
+		return err
+	}
+
+	s.activityService.Record(ctx, updatedProject.ID, domain.EntityProject, updatedProject.ID, domain.ActivityActionUpdated, currentProject.Snapshot(), updatedProject.Snapshot())
+	return s.eventPublisher.Publish(ctx, &domain.ProjectChangedEvent{Project: updatedProject})
+}
+
+func (s *ProjectService) assignProjectKey(ctx context.Context, project *domain.Project) error {
+	if project.Key != "" {
+		_, err := s.projectRepo.GetByKey(ctx, project.Key)
+		if err == nil {
+			return domain.ErrProjectKeyTaken
+		}
+		return nil
 	}
 
-	return project, columns, tasksByColumn, teams, projectMembers, users, nil
+	baseKey := domain.DeriveProjectKey(project.Name)
+	key := baseKey
+	for suffix := 2; ; suffix++ {
+		_, err := s.projectRepo.GetByKey(ctx, key)
+		if err != nil {
+			project.Key = key
+			return nil
+		}
+		key = fmt.Sprintf("%s%d", baseKey, suffix)
+	}
 }
 
 func (s *ProjectService) DeleteProject(ctx context.Context, id string) error {
-	return s.projectRepo.DeleteByID(ctx, id)
+	project, err := s.projectRepo.GetByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	err = s.eventPublisher.Publish(ctx, &domain.EntityRemovedEvent{Name: domain.EventProjectDeleted, ProjectID: project.ID, ID: project.ID})
+	if err != nil {
+		return err
+	}
+
+	err = s.projectRepo.DeleteByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	s.activityService.Record(ctx, project.ID, domain.EntityProject, project.ID, domain.ActivityActionDeleted, project.Snapshot(), nil)
+	return nil
 }
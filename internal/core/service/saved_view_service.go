@@ -0,0 +1,98 @@
+package service
+
+import (
+	"context"
+
+	"github.com/fatihsen-dev/kanban-backend/internal/core/domain"
+	ports "github.com/fatihsen-dev/kanban-backend/internal/core/ports/driven"
+)
+
+type SavedViewService struct {
+	savedViewRepo  ports.SavedViewRepository
+	taskService    *TaskService
+	projectService *ProjectService
+	eventPublisher ports.EventPublisher
+}
+
+func NewSavedViewService(savedViewRepo ports.SavedViewRepository, taskService *TaskService, projectService *ProjectService, eventPublisher ports.EventPublisher) *SavedViewService {
+	return &SavedViewService{savedViewRepo: savedViewRepo, taskService: taskService, projectService: projectService, eventPublisher: eventPublisher}
+}
+
+func (s *SavedViewService) CreateView(ctx context.Context, view *domain.SavedView) error {
+	err := s.savedViewRepo.Save(ctx, view)
+	if err != nil {
+		return err
+	}
+
+	if !view.IsShared() {
+		return nil
+	}
+
+	return s.eventPublisher.Publish(ctx, &domain.SavedViewChangedEvent{Name: domain.EventViewCreated, View: view})
+}
+
+func (s *SavedViewService) GetViewByID(ctx context.Context, id string) (*domain.SavedView, error) {
+	return s.savedViewRepo.GetByID(ctx, id)
+}
+
+func (s *SavedViewService) GetVisibleViews(ctx context.Context, projectID string, userID string) ([]*domain.SavedView, error) {
+	return s.savedViewRepo.GetVisibleViews(ctx, projectID, userID)
+}
+
+func (s *SavedViewService) UpdateView(ctx context.Context, view *domain.SavedView) error {
+	currentView, err := s.savedViewRepo.GetByID(ctx, view.ID)
+	if err != nil {
+		return err
+	}
+
+	err = s.savedViewRepo.Update(ctx, view)
+	if err != nil {
+		return err
+	}
+
+	if !view.IsShared() && !currentView.IsShared() {
+		return nil
+	}
+
+	return s.eventPublisher.Publish(ctx, &domain.SavedViewChangedEvent{Name: domain.EventViewUpdated, View: view})
+}
+
+func (s *SavedViewService) DeleteViewByID(ctx context.Context, id string) error {
+	view, err := s.savedViewRepo.GetByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	err = s.savedViewRepo.DeleteByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if !view.IsShared() {
+		return nil
+	}
+
+	return s.eventPublisher.Publish(ctx, &domain.EntityRemovedEvent{Name: domain.EventViewDeleted, ProjectID: view.ProjectID, ID: view.ID})
+}
+
+func (s *SavedViewService) ExecuteView(ctx context.Context, view *domain.SavedView, userID string) (*domain.ProjectDetails, error) {
+	filter := view.Filter.ForUser(userID)
+	filter.ProjectID = view.ProjectID
+	filter.Limit = domain.MaxTaskPageSize
+
+	var tasks []*domain.Task
+	for {
+		page, err := s.taskService.QueryTasks(ctx, filter)
+		if err != nil {
+			return nil, err
+		}
+
+		tasks = append(tasks, page.Tasks...)
+		if page.NextCursor == "" {
+			break
+		}
+		filter.Cursor = page.NextCursor
+	}
+
+	return s.projectService.GetProjectWithTasks(ctx, view.ProjectID, tasks, view.Grouping)
+}
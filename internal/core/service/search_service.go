@@ -0,0 +1,44 @@
+package service
+
+import (
+	"context"
+
+	"github.com/fatihsen-dev/kanban-backend/internal/core/domain"
+	ports "github.com/fatihsen-dev/kanban-backend/internal/core/ports/driven"
+)
+
+type SearchService struct {
+	searchEngine   ports.SearchEngine
+	projectService *ProjectService
+}
+
+func NewSearchService(searchEngine ports.SearchEngine, projectService *ProjectService) *SearchService {
+	return &SearchService{searchEngine: searchEngine, projectService: projectService}
+}
+
+func (s *SearchService) Search(ctx context.Context, userID string, query *domain.SearchQuery) ([]*domain.SearchResult, error) {
+	projects, err := s.projectService.GetUserProjects(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(projects) == 0 {
+		return []*domain.SearchResult{}, nil
+	}
+
+	query.ProjectIDs = make([]string, len(projects))
+	for i, project := range projects {
+		query.ProjectIDs[i] = project.ID
+	}
+
+	if len(query.Types) == 0 {
+		query.Types = []domain.SearchResultType{domain.SearchResultTypeTask, domain.SearchResultTypeProject}
+	}
+
+	if query.Limit <= 0 {
+		query.Limit = domain.DefaultSearchLimit
+	}
+	query.Limit = min(query.Limit, domain.MaxSearchLimit)
+
+	return s.searchEngine.Search(ctx, query)
+}
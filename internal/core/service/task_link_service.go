@@ -0,0 +1,125 @@
+package service
+
+import (
+	"context"
+
+	"github.com/fatihsen-dev/kanban-backend/internal/core/domain"
+	ports "github.com/fatihsen-dev/kanban-backend/internal/core/ports/driven"
+)
+
+type TaskLinkService struct {
+	taskLinkRepo         ports.TaskLinkRepository
+	taskRepo             ports.TaskRepository
+	projectAccessService *ProjectAccessService
+	eventPublisher       ports.EventPublisher
+}
+
+func NewTaskLinkService(taskLinkRepo ports.TaskLinkRepository, taskRepo ports.TaskRepository, projectAccessService *ProjectAccessService, eventPublisher ports.EventPublisher) *TaskLinkService {
+	return &TaskLinkService{taskLinkRepo: taskLinkRepo, taskRepo: taskRepo, projectAccessService: projectAccessService, eventPublisher: eventPublisher}
+}
+
+func (s *TaskLinkService) CreateLink(ctx context.Context, userID string, link *domain.TaskLink) ([]*domain.Task, error) {
+	if link.SourceTaskID == link.TargetTaskID {
+		return nil, domain.ErrInvalidTaskLink
+	}
+
+	tasks, err := s.getAccessibleTasks(ctx, userID, link)
+	if err != nil {
+		return nil, err
+	}
+
+	links, err := s.taskLinkRepo.GetLinksByTaskID(ctx, link.SourceTaskID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, existing := range links {
+		if existing.OtherTaskID(link.SourceTaskID) == link.TargetTaskID && existing.TypeFor(link.SourceTaskID) == link.Type {
+			return nil, domain.ErrInvalidTaskLink
+		}
+	}
+
+	if link.Type.IsAcyclic() {
+		cyclic, err := s.taskLinkRepo.HasPath(ctx, link.Type, link.TargetTaskID, link.SourceTaskID)
+		if err != nil {
+			return nil, err
+		}
+
+		if cyclic {
+			return nil, domain.ErrTaskLinkCycle
+		}
+	}
+
+	err = s.taskLinkRepo.Save(ctx, link)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.publishLinkChange(ctx, domain.EventTaskLinkCreated, link, tasks)
+	if err != nil {
+		return nil, err
+	}
+
+	return tasks, nil
+}
+
+func (s *TaskLinkService) GetLinkByID(ctx context.Context, id string) (*domain.TaskLink, error) {
+	return s.taskLinkRepo.GetByID(ctx, id)
+}
+
+func (s *TaskLinkService) GetLinksByTaskID(ctx context.Context, taskID string) ([]*domain.TaskLink, error) {
+	return s.taskLinkRepo.GetLinksByTaskID(ctx, taskID)
+}
+
+func (s *TaskLinkService) DeleteLink(ctx context.Context, userID string, link *domain.TaskLink) ([]*domain.Task, error) {
+	tasks, err := s.getAccessibleTasks(ctx, userID, link)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.taskLinkRepo.DeleteByID(ctx, link.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.publishLinkChange(ctx, domain.EventTaskLinkDeleted, link, tasks)
+	if err != nil {
+		return nil, err
+	}
+
+	return tasks, nil
+}
+
+func (s *TaskLinkService) publishLinkChange(ctx context.Context, name domain.EventName, link *domain.TaskLink, tasks []*domain.Task) error {
+	published := map[string]bool{}
+	for _, task := range tasks {
+		if published[task.ProjectID] {
+			continue
+		}
+		published[task.ProjectID] = true
+
+		err := s.eventPublisher.Publish(ctx, &domain.TaskLinkChangedEvent{Name: name, ProjectID: task.ProjectID, Link: link})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *TaskLinkService) getAccessibleTasks(ctx context.Context, userID string, link *domain.TaskLink) ([]*domain.Task, error) {
+	var tasks []*domain.Task
+	for _, taskID := range []string{link.SourceTaskID, link.TargetTaskID} {
+		task, err := s.taskRepo.GetByID(ctx, taskID)
+		if err != nil || task.DeletedAt != nil {
+			return nil, domain.ErrInvalidTaskLink
+		}
+
+		access, err := s.projectAccessService.GetProjectAccess(ctx, userID, task.ProjectID)
+		if err != nil || !access.Can(domain.PermissionProjectView) {
+			return nil, domain.ErrInvalidTaskLink
+		}
+
+		tasks = append(tasks, task)
+	}
+	return tasks, nil
+}
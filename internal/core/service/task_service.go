@@ -2,35 +2,628 @@ package service
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/fatihsen-dev/kanban-backend/internal/core/domain"
 	ports "github.com/fatihsen-dev/kanban-backend/internal/core/ports/driven"
+	"go.uber.org/zap"
 )
 
 type TaskService struct {
-	taskRepo ports.TaskRepository
+	taskRepo             ports.TaskRepository
+	columnRepo           ports.ColumnRepository
+	projectRepo          ports.ProjectRepository
+	laneRepo             ports.LaneRepository
+	projectMemberRepo    ports.ProjectMemberRepository
+	columnTransitionRepo ports.ColumnTransitionRepository
+	customFieldRepo      ports.CustomFieldRepository
+	taskRevisionRepo     ports.TaskRevisionRepository
+	taskWatcherRepo      ports.TaskWatcherRepository
+	automationEngine     *AutomationEngine
+	activityService      *ActivityService
+	notificationService  *NotificationService
+	eventPublisher       ports.EventPublisher
+	transactor           ports.Transactor
 }
 
-func NewTaskService(taskRepo ports.TaskRepository) *TaskService {
-	return &TaskService{taskRepo: taskRepo}
+func NewTaskService(taskRepo ports.TaskRepository, columnRepo ports.ColumnRepository, projectRepo ports.ProjectRepository, laneRepo ports.LaneRepository, projectMemberRepo ports.ProjectMemberRepository, columnTransitionRepo ports.ColumnTransitionRepository, customFieldRepo ports.CustomFieldRepository, taskRevisionRepo ports.TaskRevisionRepository, taskWatcherRepo ports.TaskWatcherRepository, automationEngine *AutomationEngine, activityService *ActivityService, notificationService *NotificationService, eventPublisher ports.EventPublisher, transactor ports.Transactor) *TaskService {
+	return &TaskService{taskRepo: taskRepo, columnRepo: columnRepo, projectRepo: projectRepo, laneRepo: laneRepo, projectMemberRepo: projectMemberRepo, columnTransitionRepo: columnTransitionRepo, customFieldRepo: customFieldRepo, taskRevisionRepo: taskRevisionRepo, taskWatcherRepo: taskWatcherRepo, automationEngine: automationEngine, activityService: activityService, notificationService: notificationService, eventPublisher: eventPublisher, transactor: transactor}
 }
 
-func (s *TaskService) CreateTask(ctx context.Context, task *domain.Task) error {
-	return s.taskRepo.Save(ctx, task)
+func (s *TaskService) CreateTask(ctx context.Context, task *domain.Task) (*domain.TaskResult, error) {
+	column, err := s.getActiveColumn(ctx, task.ColumnID)
+	if err != nil {
+		return nil, err
+	}
+
+	if column.ProjectID != task.ProjectID {
+		return nil, domain.ErrInvalidTargetColumn
+	}
+
+	err = s.checkTaskReferences(ctx, task.ProjectID, task)
+	if err != nil {
+		return nil, err
+	}
+
+	task.CustomFields, err = s.resolveCustomFields(ctx, task.ProjectID, nil, task.CustomFields, true)
+	if err != nil {
+		return nil, err
+	}
+
+	task.Mentions, err = s.resolveMentions(ctx, task.ProjectID, task.Content)
+	if err != nil {
+		return nil, err
+	}
+
+	if task.Priority == "" {
+		task.Priority = domain.TaskPriorityNone
+	}
+
+	var load *domain.ColumnLoad
+	err = s.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		load, err = s.checkWipLimit(ctx, column.ID)
+		if err != nil {
+			return err
+		}
+
+		return s.taskRepo.Save(ctx, task)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	watcherIDs := domain.MentionedUserIDs(task.Mentions)
+	if actorID := domain.ActorFromContext(ctx); actorID != nil {
+		watcherIDs = append(watcherIDs, *actorID)
+	}
+	if task.AssigneeID != nil {
+		watcherIDs = append(watcherIDs, *task.AssigneeID)
+	}
+	watchTask(ctx, s.transactor, s.taskWatcherRepo, task, watcherIDs)
+
+	s.activityService.Record(ctx, task.ProjectID, domain.EntityTask, task.ID, domain.ActivityActionCreated, nil, task.Snapshot())
+	saveTaskRevision(ctx, s.transactor, s.taskRevisionRepo, nil, task)
+	s.notificationService.notifyAssignee(ctx, nil, task)
+	s.notificationService.notifyMentioned(ctx, nil, task)
+
+	err = s.eventPublisher.Publish(ctx, &domain.TaskChangedEvent{Name: domain.EventTaskCreated, Task: task, Load: load})
+	if err != nil {
+		return nil, err
+	}
+
+	automations := s.automationEngine.Handle(ctx, &domain.TaskEvent{Trigger: domain.AutomationTriggerTaskCreated, Task: task})
+
+	return &domain.TaskResult{Load: load, Automations: automations}, nil
+}
+
+func (s *TaskService) GetTaskByID(ctx context.Context, projectID, id string) (*domain.Task, error) {
+	return s.getProjectTask(ctx, projectID, id)
+}
+
+func (s *TaskService) GetTaskByKey(ctx context.Context, key string) (*domain.Task, error) {
+	projectKey, number, ok := domain.ParseTaskKey(key)
+	if !ok {
+		return nil, domain.ErrInvalidTaskKey
+	}
+
+	return s.taskRepo.GetByProjectKeyAndNumber(ctx, projectKey, number)
+}
+
+func (s *TaskService) QueryTasks(ctx context.Context, filter *domain.TaskFilter) (*domain.TaskPage, error) {
+	if len(filter.CustomFields) > 0 {
+		filter.CustomFieldMatch = map[string]interface{}{}
+		for fieldID, raw := range filter.CustomFields {
+			field, err := s.customFieldRepo.GetByID(ctx, fieldID)
+			if err != nil || field.ProjectID != filter.ProjectID {
+				return nil, domain.ErrInvalidCustomFieldValue
+			}
+
+			value, err := field.MatchValue(raw)
+			if err != nil {
+				return nil, err
+			}
+			filter.CustomFieldMatch[field.ID] = value
+		}
+	}
+
+	return s.taskRepo.Query(ctx, filter)
+}
+
+func (s *TaskService) UpdateTask(ctx context.Context, task *domain.Task) (*domain.TaskResult, error) {
+	var load *domain.ColumnLoad
+	var movedFromColumnID string
+
+	currentTask, err := s.getProjectTask(ctx, task.ProjectID, task.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if task.ColumnID != "" || task.LaneID != nil || task.AssigneeID != nil || task.CustomFields != nil {
+		if task.ColumnID != "" {
+			column, err := s.getActiveColumn(ctx, task.ColumnID)
+			if err != nil {
+				return nil, err
+			}
+
+			if column.ProjectID != currentTask.ProjectID {
+				return nil, domain.ErrInvalidTargetColumn
+			}
+
+			if currentTask.ColumnID != column.ID {
+				transitions, err := s.columnTransitionRepo.GetTransitionsFromColumn(ctx, currentTask.ColumnID)
+				if err != nil {
+					return nil, err
+				}
+
+				err = domain.CheckTransition(transitions, currentTask.ColumnID, column.ID, currentTask.WithChanges(task))
+				if err != nil {
+					return nil, err
+				}
+
+				if column.IsDone() && currentTask.IsBlocked() {
+					err = s.checkBlockers(ctx, column.ProjectID)
+					if err != nil {
+						return nil, err
+					}
+				}
+
+				movedFromColumnID = currentTask.ColumnID
+			}
+		}
+
+		err = s.checkTaskReferences(ctx, currentTask.ProjectID, task)
+		if err != nil {
+			return nil, err
+		}
+
+		if task.CustomFields != nil {
+			task.CustomFields, err = s.resolveCustomFields(ctx, currentTask.ProjectID, currentTask.CustomFields, task.CustomFields, false)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if task.Content != nil {
+		task.Mentions, err = s.resolveMentions(ctx, currentTask.ProjectID, task.Content)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err = s.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if movedFromColumnID != "" {
+			load, err = s.checkWipLimit(ctx, task.ColumnID)
+			if err != nil {
+				return err
+			}
+		}
+
+		return s.taskRepo.Update(ctx, task)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	updatedTask, err := s.taskRepo.GetByID(ctx, task.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	watcherIDs := domain.NewlyMentionedUserIDs(currentTask, updatedTask)
+	if task.AssigneeID != nil && *task.AssigneeID != "" {
+		watcherIDs = append(watcherIDs, *task.AssigneeID)
+	}
+	watchTask(ctx, s.transactor, s.taskWatcherRepo, updatedTask, watcherIDs)
+	if len(updatedTask.Watchers) != len(currentTask.Watchers) {
+		task.Watchers = updatedTask.Watchers
+	}
+
+	action := domain.ActivityActionUpdated
+	if movedFromColumnID != "" {
+		action = domain.ActivityActionMoved
+	}
+	s.activityService.Record(ctx, updatedTask.ProjectID, domain.EntityTask, updatedTask.ID, action, currentTask.Snapshot(), updatedTask.Snapshot())
+	saveTaskRevision(ctx, s.transactor, s.taskRevisionRepo, currentTask, updatedTask)
+	s.notificationService.notifyAssignee(ctx, currentTask, updatedTask)
+	s.notificationService.notifyMentioned(ctx, currentTask, updatedTask)
+
+	name := domain.EventTaskUpdated
+	if movedFromColumnID != "" {
+		name = domain.EventTaskMoved
+	}
+	err = s.eventPublisher.Publish(ctx, &domain.TaskChangedEvent{Name: name, Task: updatedTask, Load: load})
+	if err != nil {
+		return nil, err
+	}
+
+	result := &domain.TaskResult{Load: load}
+	if movedFromColumnID != "" {
+		result.Automations = s.automationEngine.Handle(ctx, &domain.TaskEvent{Trigger: domain.AutomationTriggerTaskMoved, Task: updatedTask, PreviousColumnID: movedFromColumnID})
+	}
+
+	return result, nil
 }
 
-func (s *TaskService) GetTaskByID(ctx context.Context, id string) (*domain.Task, error) {
-	return s.taskRepo.GetByID(ctx, id)
+func (s *TaskService) DeleteTask(ctx context.Context, projectID, id string) error {
+	task, err := s.getProjectTask(ctx, projectID, id)
+	if err != nil {
+		return err
+	}
+
+	err = s.taskRepo.Delete(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	s.activityService.Record(ctx, task.ProjectID, domain.EntityTask, task.ID, domain.ActivityActionDeleted, task.Snapshot(), nil)
+	return s.eventPublisher.Publish(ctx, &domain.EntityRemovedEvent{Name: domain.EventTaskDeleted, ProjectID: task.ProjectID, ID: task.ID})
 }
 
-func (s *TaskService) GetTasks(ctx context.Context) ([]*domain.Task, error) {
-	return s.taskRepo.GetAll(ctx)
+func (s *TaskService) ArchiveTask(ctx context.Context, projectID, id string) error {
+	task, err := s.getProjectTask(ctx, projectID, id)
+	if err != nil {
+		return err
+	}
+
+	err = s.taskRepo.Archive(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	s.activityService.Record(ctx, task.ProjectID, domain.EntityTask, task.ID, domain.ActivityActionArchived, nil, nil)
+	return s.eventPublisher.Publish(ctx, &domain.EntityRemovedEvent{Name: domain.EventTaskArchived, ProjectID: task.ProjectID, ID: task.ID})
 }
 
-func (s *TaskService) UpdateTask(ctx context.Context, task *domain.Task) error {
-	return s.taskRepo.Update(ctx, task)
+func (s *TaskService) RestoreTask(ctx context.Context, projectID, id string) (*domain.Task, error) {
+	task, err := s.getProjectTask(ctx, projectID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	var load *domain.ColumnLoad
+	err = s.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		load, err = s.checkWipLimit(ctx, task.ColumnID)
+		if err != nil {
+			return err
+		}
+
+		return s.taskRepo.Restore(ctx, id)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.activityService.Record(ctx, task.ProjectID, domain.EntityTask, task.ID, domain.ActivityActionRestored, nil, nil)
+
+	restoredTask, err := s.taskRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.eventPublisher.Publish(ctx, &domain.TaskChangedEvent{Name: domain.EventTaskRestored, Task: restoredTask, Load: load})
+	if err != nil {
+		return nil, err
+	}
+
+	return restoredTask, nil
+}
+
+func (s *TaskService) getProjectTask(ctx context.Context, projectID, id string) (*domain.Task, error) {
+	task, err := s.taskRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if task.ProjectID != projectID {
+		return nil, domain.ErrTaskNotFound
+	}
+
+	return task, nil
+}
+
+func (s *TaskService) GetTasksByState(ctx context.Context, projectID string, state domain.ArchiveState) ([]*domain.Task, error) {
+	return s.taskRepo.GetByProjectIDAndState(ctx, projectID, state)
+}
+
+func (s *TaskService) GetTaskRevisions(ctx context.Context, taskID string) ([]*domain.TaskRevision, error) {
+	return s.taskRevisionRepo.GetByTaskID(ctx, taskID)
+}
+
+func (s *TaskService) GetTaskRevision(ctx context.Context, taskID string, number int) (*domain.TaskRevision, error) {
+	return s.taskRevisionRepo.GetByNumber(ctx, taskID, number)
+}
+
+func (s *TaskService) CompareTaskRevisions(ctx context.Context, taskID string, from, to int) (map[string]domain.FieldChange, error) {
+	fromRevision, err := s.taskRevisionRepo.GetByNumber(ctx, taskID, from)
+	if err != nil {
+		return nil, err
+	}
+
+	toRevision, err := s.taskRevisionRepo.GetByNumber(ctx, taskID, to)
+	if err != nil {
+		return nil, err
+	}
+
+	return toRevision.Diff(fromRevision), nil
 }
 
-func (s *TaskService) DeleteTask(ctx context.Context, id string) error {
-	return s.taskRepo.Delete(ctx, id)
+func (s *TaskService) GetRevertChanges(ctx context.Context, taskID string, number int) (*domain.Task, error) {
+	revision, err := s.taskRevisionRepo.GetByNumber(ctx, taskID, number)
+	if err != nil {
+		return nil, err
+	}
+
+	currentTask, err := s.taskRepo.GetByID(ctx, taskID)
+	if err != nil {
+		return nil, err
+	}
+
+	changes := revision.RevertChanges(currentTask)
+
+	if changes.CustomFields != nil {
+		fields, err := s.customFieldRepo.GetFieldsByProjectID(ctx, currentTask.ProjectID)
+		if err != nil {
+			return nil, err
+		}
+
+		existing := make(map[string]bool, len(fields))
+		for _, field := range fields {
+			existing[field.ID] = true
+		}
+
+		for fieldID := range changes.CustomFields {
+			if !existing[fieldID] {
+				delete(changes.CustomFields, fieldID)
+			}
+		}
+
+		if len(changes.CustomFields) == 0 {
+			changes.CustomFields = nil
+		}
+	}
+
+	if !changes.HasChanges() {
+		return nil, domain.ErrTaskRevisionUnchanged
+	}
+
+	return changes, nil
+}
+
+func (s *TaskService) WatchTask(ctx context.Context, taskID, userID string) (*domain.Task, error) {
+	err := s.taskWatcherRepo.Add(ctx, taskID, []string{userID})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.publishWatchers(ctx, taskID)
+}
+
+func (s *TaskService) UnwatchTask(ctx context.Context, taskID, userID string) (*domain.Task, error) {
+	err := s.taskWatcherRepo.Remove(ctx, taskID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.publishWatchers(ctx, taskID)
+}
+
+func (s *TaskService) publishWatchers(ctx context.Context, taskID string) (*domain.Task, error) {
+	task, err := s.taskRepo.GetByID(ctx, taskID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.eventPublisher.Publish(ctx, &domain.TaskWatchersChangedEvent{Task: task})
+	if err != nil {
+		return nil, err
+	}
+
+	return task, nil
+}
+
+func (s *TaskService) getActiveColumn(ctx context.Context, columnID string) (*domain.Column, error) {
+	column, err := s.columnRepo.GetByID(ctx, columnID)
+	if err != nil {
+		return nil, err
+	}
+
+	if column.ArchivedAt != nil || column.DeletedAt != nil {
+		return nil, domain.ErrColumnNotActive
+	}
+
+	return column, nil
+}
+
+func (s *TaskService) checkTaskReferences(ctx context.Context, projectID string, task *domain.Task) error {
+	if task.LaneID != nil && *task.LaneID != "" {
+		lane, err := s.laneRepo.GetByID(ctx, *task.LaneID)
+		if err != nil || lane.ProjectID != projectID {
+			return domain.ErrInvalidLane
+		}
+	}
+
+	if task.AssigneeID != nil && *task.AssigneeID != "" {
+		_, err := s.projectMemberRepo.GetByUserIDAndProjectID(ctx, *task.AssigneeID, projectID)
+		if err != nil {
+			return domain.ErrInvalidAssignee
+		}
+	}
+
+	return nil
+}
+
+func (s *TaskService) resolveMentions(ctx context.Context, projectID string, content *string) ([]domain.Mention, error) {
+	if content == nil || len(domain.ParseMentions(*content)) == 0 {
+		return []domain.Mention{}, nil
+	}
+
+	members, err := s.projectMemberRepo.GetUsersByProjectID(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	return domain.ResolveMentions(content, members), nil
+}
+
+func (s *TaskService) resolveCustomFields(ctx context.Context, projectID string, current map[string]interface{}, changes map[string]interface{}, creating bool) (map[string]interface{}, error) {
+	fields, err := s.customFieldRepo.GetFieldsByProjectID(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	fieldsByID := make(map[string]*domain.CustomField, len(fields))
+	for _, field := range fields {
+		fieldsByID[field.ID] = field
+	}
+
+	resolved := make(map[string]interface{}, len(current)+len(changes))
+	for fieldID, value := range current {
+		resolved[fieldID] = value
+	}
+
+	for fieldID, value := range changes {
+		field, ok := fieldsByID[fieldID]
+		if !ok {
+			return nil, domain.ErrInvalidCustomFieldValue
+		}
+
+		if value == nil {
+			if field.Required {
+				return nil, fmt.Errorf("%w: %s", domain.ErrInvalidCustomFieldValue, field.Name)
+			}
+			delete(resolved, fieldID)
+			continue
+		}
+
+		normalized, err := field.NormalizeValue(value)
+		if err != nil {
+			return nil, err
+		}
+
+		if field.Type == domain.CustomFieldTypeUser {
+			_, err := s.projectMemberRepo.GetByUserIDAndProjectID(ctx, normalized.(string), projectID)
+			if err != nil {
+				return nil, fmt.Errorf("%w: %s", domain.ErrInvalidCustomFieldValue, field.Name)
+			}
+		}
+
+		resolved[fieldID] = normalized
+	}
+
+	if creating {
+		for _, field := range fields {
+			if _, ok := resolved[field.ID]; field.Required && !ok {
+				return nil, fmt.Errorf("%w: %s", domain.ErrInvalidCustomFieldValue, field.Name)
+			}
+		}
+	}
+
+	return resolved, nil
+}
+
+func (s *TaskService) checkWipLimit(ctx context.Context, columnID string) (*domain.ColumnLoad, error) {
+	column, err := s.columnRepo.GetByIDForUpdate(ctx, columnID)
+	if err != nil {
+		return nil, err
+	}
+
+	if column.ArchivedAt != nil || column.DeletedAt != nil {
+		return nil, domain.ErrColumnNotActive
+	}
+
+	load := column.Load()
+	load.TaskCount++
+
+	err = enforceWipLimit(ctx, s.projectRepo, column.ProjectID, load)
+	if err != nil {
+		return nil, err
+	}
+
+	return load, nil
+}
+
+func enforceWipLimit(ctx context.Context, projectRepo ports.ProjectRepository, projectID string, load *domain.ColumnLoad) error {
+	if !load.OverLimit() {
+		return nil
+	}
+
+	project, err := projectRepo.GetByID(ctx, projectID)
+	if err != nil {
+		return err
+	}
+
+	if project.WipEnforcement == domain.WipEnforcementHard {
+		return domain.ErrWipLimitExceeded
+	}
+
+	return nil
+}
+
+func (s *TaskService) checkBlockers(ctx context.Context, projectID string) error {
+	project, err := s.projectRepo.GetByID(ctx, projectID)
+	if err != nil {
+		return err
+	}
+
+	if project.BlockerEnforcement == domain.BlockerEnforcementHard {
+		return domain.ErrTaskBlocked
+	}
+
+	return nil
+}
+
+func saveTaskRevision(ctx context.Context, transactor ports.Transactor, taskRevisionRepo ports.TaskRevisionRepository, before, after *domain.Task) {
+	if before != nil {
+		if len(domain.DiffSnapshots(before.Snapshot(), after.Snapshot())) == 0 {
+			return
+		}
+
+		err := transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+			exists, err := taskRevisionRepo.Exists(ctx, before.ID)
+			if err != nil || exists {
+				return err
+			}
+			return taskRevisionRepo.Save(ctx, domain.NewTaskRevision(before, nil))
+		})
+		if err != nil {
+			zap.L().Error("Failed to save task revision", zap.String("task_id", before.ID), zap.Error(err))
+		}
+	}
+
+	err := transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		return taskRevisionRepo.Save(ctx, domain.NewTaskRevision(after, domain.ActorFromContext(ctx)))
+	})
+	if err != nil {
+		zap.L().Error("Failed to save task revision", zap.String("task_id", after.ID), zap.Error(err))
+	}
+}
+
+func watchTask(ctx context.Context, transactor ports.Transactor, taskWatcherRepo ports.TaskWatcherRepository, task *domain.Task, userIDs []string) {
+	watching := map[string]bool{}
+	for _, userID := range task.Watchers {
+		watching[userID] = true
+	}
+
+	newWatchers := []string{}
+	for _, userID := range userIDs {
+		if !watching[userID] {
+			watching[userID] = true
+			newWatchers = append(newWatchers, userID)
+		}
+	}
+
+	if len(newWatchers) == 0 {
+		return
+	}
+
+	err := transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		return taskWatcherRepo.Add(ctx, task.ID, newWatchers)
+	})
+	if err != nil {
+		zap.L().Error("Failed to add task watchers", zap.String("task_id", task.ID), zap.Error(err))
+		return
+	}
+
+	task.Watchers = append(task.Watchers, newWatchers...)
 }
@@ -10,44 +10,118 @@ import (
 type TeamService struct {
 	teamRepo          ports.TeamRepository
 	projectMemberRepo ports.ProjectMemberRepository
+	projectRoleRepo   ports.ProjectRoleRepository
+	activityService   *ActivityService
+	eventPublisher    ports.EventPublisher
 }
 
-func NewTeamService(teamRepo ports.TeamRepository, projectMemberRepo ports.ProjectMemberRepository) *TeamService {
-	return &TeamService{teamRepo: teamRepo, projectMemberRepo: projectMemberRepo}
+func NewTeamService(teamRepo ports.TeamRepository, projectMemberRepo ports.ProjectMemberRepository, projectRoleRepo ports.ProjectRoleRepository, activityService *ActivityService, eventPublisher ports.EventPublisher) *TeamService {
+	return &TeamService{teamRepo: teamRepo, projectMemberRepo: projectMemberRepo, projectRoleRepo: projectRoleRepo, activityService: activityService, eventPublisher: eventPublisher}
 }
 
-func (s *TeamService) CreateTeam(ctx context.Context, team *domain.Team) error {
-	return s.teamRepo.Save(ctx, team)
+func (s *TeamService) CreateTeam(ctx context.Context, team *domain.Team, grantor *domain.ProjectAccess) error {
+	if err := checkGrantedRole(ctx, s.projectRoleRepo, team.ProjectID, team.Role, team.RoleID, grantor); err != nil {
+		return err
+	}
+
+	err := s.teamRepo.Save(ctx, team)
+	if err != nil {
+		return err
+	}
+
+	s.activityService.Record(ctx, team.ProjectID, domain.EntityTeam, team.ID, domain.ActivityActionCreated, nil, team.Snapshot())
+	return s.eventPublisher.Publish(ctx, &domain.TeamChangedEvent{Name: domain.EventTeamCreated, Team: team})
 }
 
-func (s *TeamService) UpdateTeam(ctx context.Context, team *domain.Team) error {
-	return s.teamRepo.Update(ctx, team)
+func (s *TeamService) UpdateTeam(ctx context.Context, team *domain.Team, grantor *domain.ProjectAccess) error {
+	currentTeam, err := s.GetTeamByID(ctx, team.ProjectID, team.ID)
+	if err != nil {
+		return err
+	}
+
+	if err := checkGrantedRole(ctx, s.projectRoleRepo, team.ProjectID, team.Role, team.RoleID, grantor); err != nil {
+		return err
+	}
+
+	err = s.teamRepo.Update(ctx, team)
+	if err != nil {
+		return err
+	}
+
+	updatedTeam, err := s.teamRepo.GetByID(ctx, team.ID)
+	if err != nil {
+		return err
+	}
+
+	s.activityService.Record(ctx, updatedTeam.ProjectID, domain.EntityTeam, updatedTeam.ID, domain.ActivityActionUpdated, currentTeam.Snapshot(), updatedTeam.Snapshot())
+	return s.eventPublisher.Publish(ctx, &domain.TeamChangedEvent{Name: domain.EventTeamUpdated, Team: updatedTeam})
 }
 
 func (s *TeamService) GetTeamsByProjectID(ctx context.Context, projectID string) ([]*domain.Team, error) {
 	return s.teamRepo.GetTeamsByProjectID(ctx, projectID)
 }
 
-func (s *TeamService) DeleteTeamByID(ctx context.Context, id string) error {
-	return s.teamRepo.DeleteByID(ctx, id)
+func (s *TeamService) DeleteTeamByID(ctx context.Context, projectID, id string) error {
+	team, err := s.GetTeamByID(ctx, projectID, id)
+	if err != nil {
+		return err
+	}
+
+	err = s.teamRepo.DeleteByID(ctx, projectID, id)
+	if err != nil {
+		return err
+	}
+
+	s.activityService.Record(ctx, team.ProjectID, domain.EntityTeam, team.ID, domain.ActivityActionDeleted, team.Snapshot(), nil)
+	return s.eventPublisher.Publish(ctx, &domain.EntityRemovedEvent{Name: domain.EventTeamDeleted, ProjectID: team.ProjectID, ID: team.ID})
 }
 
-func (s *TeamService) GetTeamByID(ctx context.Context, id string) (*domain.Team, error) {
-	return s.teamRepo.GetByID(ctx, id)
+func (s *TeamService) GetTeamByID(ctx context.Context, projectID, id string) (*domain.Team, error) {
+	team, err := s.teamRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if team.ProjectID != projectID {
+		return nil, domain.ErrTeamNotFound
+	}
+
+	return team, nil
 }
 
-func (s *TeamService) AddTeamMembers(ctx context.Context, teamID string, memberIDs []string) ([]string, error) {
+func (s *TeamService) AddTeamMembers(ctx context.Context, projectID, teamID string, memberIDs []string) ([]string, error) {
+	team, err := s.GetTeamByID(ctx, projectID, teamID)
+	if err != nil {
+		return nil, err
+	}
+
 	updatedMemberIDs := make([]string, 0)
 
 	for _, memberID := range memberIDs {
-		err := s.projectMemberRepo.UpdateProjectMember(ctx, &domain.ProjectMember{
-			ID:     memberID,
-			TeamID: &teamID,
+		currentMember, err := s.projectMemberRepo.GetByID(ctx, memberID)
+		if err != nil || currentMember.ProjectID != team.ProjectID {
+			continue
+		}
+
+		err = s.projectMemberRepo.UpdateProjectMember(ctx, &domain.ProjectMember{
+			ID:        memberID,
+			ProjectID: team.ProjectID,
+			TeamID:    &teamID,
 		})
 
 		if err == nil {
 			updatedMemberIDs = append(updatedMemberIDs, memberID)
+
+			updatedMember := *currentMember
+			updatedMember.TeamID = &teamID
+			s.activityService.Record(ctx, currentMember.ProjectID, domain.EntityProjectMember, memberID, domain.ActivityActionUpdated, currentMember.Snapshot(), updatedMember.Snapshot())
 		}
 	}
+
+	err = s.eventPublisher.Publish(ctx, &domain.TeamMembersAddedEvent{Team: team, MemberIDs: updatedMemberIDs})
+	if err != nil {
+		return nil, err
+	}
+
 	return updatedMemberIDs, nil
 }
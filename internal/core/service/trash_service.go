@@ -0,0 +1,32 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	ports "github.com/fatihsen-dev/kanban-backend/internal/core/ports/driven"
+)
+
+type TrashService struct {
+	taskRepo   ports.TaskRepository
+	columnRepo ports.ColumnRepository
+	retention  time.Duration
+}
+
+func NewTrashService(taskRepo ports.TaskRepository, columnRepo ports.ColumnRepository, retention time.Duration) *TrashService {
+	return &TrashService{taskRepo: taskRepo, columnRepo: columnRepo, retention: retention}
+}
+
+func (s *TrashService) PurgeExpired(ctx context.Context) error {
+	_, err := s.taskRepo.PurgeDeleted(ctx, s.retention)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.columnRepo.PurgeDeleted(ctx, s.retention)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
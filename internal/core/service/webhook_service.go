@@ -0,0 +1,214 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/fatihsen-dev/kanban-backend/internal/core/domain"
+	ports "github.com/fatihsen-dev/kanban-backend/internal/core/ports/driven"
+	"go.uber.org/zap"
+)
+
+type webhookEnvelope struct {
+	ID        int64       `json:"id"`
+	Event     string      `json:"event"`
+	ProjectID string      `json:"project_id"`
+	WebhookID string      `json:"webhook_id"`
+	CreatedAt string      `json:"created_at"`
+	Data      interface{} `json:"data"`
+}
+
+type WebhookService struct {
+	webhookRepo         ports.WebhookRepository
+	webhookDeliveryRepo ports.WebhookDeliveryRepository
+	webhookSender       ports.WebhookSender
+	eventEncoder        ports.EventEncoder
+}
+
+func NewWebhookService(webhookRepo ports.WebhookRepository, webhookDeliveryRepo ports.WebhookDeliveryRepository, webhookSender ports.WebhookSender, eventEncoder ports.EventEncoder) *WebhookService {
+	return &WebhookService{webhookRepo: webhookRepo, webhookDeliveryRepo: webhookDeliveryRepo, webhookSender: webhookSender, eventEncoder: eventEncoder}
+}
+
+func (s *WebhookService) CreateWebhook(ctx context.Context, webhook *domain.Webhook) error {
+	if !webhook.IsValid() {
+		return domain.ErrInvalidWebhook
+	}
+
+	if webhook.Secret == "" {
+		secret := make([]byte, 32)
+		if _, err := rand.Read(secret); err != nil {
+			return err
+		}
+		webhook.Secret = hex.EncodeToString(secret)
+	}
+
+	return s.webhookRepo.Save(ctx, webhook)
+}
+
+func (s *WebhookService) GetWebhookByID(ctx context.Context, id string) (*domain.Webhook, error) {
+	return s.webhookRepo.GetByID(ctx, id)
+}
+
+func (s *WebhookService) GetWebhooksByProjectID(ctx context.Context, projectID string) ([]*domain.Webhook, error) {
+	return s.webhookRepo.GetByProjectID(ctx, projectID)
+}
+
+func (s *WebhookService) UpdateWebhook(ctx context.Context, webhook *domain.Webhook) error {
+	if !webhook.IsValid() {
+		return domain.ErrInvalidWebhook
+	}
+
+	return s.webhookRepo.Update(ctx, webhook)
+}
+
+func (s *WebhookService) DeleteWebhookByID(ctx context.Context, id string) error {
+	return s.webhookRepo.DeleteByID(ctx, id)
+}
+
+func (s *WebhookService) GetDeliveries(ctx context.Context, filter *domain.WebhookDeliveryFilter) (*domain.WebhookDeliveryPage, error) {
+	return s.webhookDeliveryRepo.Query(ctx, filter)
+}
+
+func (s *WebhookService) GetDeliveryByID(ctx context.Context, id string) (*domain.WebhookDelivery, error) {
+	return s.webhookDeliveryRepo.GetByID(ctx, id)
+}
+
+func (s *WebhookService) Redeliver(ctx context.Context, delivery *domain.WebhookDelivery) (*domain.WebhookDelivery, error) {
+	webhook, err := s.webhookRepo.GetByID(ctx, delivery.WebhookID)
+	if err != nil {
+		return nil, err
+	}
+
+	leasedUntil := time.Now().UTC().Add(domain.WebhookDeliveryLease)
+	redelivery := &domain.WebhookDelivery{
+		WebhookID:     delivery.WebhookID,
+		ProjectID:     delivery.ProjectID,
+		Event:         delivery.Event,
+		Payload:       delivery.Payload,
+		Status:        domain.WebhookDeliveryPending,
+		NextAttemptAt: &leasedUntil,
+	}
+
+	err = s.webhookDeliveryRepo.Save(ctx, redelivery)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.attempt(ctx, webhook, redelivery)
+	if err != nil {
+		return nil, err
+	}
+
+	return redelivery, nil
+}
+
+func (s *WebhookService) HandleEvent(ctx context.Context, event domain.Event) error {
+	projectID := event.EventProjectID()
+	if projectID == "" {
+		return nil
+	}
+
+	name := string(event.EventName())
+	webhooks, err := s.webhookRepo.GetActiveByProjectIDAndEvent(ctx, projectID, name)
+	if err != nil {
+		return err
+	}
+	if len(webhooks) == 0 {
+		return nil
+	}
+
+	data, err := s.eventEncoder.Encode(event)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now().UTC()
+	envelope := webhookEnvelope{
+		Event:     name,
+		ProjectID: projectID,
+		CreatedAt: now.Format(time.RFC3339),
+		Data:      json.RawMessage(data),
+	}
+	if outboxEvent := domain.OutboxEventFromContext(ctx); outboxEvent != nil {
+		envelope.ID = outboxEvent.ID
+		envelope.CreatedAt = outboxEvent.CreatedAt.UTC().Format(time.RFC3339)
+	}
+
+	for _, webhook := range webhooks {
+		envelope.WebhookID = webhook.ID
+		payload, err := json.Marshal(envelope)
+		if err != nil {
+			return err
+		}
+
+		err = s.webhookDeliveryRepo.Save(ctx, &domain.WebhookDelivery{
+			WebhookID:     webhook.ID,
+			ProjectID:     projectID,
+			Event:         name,
+			Payload:       payload,
+			Status:        domain.WebhookDeliveryPending,
+			NextAttemptAt: &now,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s *WebhookService) DeliverDue(ctx context.Context) (int, error) {
+	deliveries, err := s.webhookDeliveryRepo.ClaimDue(ctx, domain.WebhookDeliveryBatchSize, domain.WebhookDeliveryLease)
+	if err != nil {
+		return 0, err
+	}
+
+	delivered := 0
+	for _, delivery := range deliveries {
+		webhook, err := s.webhookRepo.GetByID(ctx, delivery.WebhookID)
+		if err != nil {
+			zap.L().Error("Failed to get webhook", zap.String("webhook_id", delivery.WebhookID), zap.Error(err))
+			continue
+		}
+
+		if !webhook.Active {
+			delivery.Cancel("webhook is inactive")
+			err = s.webhookDeliveryRepo.Update(ctx, delivery)
+		} else {
+			err = s.attempt(ctx, webhook, delivery)
+		}
+		if err != nil {
+			zap.L().Error("Failed to update webhook delivery", zap.String("delivery_id", delivery.ID), zap.Error(err))
+			continue
+		}
+
+		if delivery.Status == domain.WebhookDeliverySucceeded {
+			delivered++
+		}
+	}
+
+	return delivered, nil
+}
+
+func (s *WebhookService) attempt(ctx context.Context, webhook *domain.Webhook, delivery *domain.WebhookDelivery) error {
+	headers := map[string]string{
+		"Content-Type":                "application/json",
+		"User-Agent":                  "Kanban-Webhook",
+		domain.WebhookEventHeader:     delivery.Event,
+		domain.WebhookDeliveryHeader:  delivery.ID,
+		domain.WebhookSignatureHeader: domain.SignWebhookPayload(webhook.Secret, delivery.Payload),
+	}
+
+	response, err := s.webhookSender.Send(ctx, webhook.URL, headers, delivery.Payload)
+	if err == nil && (response.StatusCode < 200 || response.StatusCode >= 300) {
+		err = fmt.Errorf("endpoint responded with status %d", response.StatusCode)
+	}
+
+	delivery.RecordAttempt(response, err, time.Now().UTC())
+
+	return s.webhookDeliveryRepo.Update(ctx, delivery)
+}
@@ -0,0 +1,442 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/fatihsen-dev/kanban-backend/internal/core/domain"
+)
+
+type memoryWebhookRepo struct {
+	webhooks map[string]*domain.Webhook
+}
+
+func (r *memoryWebhookRepo) Save(ctx context.Context, webhook *domain.Webhook) error {
+	webhook.ID = fmt.Sprintf("webhook-%d", len(r.webhooks)+1)
+	r.webhooks[webhook.ID] = webhook
+	return nil
+}
+
+func (r *memoryWebhookRepo) GetByID(ctx context.Context, id string) (*domain.Webhook, error) {
+	webhook, ok := r.webhooks[id]
+	if !ok {
+		return nil, fmt.Errorf("webhook %s not found", id)
+	}
+	return webhook, nil
+}
+
+func (r *memoryWebhookRepo) GetByProjectID(ctx context.Context, projectID string) ([]*domain.Webhook, error) {
+	var webhooks []*domain.Webhook
+	for _, webhook := range r.webhooks {
+		if webhook.ProjectID == projectID {
+			webhooks = append(webhooks, webhook)
+		}
+	}
+	return webhooks, nil
+}
+
+func (r *memoryWebhookRepo) GetActiveByProjectIDAndEvent(ctx context.Context, projectID, event string) ([]*domain.Webhook, error) {
+	var webhooks []*domain.Webhook
+	for _, webhook := range r.webhooks {
+		if webhook.ProjectID != projectID || !webhook.Active {
+			continue
+		}
+		for _, name := range webhook.Events {
+			if name == event {
+				webhooks = append(webhooks, webhook)
+				break
+			}
+		}
+	}
+	return webhooks, nil
+}
+
+func (r *memoryWebhookRepo) Update(ctx context.Context, webhook *domain.Webhook) error {
+	r.webhooks[webhook.ID] = webhook
+	return nil
+}
+
+func (r *memoryWebhookRepo) DeleteByID(ctx context.Context, id string) error {
+	delete(r.webhooks, id)
+	return nil
+}
+
+type memoryWebhookDeliveryRepo struct {
+	deliveries []*domain.WebhookDelivery
+}
+
+func (r *memoryWebhookDeliveryRepo) Save(ctx context.Context, delivery *domain.WebhookDelivery) error {
+	delivery.ID = fmt.Sprintf("delivery-%d", len(r.deliveries)+1)
+	delivery.CreatedAt = time.Now().UTC()
+	r.deliveries = append(r.deliveries, delivery)
+	return nil
+}
+
+func (r *memoryWebhookDeliveryRepo) GetByID(ctx context.Context, id string) (*domain.WebhookDelivery, error) {
+	for _, delivery := range r.deliveries {
+		if delivery.ID == id {
+			return delivery, nil
+		}
+	}
+	return nil, fmt.Errorf("delivery %s not found", id)
+}
+
+func (r *memoryWebhookDeliveryRepo) Query(ctx context.Context, filter *domain.WebhookDeliveryFilter) (*domain.WebhookDeliveryPage, error) {
+	return &domain.WebhookDeliveryPage{Deliveries: r.deliveries}, nil
+}
+
+func (r *memoryWebhookDeliveryRepo) ClaimDue(ctx context.Context, limit int, lease time.Duration) ([]*domain.WebhookDelivery, error) {
+	now := time.Now().UTC()
+	var due []*domain.WebhookDelivery
+	for _, delivery := range r.deliveries {
+		if delivery.Status == domain.WebhookDeliveryPending && !delivery.NextAttemptAt.After(now) && len(due) < limit {
+			leasedUntil := now.Add(lease)
+			delivery.NextAttemptAt = &leasedUntil
+			due = append(due, delivery)
+		}
+	}
+	return due, nil
+}
+
+func (r *memoryWebhookDeliveryRepo) Update(ctx context.Context, delivery *domain.WebhookDelivery) error {
+	return nil
+}
+
+type clientWebhookSender struct {
+	client *http.Client
+}
+
+func (s *clientWebhookSender) Send(ctx context.Context, url string, headers map[string]string, payload []byte) (*domain.WebhookResponse, error) {
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(payload))
+	if err != nil {
+		return nil, err
+	}
+	for key, value := range headers {
+		request.Header.Set(key, value)
+	}
+
+	response, err := s.client.Do(request)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	body, err := io.ReadAll(response.Body)
+	if err != nil {
+		return nil, err
+	}
+	return &domain.WebhookResponse{StatusCode: response.StatusCode, Body: string(body)}, nil
+}
+
+type receivedWebhook struct {
+	signature string
+	event     string
+	delivery  string
+	body      []byte
+}
+
+type webhookEndpoint struct {
+	mu       sync.Mutex
+	statuses []int
+	delay    time.Duration
+	received []receivedWebhook
+}
+
+func (e *webhookEndpoint) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	body, _ := io.ReadAll(r.Body)
+
+	e.mu.Lock()
+	e.received = append(e.received, receivedWebhook{
+		signature: r.Header.Get(domain.WebhookSignatureHeader),
+		event:     r.Header.Get(domain.WebhookEventHeader),
+		delivery:  r.Header.Get(domain.WebhookDeliveryHeader),
+		body:      body,
+	})
+	status := http.StatusOK
+	if len(e.statuses) > 0 {
+		status = e.statuses[0]
+		e.statuses = e.statuses[1:]
+	}
+	e.mu.Unlock()
+
+	time.Sleep(e.delay)
+	w.WriteHeader(status)
+	fmt.Fprintf(w, "status %d", status)
+}
+
+func (e *webhookEndpoint) requests() []receivedWebhook {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return append([]receivedWebhook(nil), e.received...)
+}
+
+type jsonEventEncoder struct{}
+
+func (e jsonEventEncoder) Encode(event domain.Event) ([]byte, error) {
+	return json.Marshal(event)
+}
+
+func newTestWebhookService(t *testing.T, endpoint *webhookEndpoint, timeout time.Duration) (*WebhookService, *memoryWebhookDeliveryRepo, *domain.Webhook) {
+	t.Helper()
+
+	server := httptest.NewServer(endpoint)
+	t.Cleanup(server.Close)
+
+	webhookRepo := &memoryWebhookRepo{webhooks: make(map[string]*domain.Webhook)}
+	deliveryRepo := &memoryWebhookDeliveryRepo{}
+	webhookService := NewWebhookService(webhookRepo, deliveryRepo, &clientWebhookSender{client: &http.Client{Timeout: timeout}}, jsonEventEncoder{})
+
+	webhook := &domain.Webhook{
+		ProjectID: "project-1",
+		URL:       server.URL,
+		Secret:    "0123456789abcdef",
+		Events:    []string{string(domain.EventTaskCreated)},
+		Active:    true,
+	}
+	err := webhookService.CreateWebhook(context.Background(), webhook)
+	if err != nil {
+		t.Fatalf("CreateWebhook returned error: %v", err)
+	}
+
+	return webhookService, deliveryRepo, webhook
+}
+
+func enqueueTaskCreated(t *testing.T, webhookService *WebhookService) {
+	t.Helper()
+
+	ctx := domain.ContextWithOutboxEvent(context.Background(), &domain.OutboxEvent{ID: 7, CreatedAt: time.Now()})
+	err := webhookService.HandleEvent(ctx, &domain.TaskChangedEvent{
+		Name: domain.EventTaskCreated,
+		Task: &domain.Task{ID: "task-1", ProjectID: "project-1"},
+	})
+	if err != nil {
+		t.Fatalf("HandleEvent returned error: %v", err)
+	}
+}
+
+func makeDue(deliveries []*domain.WebhookDelivery) {
+	past := time.Now().UTC().Add(-time.Second)
+	for _, delivery := range deliveries {
+		if delivery.Status == domain.WebhookDeliveryPending {
+			delivery.NextAttemptAt = &past
+		}
+	}
+}
+
+func TestWebhookDeliveryIsSigned(t *testing.T) {
+	endpoint := &webhookEndpoint{}
+	webhookService, deliveryRepo, webhook := newTestWebhookService(t, endpoint, time.Second)
+
+	enqueueTaskCreated(t, webhookService)
+
+	delivered, err := webhookService.DeliverDue(context.Background())
+	if err != nil {
+		t.Fatalf("DeliverDue returned error: %v", err)
+	}
+	if delivered != 1 {
+		t.Fatalf("delivered = %d, want 1", delivered)
+	}
+
+	requests := endpoint.requests()
+	if len(requests) != 1 {
+		t.Fatalf("endpoint received %d requests, want 1", len(requests))
+	}
+
+	request := requests[0]
+	if want := domain.SignWebhookPayload(webhook.Secret, request.body); request.signature != want {
+		t.Errorf("signature = %q, want %q", request.signature, want)
+	}
+	if request.event != string(domain.EventTaskCreated) {
+		t.Errorf("event header = %q, want %q", request.event, domain.EventTaskCreated)
+	}
+	if request.delivery != deliveryRepo.deliveries[0].ID {
+		t.Errorf("delivery header = %q, want %q", request.delivery, deliveryRepo.deliveries[0].ID)
+	}
+
+	var envelope webhookEnvelope
+	if err := json.Unmarshal(request.body, &envelope); err != nil {
+		t.Fatalf("payload is not JSON: %v", err)
+	}
+	if envelope.ID != 7 || envelope.WebhookID != webhook.ID || envelope.ProjectID != "project-1" {
+		t.Errorf("envelope = %+v, want event 7 for %s in project-1", envelope, webhook.ID)
+	}
+}
+
+func TestWebhookDeliveryRetriesWithBackoffOnServerError(t *testing.T) {
+	endpoint := &webhookEndpoint{statuses: []int{http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusOK}}
+	webhookService, deliveryRepo, _ := newTestWebhookService(t, endpoint, time.Second)
+
+	enqueueTaskCreated(t, webhookService)
+	delivery := deliveryRepo.deliveries[0]
+
+	for attempt, status := range []int{http.StatusBadGateway, http.StatusServiceUnavailable} {
+		before := time.Now().UTC()
+		_, err := webhookService.DeliverDue(context.Background())
+		if err != nil {
+			t.Fatalf("DeliverDue returned error: %v", err)
+		}
+
+		if delivery.Status != domain.WebhookDeliveryPending {
+			t.Fatalf("attempt %d: status = %s, want pending", attempt+1, delivery.Status)
+		}
+		if delivery.Attempts != attempt+1 {
+			t.Errorf("attempt %d: attempts = %d", attempt+1, delivery.Attempts)
+		}
+		if delivery.ResponseStatus == nil || *delivery.ResponseStatus != status {
+			t.Errorf("attempt %d: response status = %v, want %d", attempt+1, delivery.ResponseStatus, status)
+		}
+		if delivery.ResponseBody == nil || *delivery.ResponseBody != fmt.Sprintf("status %d", status) {
+			t.Errorf("attempt %d: response body = %v", attempt+1, delivery.ResponseBody)
+		}
+		if delivery.Error == nil {
+			t.Errorf("attempt %d: error was not recorded", attempt+1)
+		}
+
+		backoff := domain.WebhookBackoff(attempt + 1)
+		if delivery.NextAttemptAt == nil || delivery.NextAttemptAt.Before(before.Add(backoff)) || delivery.NextAttemptAt.After(time.Now().UTC().Add(backoff)) {
+			t.Errorf("attempt %d: next attempt = %v, want about %s from now", attempt+1, delivery.NextAttemptAt, backoff)
+		}
+
+		_, err = webhookService.DeliverDue(context.Background())
+		if err != nil {
+			t.Fatalf("DeliverDue returned error: %v", err)
+		}
+		if delivery.Attempts != attempt+1 {
+			t.Fatalf("delivery was retried before its backoff elapsed")
+		}
+
+		makeDue(deliveryRepo.deliveries)
+	}
+
+	delivered, err := webhookService.DeliverDue(context.Background())
+	if err != nil {
+		t.Fatalf("DeliverDue returned error: %v", err)
+	}
+	if delivered != 1 || delivery.Status != domain.WebhookDeliverySucceeded {
+		t.Fatalf("final attempt: delivered = %d, status = %s", delivered, delivery.Status)
+	}
+	if delivery.DeliveredAt == nil || delivery.NextAttemptAt != nil || delivery.Error != nil {
+		t.Errorf("succeeded delivery = %+v, want delivered time and no retry or error", delivery)
+	}
+	if len(endpoint.requests()) != 3 {
+		t.Errorf("endpoint received %d requests, want 3", len(endpoint.requests()))
+	}
+}
+
+func TestWebhookDeliveryRetriesOnTimeout(t *testing.T) {
+	endpoint := &webhookEndpoint{delay: 200 * time.Millisecond}
+	webhookService, deliveryRepo, _ := newTestWebhookService(t, endpoint, 50*time.Millisecond)
+
+	enqueueTaskCreated(t, webhookService)
+
+	_, err := webhookService.DeliverDue(context.Background())
+	if err != nil {
+		t.Fatalf("DeliverDue returned error: %v", err)
+	}
+
+	delivery := deliveryRepo.deliveries[0]
+	if delivery.Status != domain.WebhookDeliveryPending || delivery.Attempts != 1 {
+		t.Fatalf("delivery = %+v, want pending after one attempt", delivery)
+	}
+	if delivery.Error == nil || delivery.ResponseStatus != nil {
+		t.Errorf("timeout should record an error without a response, got error %v and status %v", delivery.Error, delivery.ResponseStatus)
+	}
+	if delivery.NextAttemptAt == nil || time.Until(*delivery.NextAttemptAt) < domain.WebhookBackoff(1)-time.Second {
+		t.Errorf("next attempt = %v, want about %s from now", delivery.NextAttemptAt, domain.WebhookBackoff(1))
+	}
+}
+
+func TestWebhookDeliveryFailsAfterMaxAttempts(t *testing.T) {
+	statuses := make([]int, domain.WebhookMaxAttempts)
+	for i := range statuses {
+		statuses[i] = http.StatusInternalServerError
+	}
+	endpoint := &webhookEndpoint{statuses: statuses}
+	webhookService, deliveryRepo, _ := newTestWebhookService(t, endpoint, time.Second)
+
+	enqueueTaskCreated(t, webhookService)
+
+	for i := 0; i < domain.WebhookMaxAttempts; i++ {
+		_, err := webhookService.DeliverDue(context.Background())
+		if err != nil {
+			t.Fatalf("DeliverDue returned error: %v", err)
+		}
+		makeDue(deliveryRepo.deliveries)
+	}
+
+	delivery := deliveryRepo.deliveries[0]
+	if delivery.Status != domain.WebhookDeliveryFailed || delivery.NextAttemptAt != nil {
+		t.Fatalf("delivery = %+v, want failed with no further attempt", delivery)
+	}
+	if delivery.Attempts != domain.WebhookMaxAttempts {
+		t.Errorf("attempts = %d, want %d", delivery.Attempts, domain.WebhookMaxAttempts)
+	}
+}
+
+func TestWebhookRedeliver(t *testing.T) {
+	endpoint := &webhookEndpoint{statuses: []int{http.StatusInternalServerError, http.StatusCreated}}
+	webhookService, deliveryRepo, webhook := newTestWebhookService(t, endpoint, time.Second)
+
+	enqueueTaskCreated(t, webhookService)
+	_, err := webhookService.DeliverDue(context.Background())
+	if err != nil {
+		t.Fatalf("DeliverDue returned error: %v", err)
+	}
+	original := deliveryRepo.deliveries[0]
+
+	redelivery, err := webhookService.Redeliver(context.Background(), original)
+	if err != nil {
+		t.Fatalf("Redeliver returned error: %v", err)
+	}
+
+	if redelivery.ID == original.ID {
+		t.Error("redelivery reused the original delivery")
+	}
+	if redelivery.Status != domain.WebhookDeliverySucceeded || redelivery.Attempts != 1 {
+		t.Errorf("redelivery = %+v, want succeeded after one attempt", redelivery)
+	}
+	if redelivery.ResponseStatus == nil || *redelivery.ResponseStatus != http.StatusCreated {
+		t.Errorf("redelivery response status = %v, want 201", redelivery.ResponseStatus)
+	}
+	if !bytes.Equal(redelivery.Payload, original.Payload) || redelivery.Event != original.Event {
+		t.Error("redelivery does not carry the original event and payload")
+	}
+	if original.Attempts != 1 || original.Status != domain.WebhookDeliveryPending {
+		t.Errorf("original delivery changed: %+v", original)
+	}
+
+	requests := endpoint.requests()
+	if len(requests) != 2 {
+		t.Fatalf("endpoint received %d requests, want 2", len(requests))
+	}
+	if requests[1].delivery != redelivery.ID {
+		t.Errorf("delivery header = %q, want %q", requests[1].delivery, redelivery.ID)
+	}
+	if want := domain.SignWebhookPayload(webhook.Secret, requests[1].body); requests[1].signature != want {
+		t.Errorf("redelivery signature = %q, want %q", requests[1].signature, want)
+	}
+}
+
+func TestWebhookSkipsUnsubscribedEvents(t *testing.T) {
+	endpoint := &webhookEndpoint{}
+	webhookService, deliveryRepo, _ := newTestWebhookService(t, endpoint, time.Second)
+
+	err := webhookService.HandleEvent(context.Background(), &domain.ColumnDeletedEvent{
+		Column: &domain.Column{ID: "column-1", ProjectID: "project-1"},
+	})
+	if err != nil {
+		t.Fatalf("HandleEvent returned error: %v", err)
+	}
+
+	if len(deliveryRepo.deliveries) != 0 {
+		t.Errorf("created %d deliveries for an unsubscribed event", len(deliveryRepo.deliveries))
+	}
+}